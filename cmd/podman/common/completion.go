@@ -219,6 +219,28 @@ func getImages(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellComp
 	return suggestions, cobra.ShellCompDirectiveNoFileComp
 }
 
+func getHooks(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCompDirective) {
+	suggestions := []string{}
+
+	engine, err := setupContainerEngine(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	hooks, err := engine.HooksList(registry.GetContext())
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for _, h := range hooks {
+		if strings.HasPrefix(h.Name, toComplete) {
+			suggestions = append(suggestions, h.Name)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
 func getSecrets(cmd *cobra.Command, toComplete string, cType completeType) ([]string, cobra.ShellCompDirective) {
 	suggestions := []string{}
 
@@ -591,6 +613,14 @@ func AutocompleteSecrets(cmd *cobra.Command, args []string, toComplete string) (
 	return getSecrets(cmd, toComplete, completeDefault)
 }
 
+// AutocompleteHooks - Autocomplete OCI hooks.
+func AutocompleteHooks(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !validCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return getHooks(cmd, toComplete)
+}
+
 func AutocompleteSecretCreate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if len(args) == 1 {
 		return nil, cobra.ShellCompDirectiveDefault
@@ -919,6 +949,16 @@ func AutocompleteDetachKeys(cmd *cobra.Command, args []string, toComplete string
 	return []string{"ctrl-"}, cobra.ShellCompDirectiveNoSpace
 }
 
+// AutocompleteCpPreserve - Autocomplete cp --preserve options.
+// -> "owner", "mode", "xattrs", "all"
+func AutocompleteCpPreserve(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	attributes := []string{"owner", "mode", "xattrs", "all"}
+	split := strings.Split(toComplete, ",")
+	split[len(split)-1] = ""
+	toComplete = strings.Join(split, ",")
+	return prefixSlice(toComplete, attributes), cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteChangeInstructions - Autocomplete change instructions options for commit and import.
 // -> "CMD", "ENTRYPOINT", "ENV", "EXPOSE", "LABEL", "ONBUILD", "STOPSIGNAL", "USER", "VOLUME", "WORKDIR"
 func AutocompleteChangeInstructions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -1498,6 +1538,13 @@ func AutocompleteNetworkIPAMDriver(cmd *cobra.Command, args []string, toComplete
 	return drivers, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteNetworkIsolate - Autocomplete network isolate option.
+// -> "true", "false", "strict"
+func AutocompleteNetworkIsolate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	values := []string{"true", "false", "strict"}
+	return values, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompletePodShareNamespace - Autocomplete pod create --share flag option.
 // -> "ipc", "net", "pid", "user", "uts", "cgroup", "none"
 func AutocompletePodShareNamespace(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -1595,31 +1642,51 @@ func AutocompleteLogLevel(cmd *cobra.Command, args []string, toComplete string)
 	return LogLevels, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteDatabaseBackend - Autocomplete the database backends a state
+// can be migrated to.
+// -> "sqlite"
+func AutocompleteDatabaseBackend(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return []string{config.DBBackendSQLite.String()}, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteSDNotify - Autocomplete sdnotify options.
 // -> "container", "conmon", "ignore"
 func AutocompleteSDNotify(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	types := []string{define.SdNotifyModeConmon, define.SdNotifyModeContainer, define.SdNotifyModeHealthy, define.SdNotifyModeIgnore}
+	types := []string{define.SdNotifyModeConmon, define.SdNotifyModeContainer, define.SdNotifyModeHealthy, define.SdNotifyModeHealthyThenWatchdog, define.SdNotifyModeIgnore}
 	return types, cobra.ShellCompDirectiveNoFileComp
 }
 
 var containerStatuses = []string{"created", "running", "paused", "stopped", "exited", "unknown"}
 
+// AutocompleteBulkFormat - Autocomplete the --format option of commands
+// operating on several objects.
+func AutocompleteBulkFormat(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return []string{"json"}, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompletePsFilters - Autocomplete ps filter options.
 func AutocompletePsFilters(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	kv := keyValueCompletion{
 		"ancestor=": func(s string) ([]string, cobra.ShellCompDirective) { return getImages(cmd, s) },
 		"before=":   func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeDefault) },
+		"command=":  nil,
 		"exited=":   nil,
+		"expose=":   nil,
 		"health=": func(_ string) ([]string, cobra.ShellCompDirective) {
 			return []string{define.HealthCheckHealthy,
 				define.HealthCheckUnhealthy}, cobra.ShellCompDirectiveNoFileComp
 		},
-		"id=":      func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeIDs) },
-		"label=":   nil,
-		"name=":    func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeNames) },
-		"network=": func(s string) ([]string, cobra.ShellCompDirective) { return getNetworks(cmd, s, completeDefault) },
-		"pod=":     func(s string) ([]string, cobra.ShellCompDirective) { return getPods(cmd, s, completeDefault) },
-		"since=":   func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeDefault) },
+		"id=":            func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeIDs) },
+		"image-digest=":  nil,
+		"label=":         nil,
+		"name=":          func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeNames) },
+		"network=":       func(s string) ([]string, cobra.ShellCompDirective) { return getNetworks(cmd, s, completeDefault) },
+		"oom-killed=":    getBoolCompletion,
+		"pod=":           func(s string) ([]string, cobra.ShellCompDirective) { return getPods(cmd, s, completeDefault) },
+		"publish=":       nil,
+		"restart-count=": nil,
+		"since=":         func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeDefault) },
+		"started-since=": nil,
 		"status=": func(_ string) ([]string, cobra.ShellCompDirective) {
 			return containerStatuses, cobra.ShellCompDirectiveNoFileComp
 		},
@@ -1681,6 +1748,23 @@ func AutocompletePruneFilters(cmd *cobra.Command, args []string, toComplete stri
 	return completeKeyValues(toComplete, kv)
 }
 
+// AutocompleteContainerPruneFilters - Autocomplete container prune --filter options.
+func AutocompleteContainerPruneFilters(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	kv := keyValueCompletion{
+		"command=":       nil,
+		"exited=":        nil,
+		"expose=":        nil,
+		"image-digest=":  nil,
+		"label=":         nil,
+		"oom-killed=":    getBoolCompletion,
+		"publish=":       nil,
+		"restart-count=": nil,
+		"started-since=": nil,
+		"until=":         nil,
+	}
+	return completeKeyValues(toComplete, kv)
+}
+
 // AutocompleteNetworkFilters - Autocomplete network ls --filter options.
 func AutocompleteNetworkFilters(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	kv := keyValueCompletion{
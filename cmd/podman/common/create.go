@@ -218,6 +218,14 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(healthOnFailureFlagName, AutocompleteHealthOnFailure)
 
+		healthCheckpointRetentionFlagName := "health-checkpoint-retention"
+		createFlags.UintVar(
+			&cf.HealthCheckpoints,
+			healthCheckpointRetentionFlagName, 0,
+			"number of checkpoint archives to keep for --health-on-failure=checkpoint",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(healthCheckpointRetentionFlagName, completion.AutocompleteNone)
+
 		createFlags.BoolVar(
 			&cf.HTTPProxy,
 			"http-proxy", podmanConfig.ContainersConfDefaultsRO.Containers.HTTPProxy,
@@ -346,6 +354,12 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 			"Read the pod ID from the file",
 		)
 		_ = cmd.RegisterFlagCompletionFunc(podIDFileFlagName, completion.AutocompleteDefault)
+
+		createFlags.BoolVar(
+			&cf.PodCritical,
+			"pod-critical", false,
+			"Restart the whole pod according to its --restart-pod policy when the container exits or turns unhealthy",
+		)
 		createFlags.BoolVar(
 			&cf.Privileged,
 			"privileged", podmanConfig.ContainersConfDefaultsRO.Containers.Privileged,
@@ -392,10 +406,18 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		createFlags.StringSliceVar(
 			&cf.Requires,
 			requiresFlagName, []string{},
-			"Add one or more requirement containers that must be started before this container will start",
+			"Add one or more requirement containers that must be started, or meet the given condition (started, healthy, completed-successfully), before this container will start",
 		)
 		_ = cmd.RegisterFlagCompletionFunc(requiresFlagName, AutocompleteContainers)
 
+		requiresTimeoutFlagName := "requires-timeout"
+		createFlags.StringVar(
+			&cf.RequiresTimeout,
+			requiresTimeoutFlagName, "",
+			"Time to wait for the conditions of the required containers to be met (default 5m0s)",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(requiresTimeoutFlagName, completion.AutocompleteNone)
+
 		createFlags.BoolVar(
 			&cf.Rm,
 			"rm", false,
@@ -645,6 +667,32 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(restartFlagName, AutocompleteRestartOption)
 
+		if mode == entities.CreateMode {
+			restartDelayFlagName := "restart-delay"
+			createFlags.StringVar(
+				&cf.RestartDelay,
+				restartDelayFlagName, "",
+				"Delay restarts by the restart policy, doubling the delay after every restart of a container which exited shortly after it was started",
+			)
+			_ = cmd.RegisterFlagCompletionFunc(restartDelayFlagName, completion.AutocompleteNone)
+
+			restartMaxDelayFlagName := "restart-max-delay"
+			createFlags.StringVar(
+				&cf.RestartMaxDelay,
+				restartMaxDelayFlagName, "",
+				"Maximum delay of restarts by the restart policy (default 5m0s)",
+			)
+			_ = cmd.RegisterFlagCompletionFunc(restartMaxDelayFlagName, completion.AutocompleteNone)
+
+			restartDelayResetFlagName := "restart-delay-reset"
+			createFlags.StringVar(
+				&cf.RestartDelayReset,
+				restartDelayResetFlagName, "",
+				"Time a container must run, and be healthy, for the restart delay to be reset (default 10m0s)",
+			)
+			_ = cmd.RegisterFlagCompletionFunc(restartDelayResetFlagName, completion.AutocompleteNone)
+		}
+
 		shmSizeFlagName := "shm-size"
 		createFlags.String(
 			shmSizeFlagName, shmSize(),
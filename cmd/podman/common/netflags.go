@@ -88,6 +88,20 @@ func DefineNetFlags(cmd *cobra.Command) {
 	)
 	_ = cmd.RegisterFlagCompletionFunc(publishFlagName, completion.AutocompleteNone)
 
+	networkRateFlagName := "network-rate"
+	netFlags.StringArray(
+		networkRateFlagName, nil,
+		"Limit the network bandwidth of the container ([network:]ingress=rate,egress=rate)",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(networkRateFlagName, completion.AutocompleteNone)
+
+	hostsGroupFlagName := "hosts-group"
+	netFlags.String(
+		hostsGroupFlagName, "",
+		"Keep the /etc/hosts entries of all containers and pods in the `group` in sync",
+	)
+	_ = cmd.RegisterFlagCompletionFunc(hostsGroupFlagName, completion.AutocompleteNone)
+
 	netFlags.Bool(
 		"no-hosts", podmanConfig.ContainersConfDefaultsRO.Containers.NoHosts,
 		"Do not create /etc/hosts within the container, instead use the version from the image",
@@ -165,6 +179,24 @@ func NetFlagsToNetOptions(opts *entities.NetOptions, flags pflag.FlagSet) (*enti
 		opts.DNSSearch = dnsSearches
 	}
 
+	if flags.Changed("network-rate") {
+		rates, err := flags.GetStringArray("network-rate")
+		if err != nil {
+			return nil, err
+		}
+		opts.NetworkRates, err = specgenutil.ParseNetworkRates(rates)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if flags.Changed("hosts-group") {
+		opts.HostsGroup, err = flags.GetString("hosts-group")
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if flags.Changed("publish") {
 		inputPorts, err := flags.GetStringSlice("publish")
 		if err != nil {
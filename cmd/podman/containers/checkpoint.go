@@ -9,6 +9,7 @@ import (
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
@@ -40,7 +41,12 @@ var (
 	}
 )
 
-var checkpointOptions entities.CheckpointOptions
+var (
+	checkpointOptions = entities.CheckpointOptions{
+		Filters: make(map[string][]string),
+	}
+	checkpointFormat string
+)
 
 type checkpointStatistics struct {
 	PodmanDuration      int64                        `json:"podman_checkpoint_duration"`
@@ -82,11 +88,25 @@ func init() {
 		"Display checkpoint statistics",
 	)
 
+	filterFlagName := "filter"
+	flags.StringArrayVarP(&filters, filterFlagName, "f", []string{}, "Filter output based on conditions given")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
+
+	formatFlagName := "format"
+	flags.StringVar(&checkpointFormat, formatFlagName, "", "Print a report for each container in the given format (json)")
+	_ = checkpointCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
+
 	validate.AddLatestFlag(checkpointCommand, &checkpointOptions.Latest)
 }
 
 func checkpoint(cmd *cobra.Command, args []string) error {
 	var errs utils.OutputErrors
+	if err := utils.ValidateBulkFormat(checkpointFormat); err != nil {
+		return err
+	}
+	if checkpointFormat != "" && checkpointOptions.PrintStats {
+		return errors.New("--format and --print-stats cannot be used together")
+	}
 	args = utils.RemoveSlash(args)
 	podmanStart := time.Now()
 	if cmd.Flags().Changed("compress") {
@@ -122,12 +142,27 @@ func checkpoint(cmd *cobra.Command, args []string) error {
 	if (checkpointOptions.WithPrevious || checkpointOptions.PreCheckPoint) && !criu.MemTrack() {
 		return errors.New("system (architecture/kernel/CRIU) does not support memory tracking")
 	}
+	for _, f := range filters {
+		fname, filter, hasFilter := parse.SplitFilter(f)
+		if !hasFilter {
+			return fmt.Errorf("invalid filter %q", f)
+		}
+		checkpointOptions.Filters[fname] = append(checkpointOptions.Filters[fname], filter)
+	}
 	responses, err := registry.ContainerEngine().ContainerCheckpoint(context.Background(), args, checkpointOptions)
 	if err != nil {
 		return err
 	}
 	podmanFinished := time.Now()
 
+	if checkpointFormat != "" {
+		reports := make([]utils.BulkReport, 0, len(responses))
+		for _, r := range responses {
+			reports = append(reports, utils.BulkReport{Id: r.Id, RawInput: r.RawInput, Err: r.Err})
+		}
+		return utils.PrintBulkReports(checkpointFormat, reports)
+	}
+
 	var statistics checkpointStatistics
 
 	for _, r := range responses {
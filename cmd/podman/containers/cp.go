@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"archive/tar"
 	"fmt"
 	"io"
 	"os"
@@ -8,10 +9,13 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"errors"
 
 	buildahCopiah "github.com/containers/buildah/copier"
+	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/pkg/copy"
@@ -19,6 +23,7 @@ import (
 	"github.com/containers/podman/v5/pkg/errorhandling"
 	"github.com/containers/storage/pkg/archive"
 	"github.com/containers/storage/pkg/idtools"
+	"github.com/docker/go-units"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -52,12 +57,33 @@ var (
 var (
 	cpOpts entities.ContainerCpOptions
 	chown  bool
+
+	// The attributes to keep as given by --preserve.  Without it, the
+	// mode and extended attributes are kept and the owner is set by
+	// --archive.
+	preserveOwner  bool
+	preserveMode   = true
+	preserveXattrs = true
 )
 
 func cpFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
 	flags.BoolVar(&cpOpts.OverwriteDirNonDir, "overwrite", false, "Allow to overwrite directories with non-directories and vice versa")
 	flags.BoolVarP(&chown, "archive", "a", true, `Chown copied files to the primary uid/gid of the destination container.`)
+	flags.BoolVar(&cpOpts.NoOverwriteDir, "no-overwrite-dir", false, "Keep the ownership, permissions and timestamps of existing directories")
+	flags.BoolVar(&cpOpts.Progress, "progress", false, "Show the number of files and bytes copied")
+
+	excludeFlagName := "exclude"
+	flags.StringArrayVar(&cpOpts.Excludes, excludeFlagName, nil, "Do not copy paths matching `pattern`")
+	_ = cmd.RegisterFlagCompletionFunc(excludeFlagName, completion.AutocompleteNone)
+
+	includeFlagName := "include"
+	flags.StringArrayVar(&cpOpts.Includes, includeFlagName, nil, "Only copy paths matching `pattern` and their parent directories")
+	_ = cmd.RegisterFlagCompletionFunc(includeFlagName, completion.AutocompleteNone)
+
+	preserveFlagName := "preserve"
+	flags.StringSliceVar(&cpOpts.Preserve, preserveFlagName, nil, "Preserve the given attributes of the files (owner, mode, xattrs, all)")
+	_ = cmd.RegisterFlagCompletionFunc(preserveFlagName, common.AutocompleteCpPreserve)
 
 	// Deprecated flags (both are NOPs): exist for backwards compat
 	flags.BoolVar(&cpOpts.Extract, "extract", false, "Deprecated...")
@@ -86,13 +112,131 @@ func cp(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmd.Flags().Changed("preserve") {
+		if err := parsePreserve(cpOpts.Preserve); err != nil {
+			return err
+		}
+	}
+	// Catch invalid patterns before anything is copied.
+	if _, err := copy.NewArchiveFilter(cpOpts.Includes, cpOpts.Excludes); err != nil {
+		return err
+	}
+
+	var progress *copyProgress
+	if cpOpts.Progress {
+		progress = new(copyProgress)
+		defer progress.done()
+	}
+
 	if len(sourceContainerStr) > 0 && len(destContainerStr) > 0 {
-		return copyContainerToContainer(sourceContainerStr, sourcePath, destContainerStr, destPath)
+		return copyContainerToContainer(sourceContainerStr, sourcePath, destContainerStr, destPath, progress)
 	} else if len(sourceContainerStr) > 0 {
-		return copyFromContainer(sourceContainerStr, sourcePath, destPath)
+		return copyFromContainer(sourceContainerStr, sourcePath, destPath, progress)
+	}
+
+	return copyToContainer(destContainerStr, destPath, sourcePath, progress)
+}
+
+// parsePreserve parses the attributes given to --preserve.
+func parsePreserve(attributes []string) error {
+	preserveOwner, preserveMode, preserveXattrs = false, false, false
+	for _, attr := range attributes {
+		switch attr {
+		case "owner":
+			preserveOwner = true
+		case "mode":
+			preserveMode = true
+		case "xattrs":
+			preserveXattrs = true
+		case "all":
+			preserveOwner, preserveMode, preserveXattrs = true, true, true
+		default:
+			return fmt.Errorf("invalid --preserve attribute %q: must be one of owner, mode, xattrs or all", attr)
+		}
+	}
+	return nil
+}
+
+// copyToArchiveOptions returns the options for reading from a container.
+func copyToArchiveOptions() entities.CopyToArchiveOptions {
+	return entities.CopyToArchiveOptions{
+		Excludes: cpOpts.Excludes,
+		Includes: cpOpts.Includes,
+		NoChown:  preserveOwner,
+	}
+}
+
+// copyFromArchiveOptions returns the options for writing to a container.
+func copyFromArchiveOptions() entities.CopyOptions {
+	return entities.CopyOptions{
+		Chown:                chown && !preserveOwner,
+		NoOverwriteDirNonDir: !cpOpts.OverwriteDirNonDir,
+		NoOverwriteDir:       cpOpts.NoOverwriteDir,
+		StripXattrs:          !preserveXattrs,
+		StripSpecialBits:     !preserveMode,
+	}
+}
+
+// filterArchive returns a reader of the archive read from reader, passed
+// through a filter applying the patterns if requested, the directories to
+// skip and the progress.  If there is nothing to do, reader is returned.
+func filterArchive(reader io.ReadCloser, applyPatterns bool, skipDirectory func(string) bool, progress *copyProgress) (io.ReadCloser, error) {
+	if (!applyPatterns || (len(cpOpts.Includes) == 0 && len(cpOpts.Excludes) == 0)) && skipDirectory == nil && progress == nil {
+		return reader, nil
+	}
+	var filter *copy.ArchiveFilter
+	var err error
+	if applyPatterns {
+		filter, err = copy.NewArchiveFilter(cpOpts.Includes, cpOpts.Excludes)
+	} else {
+		filter, err = copy.NewArchiveFilter(nil, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	filter.SkipDirectory = skipDirectory
+	if progress != nil {
+		filter.Progress = progress.update
+	}
+
+	filteredReader, filteredWriter := io.Pipe()
+	go func() {
+		filteredWriter.CloseWithError(filter.Copy(filteredWriter, reader))
+	}()
+	return filteredReader, nil
+}
+
+// copyProgress prints the number of files and bytes copied to stderr.
+type copyProgress struct {
+	lock    sync.Mutex
+	files   int
+	bytes   int64
+	printed time.Time
+}
+
+func (p *copyProgress) update(hdr *tar.Header) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if hdr.Typeflag == tar.TypeReg {
+		p.files++
+		p.bytes += hdr.Size
+	}
+	if time.Since(p.printed) >= 100*time.Millisecond {
+		p.print()
 	}
+}
+
+// print must be called with the lock held.
+func (p *copyProgress) print() {
+	fmt.Fprintf(os.Stderr, "\rCopied %d files, %s", p.files, units.HumanSize(float64(p.bytes)))
+	p.printed = time.Now()
+}
 
-	return copyToContainer(destContainerStr, destPath, sourcePath)
+func (p *copyProgress) done() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.print()
+	fmt.Fprintln(os.Stderr)
 }
 
 // containerMustExist returns an error if the specified container does not
@@ -121,7 +265,7 @@ func doCopy(funcA func() error, funcB func() error) error {
 	return errorhandling.JoinErrors(copyErrors)
 }
 
-func copyContainerToContainer(sourceContainer string, sourcePath string, destContainer string, destPath string) error {
+func copyContainerToContainer(sourceContainer string, sourcePath string, destContainer string, destPath string, progress *copyProgress) error {
 	if err := containerMustExist(sourceContainer); err != nil {
 		return err
 	}
@@ -166,7 +310,7 @@ func copyContainerToContainer(sourceContainer string, sourcePath string, destCon
 
 	sourceContainerCopy := func() error {
 		defer writer.Close()
-		copyFunc, err := registry.ContainerEngine().ContainerCopyToArchive(registry.GetContext(), sourceContainer, sourceContainerTarget, writer)
+		copyFunc, err := registry.ContainerEngine().ContainerCopyToArchive(registry.GetContext(), sourceContainer, sourceContainerTarget, writer, copyToArchiveOptions())
 		if err != nil {
 			return err
 		}
@@ -179,14 +323,21 @@ func copyContainerToContainer(sourceContainer string, sourcePath string, destCon
 	destContainerCopy := func() error {
 		defer reader.Close()
 
-		copyOptions := entities.CopyOptions{Chown: chown, NoOverwriteDirNonDir: !cpOpts.OverwriteDirNonDir}
+		copyOptions := copyFromArchiveOptions()
 		if (!sourceContainerInfo.IsDir && !destContainerInfo.IsDir) || destResolvedToParentDir {
 			// If we're having a file-to-file copy, make sure to
 			// rename accordingly.
 			copyOptions.Rename = map[string]string{filepath.Base(sourceContainerTarget): destContainerBaseName}
 		}
 
-		copyFunc, err := registry.ContainerEngine().ContainerCopyFromArchive(registry.GetContext(), destContainer, destContainerTarget, reader, copyOptions)
+		// The source already applied the patterns.
+		filtered, err := filterArchive(reader, false, nil, progress)
+		if err != nil {
+			return err
+		}
+		defer filtered.Close()
+
+		copyFunc, err := registry.ContainerEngine().ContainerCopyFromArchive(registry.GetContext(), destContainer, destContainerTarget, filtered, copyOptions)
 		if err != nil {
 			return err
 		}
@@ -200,7 +351,7 @@ func copyContainerToContainer(sourceContainer string, sourcePath string, destCon
 }
 
 // copyFromContainer copies from the containerPath on the container to hostPath.
-func copyFromContainer(container string, containerPath string, hostPath string) error {
+func copyFromContainer(container string, containerPath string, hostPath string, progress *copyProgress) error {
 	if err := containerMustExist(container); err != nil {
 		return err
 	}
@@ -273,7 +424,12 @@ func copyFromContainer(container string, containerPath string, hostPath string)
 	hostCopy := func() error {
 		defer reader.Close()
 		if isStdout {
-			_, err := io.Copy(os.Stdout, reader)
+			filtered, err := filterArchive(reader, false, nil, progress)
+			if err != nil {
+				return err
+			}
+			defer filtered.Close()
+			_, err = io.Copy(os.Stdout, filtered)
 			return err
 		}
 
@@ -303,6 +459,14 @@ func copyFromContainer(container string, containerPath string, hostPath string)
 			IgnoreDevices:        true,
 			NoOverwriteDirNonDir: !cpOpts.OverwriteDirNonDir,
 			NoOverwriteNonDirDir: !cpOpts.OverwriteDirNonDir,
+			StripXattrs:          !preserveXattrs,
+			StripSetuidBit:       !preserveMode,
+			StripSetgidBit:       !preserveMode,
+			StripStickyBit:       !preserveMode,
+		}
+		if preserveOwner {
+			putOptions.ChownDirs = nil
+			putOptions.ChownFiles = nil
 		}
 		if (!containerInfo.IsDir && !hostInfo.IsDir) || resolvedToHostParentDir {
 			// If we're having a file-to-file copy, make sure to
@@ -313,7 +477,19 @@ func copyFromContainer(container string, containerPath string, hostPath string)
 		if !hostInfo.IsDir {
 			dir = filepath.Dir(dir)
 		}
-		if err := buildahCopiah.Put(dir, "", putOptions, reader); err != nil {
+
+		// The container already applied the patterns.
+		var skipDirectory func(string) bool
+		if cpOpts.NoOverwriteDir {
+			skipDirectory = copy.ExistingDirectory("/", dir, putOptions.Rename)
+		}
+		filtered, err := filterArchive(reader, false, skipDirectory, progress)
+		if err != nil {
+			return err
+		}
+		defer filtered.Close()
+
+		if err := buildahCopiah.Put(dir, "", putOptions, filtered); err != nil {
 			return fmt.Errorf("copying to host: %w", err)
 		}
 		return nil
@@ -321,7 +497,7 @@ func copyFromContainer(container string, containerPath string, hostPath string)
 
 	containerCopy := func() error {
 		defer writer.Close()
-		copyFunc, err := registry.ContainerEngine().ContainerCopyToArchive(registry.GetContext(), container, containerTarget, writer)
+		copyFunc, err := registry.ContainerEngine().ContainerCopyToArchive(registry.GetContext(), container, containerTarget, writer, copyToArchiveOptions())
 		if err != nil {
 			return err
 		}
@@ -334,7 +510,7 @@ func copyFromContainer(container string, containerPath string, hostPath string)
 }
 
 // copyToContainer copies the hostPath to containerPath on the container.
-func copyToContainer(container string, containerPath string, hostPath string) error {
+func copyToContainer(container string, containerPath string, hostPath string, progress *copyProgress) error {
 	if err := containerMustExist(container); err != nil {
 		return err
 	}
@@ -412,7 +588,14 @@ func copyToContainer(container string, containerPath string, hostPath string) er
 				return err
 			}
 			defer stream.Close()
-			_, err = io.Copy(writer, stream)
+			// The archive may be compressed, which the filter
+			// cannot read.
+			decompressed, err := archive.DecompressStream(stream)
+			if err != nil {
+				return err
+			}
+			defer decompressed.Close()
+			_, err = io.Copy(writer, decompressed)
 			return err
 		}
 
@@ -439,7 +622,13 @@ func copyToContainer(container string, containerPath string, hostPath string) er
 			target = filepath.Dir(target)
 		}
 
-		copyFunc, err := registry.ContainerEngine().ContainerCopyFromArchive(registry.GetContext(), container, target, reader, entities.CopyOptions{Chown: chown, NoOverwriteDirNonDir: !cpOpts.OverwriteDirNonDir})
+		filtered, err := filterArchive(reader, true, nil, progress)
+		if err != nil {
+			return err
+		}
+		defer filtered.Close()
+
+		copyFunc, err := registry.ContainerEngine().ContainerCopyFromArchive(registry.GetContext(), container, target, filtered, copyFromArchiveOptions())
 		if err != nil {
 			return err
 		}
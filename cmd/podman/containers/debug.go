@@ -0,0 +1,161 @@
+package containers
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/spf13/cobra"
+)
+
+// debugRootfsDest is where the root filesystem of the debugged container is
+// mounted in the debug container.
+const debugRootfsDest = "/target"
+
+var (
+	debugDescription = `Run a temporary debug container next to a running container.
+
+  The debug container shares the PID, network, IPC and UTS namespaces of the target container, which has its root filesystem mounted at /target.  It is removed when it exits.`
+	debugCommand = &cobra.Command{
+		Use:               "debug [options] CONTAINER [COMMAND [ARG...]]",
+		Short:             "Debug a running container with a temporary container",
+		Long:              debugDescription,
+		RunE:              debug,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: common.AutocompleteExecCommand,
+		Example: `podman debug -it --image busybox ctrID
+  podman debug --image busybox myCtr ls /target/etc
+  podman debug -it --image quay.io/fedora/fedora myCtr`,
+	}
+
+	containerDebugCommand = &cobra.Command{
+		Use:               debugCommand.Use,
+		Short:             debugCommand.Short,
+		Long:              debugCommand.Long,
+		RunE:              debugCommand.RunE,
+		Args:              debugCommand.Args,
+		ValidArgsFunction: debugCommand.ValidArgsFunction,
+		Example: `podman container debug -it --image busybox ctrID
+  podman container debug --image busybox myCtr ls /target/etc
+  podman container debug -it --image quay.io/fedora/fedora myCtr`,
+	}
+)
+
+var debugOpts struct {
+	DetachKeys  string
+	Image       string
+	Interactive bool
+	Name        string
+	Pull        string
+	Tty         bool
+}
+
+func debugFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	flags.SetInterspersed(false)
+
+	detachKeysFlagName := "detach-keys"
+	flags.StringVar(&debugOpts.DetachKeys, detachKeysFlagName, containerConfig.DetachKeys(), "Override the key sequence for detaching the debug container. Format is a single character [a-Z] or ctrl-<value> where <value> is one of: a-z, @, ^, [, , or _")
+	_ = cmd.RegisterFlagCompletionFunc(detachKeysFlagName, common.AutocompleteDetachKeys)
+
+	imageFlagName := "image"
+	flags.StringVar(&debugOpts.Image, imageFlagName, "busybox", "Image of the debug container")
+	_ = cmd.RegisterFlagCompletionFunc(imageFlagName, common.AutocompleteImages)
+
+	flags.BoolVarP(&debugOpts.Interactive, "interactive", "i", false, "Keep STDIN open even if not attached")
+
+	nameFlagName := "name"
+	flags.StringVar(&debugOpts.Name, nameFlagName, "", "Assign a name to the debug container")
+	_ = cmd.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
+
+	pullPolicy := ""
+	if !registry.IsRemote() {
+		pullPolicy = registry.PodmanConfig().ContainersConfDefaultsRO.Engine.PullPolicy
+	}
+	pullFlagName := "pull"
+	flags.StringVar(&debugOpts.Pull, pullFlagName, pullPolicy, `Pull image policy ("always"|"missing"|"never"|"newer")`)
+	_ = cmd.RegisterFlagCompletionFunc(pullFlagName, common.AutocompletePullOption)
+
+	flags.BoolVarP(&debugOpts.Tty, "tty", "t", false, "Allocate a pseudo-TTY. The default is false")
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: debugCommand,
+	})
+	debugFlags(debugCommand)
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: containerDebugCommand,
+		Parent:  containerCmd,
+	})
+	debugFlags(containerDebugCommand)
+}
+
+func debug(cmd *cobra.Command, args []string) error {
+	reports, errs, err := registry.ContainerEngine().ContainerInspect(registry.GetContext(), args[:1], entities.InspectOptions{})
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	target := reports[0]
+	if target.State == nil || !target.State.Running {
+		return fmt.Errorf("container %s is not running: %w", args[0], define.ErrCtrStateInvalid)
+	}
+
+	imageName, err := PullImage(debugOpts.Image, &entities.ContainerCreateOptions{Pull: debugOpts.Pull})
+	if err != nil {
+		return err
+	}
+
+	s := specgen.NewSpecGenerator(imageName, false)
+	s.RawImageName = debugOpts.Image
+	s.Name = debugOpts.Name
+	s.Command = args[1:]
+	s.Terminal = &debugOpts.Tty
+	s.Stdin = &debugOpts.Interactive
+	remove := true
+	s.Remove = &remove
+
+	// Join the pod of the target container, if any, so that the debug
+	// container follows the life cycle of the pod.
+	s.Pod = target.Pod
+
+	targetNS := specgen.Namespace{NSMode: specgen.FromContainer, Value: target.ID}
+	s.PidNS = targetNS
+	s.NetNS = targetNS
+	s.IpcNS = targetNS
+	s.UtsNS = targetNS
+
+	s.RootfsVolumes = []*specgen.RootfsVolume{{Source: target.ID, Destination: debugRootfsDest}}
+	// The root filesystem of the target carries the SELinux label of the
+	// target container, which the debug container could not access.
+	s.SelinuxOpts = append(s.SelinuxOpts, "disable")
+
+	runOpts := entities.ContainerRunOptions{
+		DetachKeys:   debugOpts.DetachKeys,
+		ErrorStream:  os.Stderr,
+		OutputStream: os.Stdout,
+		Rm:           true,
+		SigProxy:     true,
+		Spec:         s,
+	}
+	if debugOpts.Interactive {
+		runOpts.InputStream = os.Stdin
+	}
+
+	report, err := registry.ContainerEngine().ContainerRun(registry.GetContext(), runOpts)
+	// report.ExitCode is set by ContainerRun even it returns an error
+	if report != nil {
+		registry.SetExitCode(report.ExitCode)
+	}
+	return err
+}
@@ -0,0 +1,81 @@
+package containers
+
+import (
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	execAttachDescription = `Attach to a running exec session using its name or ID.
+
+  The exec session keeps running after detaching from it, so it can be attached to again later.
+`
+	execAttachCommand = &cobra.Command{
+		Use:               "exec-attach [options] SESSION",
+		Short:             "Attach to a running exec session",
+		Long:              execAttachDescription,
+		RunE:              execAttach,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman exec-attach debug
+  podman exec-attach --detach-keys ctrl-x debug`,
+	}
+
+	containerExecAttachCommand = &cobra.Command{
+		Use:               execAttachCommand.Use,
+		Short:             execAttachCommand.Short,
+		Long:              execAttachCommand.Long,
+		RunE:              execAttachCommand.RunE,
+		Args:              execAttachCommand.Args,
+		ValidArgsFunction: execAttachCommand.ValidArgsFunction,
+		Example: `podman container exec-attach debug
+  podman container exec-attach --detach-keys ctrl-x debug`,
+	}
+)
+
+var (
+	execAttachOpts entities.ExecAttachOptions
+)
+
+func execAttachFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	detachKeysFlagName := "detach-keys"
+	flags.String(detachKeysFlagName, "", "Select the key sequence for detaching from the exec session, defaults to the one of the exec session. Format is a single character [a-Z] or ctrl-<value> where <value> is one of: a-z, @, ^, [, , or _")
+	_ = cmd.RegisterFlagCompletionFunc(detachKeysFlagName, common.AutocompleteDetachKeys)
+
+	flags.BoolVar(&execAttachOpts.NoStdin, "no-stdin", false, "Do not attach STDIN. The default is false")
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: execAttachCommand,
+	})
+	execAttachFlags(execAttachCommand)
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: containerExecAttachCommand,
+		Parent:  containerCmd,
+	})
+	execAttachFlags(containerExecAttachCommand)
+}
+
+func execAttach(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("detach-keys") {
+		detachKeys, err := cmd.Flags().GetString("detach-keys")
+		if err != nil {
+			return err
+		}
+		execAttachOpts.DetachKeys = &detachKeys
+	}
+	execAttachOpts.Stdin = os.Stdin
+	execAttachOpts.Stdout = os.Stdout
+	execAttachOpts.Stderr = os.Stderr
+
+	return registry.ContainerEngine().ContainerExecAttach(registry.GetContext(), args[0], execAttachOpts)
+}
@@ -32,6 +32,7 @@ func init() {
 	inspectOpts = new(entities.InspectOptions)
 	flags := inspectCmd.Flags()
 	flags.BoolVarP(&inspectOpts.Size, "size", "s", false, "Display total file size")
+	flags.BoolVar(&inspectOpts.ShowHooks, "show-hooks", false, "Display the OCI hooks the container uses")
 
 	formatFlagName := "format"
 	flags.StringVarP(&inspectOpts.Format, formatFlagName, "f", "json", "Format the output to a Go template or json")
@@ -9,6 +9,7 @@ import (
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
@@ -49,8 +50,11 @@ var (
 )
 
 var (
-	killOptions  = entities.KillOptions{}
+	killOptions = entities.KillOptions{
+		Filters: make(map[string][]string),
+	}
 	killCidFiles = []string{}
+	killFormat   string
 )
 
 func killFlags(cmd *cobra.Command) {
@@ -64,6 +68,14 @@ func killFlags(cmd *cobra.Command) {
 	cidfileFlagName := "cidfile"
 	flags.StringArrayVar(&killCidFiles, cidfileFlagName, nil, "Read the container ID from the file")
 	_ = cmd.RegisterFlagCompletionFunc(cidfileFlagName, completion.AutocompleteDefault)
+
+	filterFlagName := "filter"
+	flags.StringArrayVarP(&filters, filterFlagName, "f", []string{}, "Filter output based on conditions given")
+	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
+
+	formatFlagName := "format"
+	flags.StringVar(&killFormat, formatFlagName, "", "Print a report for each container in the given format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
 }
 
 func init() {
@@ -82,10 +94,10 @@ func init() {
 }
 
 func kill(_ *cobra.Command, args []string) error {
-	var (
-		err  error
-		errs utils.OutputErrors
-	)
+	var err error
+	if err := utils.ValidateBulkFormat(killFormat); err != nil {
+		return err
+	}
 	args = utils.RemoveSlash(args)
 	// Check if the signalString provided by the user is valid
 	// Invalid signals will return err
@@ -105,19 +117,21 @@ func kill(_ *cobra.Command, args []string) error {
 		args = append(args, id)
 	}
 
+	for _, f := range filters {
+		fname, filter, hasFilter := parse.SplitFilter(f)
+		if !hasFilter {
+			return fmt.Errorf("invalid filter %q", f)
+		}
+		killOptions.Filters[fname] = append(killOptions.Filters[fname], filter)
+	}
+
 	responses, err := registry.ContainerEngine().ContainerKill(context.Background(), args, killOptions)
 	if err != nil {
 		return err
 	}
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		switch {
-		case r.Err != nil:
-			errs = append(errs, r.Err)
-		case r.RawInput != "":
-			fmt.Println(r.RawInput)
-		default:
-			fmt.Println(r.Id)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, RawInput: r.RawInput, Err: r.Err})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(killFormat, reports)
 }
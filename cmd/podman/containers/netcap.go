@@ -0,0 +1,105 @@
+package containers
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/libpod/shutdown"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/netcap"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var (
+	netcapDescription = `Captures the packets in the network namespace of a running container and writes them in the pcapng format.
+
+  The capture runs until it is interrupted or the given number of packets was captured.`
+
+	netcapCommand = &cobra.Command{
+		Use:               "netcap [options] CONTAINER",
+		Short:             "Capture the network packets of a container",
+		Long:              netcapDescription,
+		RunE:              netcapCapture,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteContainersRunning,
+		Example: `podman container netcap -w web.pcapng web
+  podman container netcap -i eth0 --filter "tcp port 443" -c 100 -w https.pcapng ctrID
+  podman container netcap ctrID | wireshark -k -i -`,
+	}
+)
+
+var (
+	netcapOpts      entities.ContainerNetCaptureOptions
+	netcapWriteFile string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: netcapCommand,
+		Parent:  containerCmd,
+	})
+	flags := netcapCommand.Flags()
+
+	interfaceFlagName := "interface"
+	flags.StringVarP(&netcapOpts.Interface, interfaceFlagName, "i", "", "Capture packets on the `interface` in the container (default: all interfaces)")
+	_ = netcapCommand.RegisterFlagCompletionFunc(interfaceFlagName, completion.AutocompleteNone)
+
+	filterFlagName := "filter"
+	flags.StringVar(&netcapOpts.Filter, filterFlagName, "", "Only capture packets matching the pcap-filter `expression`")
+	_ = netcapCommand.RegisterFlagCompletionFunc(filterFlagName, completion.AutocompleteNone)
+
+	writeFlagName := "write"
+	flags.StringVarP(&netcapWriteFile, writeFlagName, "w", "", "Write the packets to `file` (default: stdout, which must be redirected)")
+	_ = netcapCommand.RegisterFlagCompletionFunc(writeFlagName, completion.AutocompleteDefault)
+
+	countFlagName := "count"
+	flags.Uint64VarP(&netcapOpts.Count, countFlagName, "c", 0, "Stop after capturing `count` packets")
+	_ = netcapCommand.RegisterFlagCompletionFunc(countFlagName, completion.AutocompleteNone)
+
+	snaplenFlagName := "snaplen"
+	flags.Uint32VarP(&netcapOpts.Snaplen, snaplenFlagName, "s", netcap.DefaultSnaplen, "Capture at most `bytes` of each packet")
+	_ = netcapCommand.RegisterFlagCompletionFunc(snaplenFlagName, completion.AutocompleteNone)
+}
+
+func netcapCapture(cmd *cobra.Command, args []string) error {
+	// check the filter before touching the output file
+	if _, err := netcap.Compile(netcapOpts.Filter); err != nil {
+		return err
+	}
+
+	if netcapWriteFile == "" || netcapWriteFile == "-" {
+		file := os.Stdout
+		if term.IsTerminal(int(file.Fd())) {
+			return errors.New("refusing to write packets to terminal. Use -w flag or redirect")
+		}
+		netcapOpts.Output = file
+	} else {
+		if err := parse.ValidateFileName(netcapWriteFile); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(netcapWriteFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		netcapOpts.Output = file
+	}
+
+	// stop the capture on ctrl+c instead of exiting, like tcpdump does
+	if err := shutdown.Stop(); err != nil && !errors.Is(err, shutdown.ErrNotStarted) {
+		return err
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	return registry.ContainerEngine().ContainerNetCapture(ctx, strings.TrimPrefix(args[0], "/"), netcapOpts)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
@@ -51,6 +52,7 @@ var (
 		Filters: make(map[string][]string),
 	}
 	pauseCidFiles = []string{}
+	pauseFormat   string
 )
 
 func pauseFlags(cmd *cobra.Command) {
@@ -66,6 +68,10 @@ func pauseFlags(cmd *cobra.Command) {
 	flags.StringArrayVarP(&filters, filterFlagName, "f", []string{}, "Filter output based on conditions given")
 	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
 
+	formatFlagName := "format"
+	flags.StringVar(&pauseFormat, formatFlagName, "", "Print a report for each container in the given format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
+
 	if registry.IsRemote() {
 		_ = flags.MarkHidden("cidfile")
 	}
@@ -87,9 +93,9 @@ func init() {
 }
 
 func pause(cmd *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(pauseFormat); err != nil {
+		return err
+	}
 	args = utils.RemoveSlash(args)
 
 	for _, cidFile := range pauseCidFiles {
@@ -102,7 +108,7 @@ func pause(cmd *cobra.Command, args []string) error {
 	}
 
 	for _, f := range filters {
-		fname, filter, hasFilter := strings.Cut(f, "=")
+		fname, filter, hasFilter := parse.SplitFilter(f)
 		if !hasFilter {
 			return fmt.Errorf("invalid filter %q", f)
 		}
@@ -113,15 +119,9 @@ func pause(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		switch {
-		case r.Err != nil:
-			errs = append(errs, r.Err)
-		case r.RawInput != "":
-			fmt.Println(r.RawInput)
-		default:
-			fmt.Println(r.Id)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, RawInput: r.RawInput, Err: r.Err})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(pauseFormat, reports)
 }
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/validate"
@@ -129,9 +130,7 @@ func port(_ *cobra.Command, args []string) error {
 			for _, protocol := range protocols {
 				// If not searching by port or port/proto, then dump what we see
 				if port == "" {
-					for i := uint16(0); i < v.Range; i++ {
-						fmt.Printf("%s%d/%s -> %s:%d\n", allPrefix, v.ContainerPort+i, protocol, hostIP, v.HostPort+i)
-					}
+					printPortMapping(allPrefix, hostIP, protocol, v)
 					continue
 				}
 				// check if the proto matches and if the port is in the range
@@ -153,3 +152,10 @@ func port(_ *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// printPortMapping prints all ports of the mapping for the given protocol.
+func printPortMapping(prefix, hostIP, protocol string, v types.PortMapping) {
+	for i := uint16(0); i < v.Range; i++ {
+		fmt.Printf("%s%d/%s -> %s:%d\n", prefix, v.ContainerPort+i, protocol, hostIP, v.HostPort+i)
+	}
+}
@@ -0,0 +1,119 @@
+package containers
+
+import (
+	"strings"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	portAddDescription = `Publish additional ports of a container.
+
+  The ports of a running container are changed without restarting it. Ports use the format of the --publish option of podman run.`
+	portAddCommand = &cobra.Command{
+		Use:               "add CONTAINER PORT [PORT...]",
+		Short:             "Publish additional ports of a container",
+		Long:              portAddDescription,
+		RunE:              portAdd,
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: portUpdateArgsCompletion,
+		Example: `podman port add ctrID 8080:80/tcp
+  podman port add ctrID 127.0.0.1:5353:53/udp 9000-9002:9000-9002`,
+	}
+
+	containerPortAddCommand = &cobra.Command{
+		Use:               portAddCommand.Use,
+		Short:             portAddCommand.Short,
+		Long:              portAddCommand.Long,
+		RunE:              portAddCommand.RunE,
+		Args:              portAddCommand.Args,
+		ValidArgsFunction: portAddCommand.ValidArgsFunction,
+		Example:           `podman container port add ctrID 8080:80/tcp`,
+	}
+
+	portRmDescription = `Unpublish ports of a container.
+
+  The ports of a running container are changed without restarting it. Without host port, all mappings of the container port are removed.`
+	portRmCommand = &cobra.Command{
+		Use:               "rm CONTAINER PORT [PORT...]",
+		Short:             "Unpublish ports of a container",
+		Long:              portRmDescription,
+		RunE:              portRm,
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: portUpdateArgsCompletion,
+		Example: `podman port rm ctrID 80/tcp
+  podman port rm ctrID 8080:80`,
+	}
+
+	containerPortRmCommand = &cobra.Command{
+		Use:               portRmCommand.Use,
+		Short:             portRmCommand.Short,
+		Long:              portRmCommand.Long,
+		RunE:              portRmCommand.RunE,
+		Args:              portRmCommand.Args,
+		ValidArgsFunction: portRmCommand.ValidArgsFunction,
+		Example:           `podman container port rm ctrID 80/tcp`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: portAddCommand,
+		Parent:  portCommand,
+	})
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: containerPortAddCommand,
+		Parent:  containerPortCommand,
+	})
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: portRmCommand,
+		Parent:  portCommand,
+	})
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: containerPortRmCommand,
+		Parent:  containerPortCommand,
+	})
+}
+
+func portUpdateArgsCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return common.AutocompleteContainers(cmd, args, toComplete)
+	}
+	return completion.AutocompleteNone(cmd, args, toComplete)
+}
+
+func portAdd(_ *cobra.Command, args []string) error {
+	report, err := registry.ContainerEngine().ContainerPortAdd(registry.GetContext(), strings.TrimPrefix(args[0], "/"), args[1:])
+	if err != nil {
+		return err
+	}
+	printPortReport(report)
+	return nil
+}
+
+func portRm(_ *cobra.Command, args []string) error {
+	report, err := registry.ContainerEngine().ContainerPortRm(registry.GetContext(), strings.TrimPrefix(args[0], "/"), args[1:])
+	if err != nil {
+		return err
+	}
+	printPortReport(report)
+	return nil
+}
+
+// printPortReport prints the port mappings of the container after the change,
+// in the same format as podman port.
+func printPortReport(report *entities.ContainerPortReport) {
+	for _, v := range report.Ports {
+		hostIP := v.HostIP
+		if hostIP == "" {
+			hostIP = "0.0.0.0"
+		}
+		for _, protocol := range strings.Split(v.Protocol, ",") {
+			printPortMapping("", hostIP, protocol, v)
+		}
+	}
+}
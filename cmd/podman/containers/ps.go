@@ -13,6 +13,7 @@ import (
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/common/pkg/report"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
@@ -195,7 +196,7 @@ func ps(cmd *cobra.Command, _ []string) error {
 	}
 
 	for _, f := range filters {
-		fname, filter, hasFilter := strings.Cut(f, "=")
+		fname, filter, hasFilter := parse.SplitFilter(f)
 		if !hasFilter {
 			return fmt.Errorf("invalid filter %q", f)
 		}
@@ -366,11 +367,17 @@ func (l psReporter) Pod() string {
 // Status returns the container status in the default ps output format.
 func (l psReporter) Status() string {
 	var state string
-	switch l.ListContainer.State {
-	case "running":
+	switch {
+	case l.RestartingAt > 0:
+		left := time.Until(time.Unix(l.RestartingAt, 0)).Round(time.Second)
+		if left < 0 {
+			left = 0
+		}
+		state = fmt.Sprintf("Restarting (backoff %s)", left)
+	case l.ListContainer.State == "running":
 		t := units.HumanDuration(time.Since(time.Unix(l.StartedAt, 0)))
 		state = "Up " + t
-	case "exited", "stopped":
+	case l.ListContainer.State == "exited" || l.ListContainer.State == "stopped":
 		t := units.HumanDuration(time.Since(time.Unix(l.ExitedAt, 0)))
 		state = fmt.Sprintf("Exited (%d) %s ago", l.ExitCode, t)
 	default:
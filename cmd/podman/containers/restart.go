@@ -8,6 +8,7 @@ import (
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
@@ -50,6 +51,7 @@ var (
 		Filters: make(map[string][]string),
 	}
 	restartCidFiles = []string{}
+	restartFormat   string
 	restartTimeout  int
 )
 
@@ -67,6 +69,10 @@ func restartFlags(cmd *cobra.Command) {
 	flags.StringArrayVarP(&filters, filterFlagName, "f", []string{}, "Filter output based on conditions given")
 	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
 
+	formatFlagName := "format"
+	flags.StringVar(&restartFormat, formatFlagName, "", "Print a report for each container in the given format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
+
 	timeFlagName := "time"
 	flags.IntVarP(&restartTimeout, timeFlagName, "t", int(containerConfig.Engine.StopTimeout), "Seconds to wait for stop before killing the container")
 	_ = cmd.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
@@ -94,9 +100,9 @@ func init() {
 }
 
 func restart(cmd *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(restartFormat); err != nil {
+		return err
+	}
 	args = utils.RemoveSlash(args)
 
 	if cmd.Flag("time").Changed {
@@ -114,7 +120,7 @@ func restart(cmd *cobra.Command, args []string) error {
 	}
 
 	for _, f := range filters {
-		fname, filter, hasFilter := strings.Cut(f, "=")
+		fname, filter, hasFilter := parse.SplitFilter(f)
 		if !hasFilter {
 			return fmt.Errorf("invalid filter %q", f)
 		}
@@ -125,15 +131,9 @@ func restart(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		switch {
-		case r.Err != nil:
-			errs = append(errs, r.Err)
-		case r.RawInput != "":
-			fmt.Println(r.RawInput)
-		default:
-			fmt.Println(r.Id)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, RawInput: r.RawInput, Err: r.Err})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(restartFormat, reports)
 }
@@ -0,0 +1,463 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/copy"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/util"
+	"github.com/containers/storage/pkg/fileutils"
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncDescription = `Synchronize the contents of HOST_DIR to DIR in the container, or the contents of DIR in the container to HOST_DIR.
+
+  Only files which are missing or differ in size, modification time or mode at the destination are copied. With --watch, changes to the source are copied as they happen. Files matched by the .containerignore or .dockerignore file of HOST_DIR are not synchronized.
+`
+	syncCommand = &cobra.Command{
+		Use:               "sync [options] HOST_DIR CONTAINER:DIR | CONTAINER:DIR HOST_DIR",
+		Short:             "Synchronize a directory between the host and a container",
+		Long:              syncDescription,
+		Args:              cobra.ExactArgs(2),
+		RunE:              syncDir,
+		ValidArgsFunction: common.AutocompleteCpCommand,
+		Example: `podman sync ./src myctr:/app/src
+  podman sync --watch --delete --exclude '*.tmp' . myctr:/app
+  podman sync myctr:/app/dist ./dist`,
+	}
+
+	containerSyncCommand = &cobra.Command{
+		Use:               syncCommand.Use,
+		Short:             syncCommand.Short,
+		Long:              syncCommand.Long,
+		Args:              syncCommand.Args,
+		RunE:              syncCommand.RunE,
+		ValidArgsFunction: syncCommand.ValidArgsFunction,
+		Example: `podman container sync ./src myctr:/app/src
+  podman container sync --watch --delete --exclude '*.tmp' . myctr:/app
+  podman container sync myctr:/app/dist ./dist`,
+	}
+)
+
+var (
+	syncOpts struct {
+		Delete     bool
+		Excludes   []string
+		IgnoreFile string
+		Watch      bool
+	}
+)
+
+// syncDebounce is the time to wait for more changes before copying them.
+const syncDebounce = 200 * time.Millisecond
+
+// syncPollInterval is the time between two synchronizations of a directory
+// in a container to the host with --watch, as changes in the container
+// cannot be watched through the archive API.
+const syncPollInterval = time.Second
+
+func syncFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.BoolVar(&syncOpts.Delete, "delete", false, "Remove files at the destination which do not exist in the source")
+	flags.BoolVarP(&syncOpts.Watch, "watch", "w", false, "Keep running and copy changes as they happen")
+
+	excludeFlagName := "exclude"
+	flags.StringArrayVar(&syncOpts.Excludes, excludeFlagName, nil, "Do not synchronize paths matching `pattern`")
+	_ = cmd.RegisterFlagCompletionFunc(excludeFlagName, completion.AutocompleteNone)
+
+	ignoreFileFlagName := "ignorefile"
+	flags.StringVar(&syncOpts.IgnoreFile, ignoreFileFlagName, "", "Path to an alternate .containerignore file")
+	_ = cmd.RegisterFlagCompletionFunc(ignoreFileFlagName, completion.AutocompleteDefault)
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: syncCommand,
+	})
+	syncFlags(syncCommand)
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: containerSyncCommand,
+		Parent:  containerCmd,
+	})
+	syncFlags(containerSyncCommand)
+}
+
+// dirSync synchronizes a directory on the host and a directory in a
+// container.
+type dirSync struct {
+	hostDir   string
+	container string
+	ctrDir    string
+	ignore    *fileutils.PatternMatcher
+	// toHost is set if the directory in the container is the source.
+	toHost bool
+}
+
+func syncDir(cmd *cobra.Command, args []string) error {
+	sourceContainer, sourcePath, destContainer, destPath, err := copy.ParseSourceAndDestination(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	var hostDir, container, ctrDir string
+	switch {
+	case len(sourceContainer) == 0 && len(destContainer) > 0:
+		hostDir, container, ctrDir = sourcePath, destContainer, destPath
+	case len(sourceContainer) > 0 && len(destContainer) == 0:
+		hostDir, container, ctrDir = destPath, sourceContainer, sourcePath
+	default:
+		return errors.New("one directory must be on the host and the other one in a container")
+	}
+
+	info, err := os.Stat(hostDir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%q is not a directory", hostDir)
+	}
+	ctrInfo, err := registry.ContainerEngine().ContainerStat(registry.GetContext(), container, ctrDir)
+	if err != nil {
+		return fmt.Errorf("%q could not be found on container %s: %w", ctrDir, container, err)
+	}
+	if !ctrInfo.IsDir {
+		return fmt.Errorf("%q is not a directory on container %s", ctrDir, container)
+	}
+
+	patterns, err := syncIgnorePatterns(hostDir)
+	if err != nil {
+		return err
+	}
+	ignore, err := fileutils.NewPatternMatcher(patterns)
+	if err != nil {
+		return err
+	}
+
+	s := &dirSync{
+		hostDir:   hostDir,
+		container: container,
+		ctrDir:    ctrInfo.LinkTarget,
+		ignore:    ignore,
+		toHost:    len(sourceContainer) > 0,
+	}
+	if err := s.sync(); err != nil {
+		return err
+	}
+	switch {
+	case !syncOpts.Watch:
+		return nil
+	case s.toHost:
+		return s.poll()
+	default:
+		return s.watch()
+	}
+}
+
+// syncIgnorePatterns returns the patterns of the ignore file and --exclude.
+func syncIgnorePatterns(hostDir string) ([]string, error) {
+	var patterns []string
+	if syncOpts.IgnoreFile != "" {
+		content, err := os.ReadFile(syncOpts.IgnoreFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(string(content), "\n") {
+			if len(line) == 0 || line[0] == '#' {
+				continue
+			}
+			patterns = append(patterns, line)
+		}
+	} else {
+		excludes, _, err := util.ParseDockerignore(nil, hostDir)
+		if err != nil {
+			return nil, err
+		}
+		patterns = excludes
+	}
+	return append(patterns, syncOpts.Excludes...), nil
+}
+
+// sync copies all files which are missing or outdated at the destination.
+func (s *dirSync) sync() error {
+	ctrEntries, err := s.containerEntries()
+	if err != nil {
+		return err
+	}
+	hostEntries, err := copy.HostSyncEntries(s.hostDir, s.ignore)
+	if err != nil {
+		return err
+	}
+
+	if s.toHost {
+		changed, removed := copy.SyncChanges(ctrEntries, hostEntries, s.ignore)
+		if err := s.pull(changed, ctrEntries, hostEntries); err != nil {
+			return err
+		}
+		if syncOpts.Delete && len(removed) > 0 {
+			logrus.Debugf("Removing %d files from %s", len(removed), s.hostDir)
+			return copy.RemoveSyncPaths(s.hostDir, removed)
+		}
+		return nil
+	}
+
+	changed, removed := copy.SyncChanges(hostEntries, ctrEntries, s.ignore)
+	if !syncOpts.Delete {
+		removed = nil
+	}
+	return s.push(changed, removed)
+}
+
+// containerEntries lists the files of the directory in the container which
+// are not ignored.
+func (s *dirSync) containerEntries() (map[string]copy.SyncEntry, error) {
+	// Ignored files are filtered here rather than by the container, as
+	// ignore files are anchored at the synced directory while --exclude
+	// patterns of the archive API are not.
+	var entries map[string]copy.SyncEntry
+	err := s.readContainerArchive(strings.TrimSuffix(s.ctrDir, "/")+"/.", entities.CopyToArchiveOptions{NoContents: true}, func(r io.Reader) error {
+		var err error
+		entries, err = copy.ReadSyncEntries(r)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing the files of %q on container %s: %w", s.ctrDir, s.container, err)
+	}
+	for name := range entries {
+		if copy.IsIgnored(s.ignore, name) {
+			delete(entries, name)
+		}
+	}
+	return entries, nil
+}
+
+// readContainerArchive passes an archive of ctrPath in the container to
+// read.
+func (s *dirSync) readContainerArchive(ctrPath string, options entities.CopyToArchiveOptions, read func(io.Reader) error) error {
+	reader, writer := io.Pipe()
+	copyFunc, err := registry.ContainerEngine().ContainerCopyToArchive(registry.GetContext(), s.container, ctrPath, writer, options)
+	if err != nil {
+		return err
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		err := copyFunc()
+		writer.CloseWithError(err)
+		errChan <- err
+	}()
+	err = read(reader)
+	reader.Close()
+	if copyErr := <-errChan; err == nil && copyErr != nil && !errors.Is(copyErr, io.ErrClosedPipe) {
+		err = copyErr
+	}
+	return err
+}
+
+// push copies the files changed, paths relative to the synced directories,
+// to the container and removes the files removed there.
+func (s *dirSync) push(changed, removed []string) error {
+	if len(changed) == 0 && len(removed) == 0 {
+		return nil
+	}
+	logrus.Debugf("Syncing %d files to and removing %d files from %s:%s", len(changed), len(removed), s.container, s.ctrDir)
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(copy.WriteSyncArchive(writer, s.hostDir, changed))
+	}()
+	defer reader.Close()
+
+	copyOptions := entities.CopyOptions{Chown: true, Remove: removed}
+	copyFunc, err := registry.ContainerEngine().ContainerCopyFromArchive(registry.GetContext(), s.container, s.ctrDir, reader, copyOptions)
+	if err != nil {
+		return err
+	}
+	return copyFunc()
+}
+
+// pull copies the files changed, paths relative to the synced directories,
+// from the container to the host.  Directories missing on the host are
+// copied as a whole, the other files one by one.
+func (s *dirSync) pull(changed []string, ctrEntries, hostEntries map[string]copy.SyncEntry) error {
+	if len(changed) == 0 {
+		return nil
+	}
+	logrus.Debugf("Syncing %d files from %s:%s", len(changed), s.container, s.ctrDir)
+
+	for i := 0; i < len(changed); i++ {
+		name := changed[i]
+		if ctrEntries[name].Mode&fs.ModeSymlink != 0 {
+			// Copying a symbolic link from the container would copy
+			// its target.
+			if err := copy.CreateSyncSymlink(s.hostDir, name, ctrEntries[name].Linkname); err != nil {
+				return err
+			}
+			continue
+		}
+		if ctrEntries[name].Mode.IsDir() {
+			if hostEntries[name].Mode.IsDir() {
+				// Only the permissions differ.
+				if err := os.Chmod(filepath.Join(s.hostDir, filepath.FromSlash(name)), ctrEntries[name].Mode); err != nil {
+					return err
+				}
+				continue
+			}
+			// Sorting puts the contents right after the directory.
+			for i+1 < len(changed) && strings.HasPrefix(changed[i+1], name+"/") {
+				i++
+			}
+		}
+		keep := func(entry string) bool {
+			return (entry == name || strings.HasPrefix(entry, name+"/")) && !copy.IsIgnored(s.ignore, entry)
+		}
+		err := s.readContainerArchive(path.Join(s.ctrDir, name), entities.CopyToArchiveOptions{}, func(r io.Reader) error {
+			return copy.ExtractSyncArchive(r, s.hostDir, path.Dir(name), keep)
+		})
+		if err != nil {
+			return fmt.Errorf("copying %q from container %s: %w", path.Join(s.ctrDir, name), s.container, err)
+		}
+	}
+	return nil
+}
+
+// poll copies changes of the directory in the container to the host until
+// the command is interrupted.
+func (s *dirSync) poll() error {
+	ticker := time.NewTicker(syncPollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := s.sync(); err != nil {
+			// Keep polling, a later change may fix it.
+			logrus.Errorf("Syncing %s:%s to %s: %v", s.container, s.ctrDir, s.hostDir, err)
+		}
+	}
+	return nil
+}
+
+// watch copies changes of the host directory to the container until the
+// command is interrupted.
+func (s *dirSync) watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	pending := make(map[string]struct{})
+	// addTree watches the directory name and the ones below it.  All
+	// files found are marked as changed, as they may have been created
+	// before the watch was in place.
+	addTree := func(name string) error {
+		return filepath.WalkDir(filepath.Join(s.hostDir, filepath.FromSlash(name)), func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				// The file may be gone already.
+				if errors.Is(err, os.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			rel, err := s.relPath(p)
+			if err != nil {
+				return err
+			}
+			if rel != "." && copy.IsIgnored(s.ignore, rel) {
+				if d.IsDir() && !s.ignore.Exclusions() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if rel != name {
+				pending[rel] = struct{}{}
+			}
+			if d.IsDir() {
+				return watcher.Add(p)
+			}
+			return nil
+		})
+	}
+	if err := addTree("."); err != nil {
+		return err
+	}
+	// Nothing changed yet.
+	pending = make(map[string]struct{})
+
+	timer := time.NewTimer(syncDebounce)
+	timer.Stop()
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			rel, err := s.relPath(event.Name)
+			if err != nil || rel == "." || copy.IsIgnored(s.ignore, rel) {
+				continue
+			}
+			pending[rel] = struct{}{}
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Lstat(event.Name); err == nil && info.IsDir() {
+					if err := addTree(rel); err != nil {
+						logrus.Warnf("Failed to watch %s: %v", event.Name, err)
+					}
+				}
+			}
+			timer.Reset(syncDebounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logrus.Warnf("Watching %s: %v", s.hostDir, err)
+		case <-timer.C:
+			if err := s.flush(pending); err != nil {
+				// Keep watching, a later change may fix it.
+				logrus.Errorf("Syncing %s to %s:%s: %v", s.hostDir, s.container, s.ctrDir, err)
+			}
+			pending = make(map[string]struct{})
+		}
+	}
+}
+
+// flush copies the pending changes to the container.
+func (s *dirSync) flush(pending map[string]struct{}) error {
+	var changed, removed []string
+	for name := range pending {
+		if _, err := os.Lstat(filepath.Join(s.hostDir, filepath.FromSlash(name))); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				removed = append(removed, name)
+				continue
+			}
+			return err
+		}
+		changed = append(changed, name)
+	}
+	sort.Strings(changed)
+	sort.Strings(removed)
+	if !syncOpts.Delete {
+		removed = nil
+	}
+	return s.push(changed, removed)
+}
+
+// relPath returns the slash-separated path of p relative to the host
+// directory.
+func (s *dirSync) relPath(p string) (string, error) {
+	rel, err := filepath.Rel(s.hostDir, p)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
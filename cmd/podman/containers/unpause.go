@@ -10,6 +10,7 @@ import (
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
@@ -52,6 +53,7 @@ var (
 		Filters: make(map[string][]string),
 	}
 	unpauseCidFiles = []string{}
+	unpauseFormat   string
 )
 
 func unpauseFlags(cmd *cobra.Command) {
@@ -67,6 +69,10 @@ func unpauseFlags(cmd *cobra.Command) {
 	flags.StringArrayVarP(&filters, filterFlagName, "f", []string{}, "Filter output based on conditions given")
 	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
 
+	formatFlagName := "format"
+	flags.StringVar(&unpauseFormat, formatFlagName, "", "Print a report for each container in the given format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
+
 	if registry.IsRemote() {
 		_ = flags.MarkHidden("cidfile")
 	}
@@ -88,9 +94,9 @@ func init() {
 }
 
 func unpause(cmd *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(unpauseFormat); err != nil {
+		return err
+	}
 	args = utils.RemoveSlash(args)
 
 	if rootless.IsRootless() && !registry.IsRemote() {
@@ -110,7 +116,7 @@ func unpause(cmd *cobra.Command, args []string) error {
 	}
 
 	for _, f := range filters {
-		fname, filter, hasFilter := strings.Cut(f, "=")
+		fname, filter, hasFilter := parse.SplitFilter(f)
 		if !hasFilter {
 			return fmt.Errorf("invalid filter %q", f)
 		}
@@ -122,15 +128,9 @@ func unpause(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		switch {
-		case r.Err != nil:
-			errs = append(errs, r.Err)
-		case r.RawInput != "":
-			fmt.Println(r.RawInput)
-		default:
-			fmt.Println(r.Id)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, RawInput: r.RawInput, Err: r.Err})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(unpauseFormat, reports)
 }
@@ -2,29 +2,42 @@ package containers
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"strings"
 
+	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var (
 	updateDescription = `Updates the cgroup configuration of a given container`
 
 	updateCommand = &cobra.Command{
-		Use:               "update [options] CONTAINER",
-		Short:             "Update an existing container",
-		Long:              updateDescription,
-		RunE:              update,
-		Args:              cobra.ExactArgs(1),
+		Use:   "update [options] CONTAINER",
+		Short: "Update an existing container",
+		Long:  updateDescription,
+		RunE:  update,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("filter") {
+				if len(args) > 0 {
+					return errors.New("--filter takes no arguments")
+				}
+				return nil
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		ValidArgsFunction: common.AutocompleteContainers,
-		Example:           `podman update --cpus=5 foobar_container`,
+		Example: `podman update --cpus=5 foobar_container
+  podman update --memory=1g --filter label=tier=web`,
 	}
 
 	containerUpdateCommand = &cobra.Command{
@@ -41,9 +54,26 @@ var (
 	updateOpts entities.ContainerCreateOptions
 )
 
+var (
+	networkRates []string
+	updateFormat string
+)
+
 func updateFlags(cmd *cobra.Command) {
 	common.DefineCreateDefaults(&updateOpts)
 	common.DefineCreateFlags(cmd, &updateOpts, entities.UpdateMode)
+
+	networkRateFlagName := "network-rate"
+	cmd.Flags().StringArrayVar(&networkRates, networkRateFlagName, nil, "Change the network bandwidth limits of the container ([network:]ingress=rate,egress=rate)")
+	_ = cmd.RegisterFlagCompletionFunc(networkRateFlagName, completion.AutocompleteNone)
+
+	filterFlagName := "filter"
+	cmd.Flags().StringArrayVarP(&filters, filterFlagName, "f", []string{}, "Update the containers matching the given conditions")
+	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
+
+	formatFlagName := "format"
+	cmd.Flags().StringVar(&updateFormat, formatFlagName, "", "Print a report for each container in the given format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
 }
 
 func init() {
@@ -61,6 +91,9 @@ func init() {
 
 func update(cmd *cobra.Command, args []string) error {
 	var err error
+	if err := utils.ValidateBulkFormat(updateFormat); err != nil {
+		return err
+	}
 	// use a specgen since this is the easiest way to hold resource info
 	s := &specgen.SpecGenerator{}
 	s.ResourceLimits = &specs.LinuxResources{}
@@ -77,13 +110,46 @@ func update(cmd *cobra.Command, args []string) error {
 	}
 
 	opts := &entities.ContainerUpdateOptions{
-		NameOrID: strings.TrimPrefix(args[0], "/"),
-		Specgen:  s,
+		Specgen: s,
 	}
-	rep, err := registry.ContainerEngine().ContainerUpdate(context.Background(), opts)
+	if cmd.Flags().Changed("network-rate") {
+		opts.NetworkRates, err = specgenutil.ParseNetworkRates(networkRates)
+		if err != nil {
+			return err
+		}
+		// leave the resources alone when only the network rates change
+		onlyRates := true
+		cmd.LocalFlags().Visit(func(f *pflag.Flag) {
+			if f.Name != "network-rate" && f.Name != "filter" && f.Name != "format" {
+				onlyRates = false
+			}
+		})
+		if onlyRates {
+			opts.Specgen = nil
+		}
+	}
+	if len(filters) == 0 {
+		opts.NameOrID = strings.TrimPrefix(args[0], "/")
+		rep, err := registry.ContainerEngine().ContainerUpdate(context.Background(), opts)
+		if err != nil {
+			return err
+		}
+		return utils.PrintBulkReports(updateFormat, []utils.BulkReport{{Id: rep}})
+	}
+
+	filterMap, err := parse.FilterArgumentsIntoFilters(filters)
 	if err != nil {
 		return err
 	}
-	fmt.Println(rep)
-	return nil
+	ids, err := utils.ContainerIDsByFilters(filterMap, true)
+	if err != nil {
+		return err
+	}
+	reports := utils.RunBulkOp(ids, func(id string) utils.BulkReport {
+		ctrOpts := *opts
+		ctrOpts.NameOrID = id
+		_, err := registry.ContainerEngine().ContainerUpdate(context.Background(), &ctrOpts)
+		return utils.BulkReport{Id: id, Err: err}
+	})
+	return utils.PrintBulkReports(updateFormat, reports)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/cmd/podman/validate"
@@ -16,7 +17,7 @@ import (
 )
 
 var (
-	waitDescription = `Block until one or more containers stop and then print their exit codes.
+	waitDescription = `Block until one or more containers stop, or meet other conditions, and then print their exit codes.
 `
 	waitCommand = &cobra.Command{
 		Use:               "wait [options] CONTAINER [CONTAINER...]",
@@ -25,7 +26,8 @@ var (
 		RunE:              wait,
 		ValidArgsFunction: common.AutocompleteContainers,
 		Example: `podman wait --interval 5s ctrID
-  podman wait ctrID1 ctrID2`,
+  podman wait ctrID1 ctrID2
+  podman wait --timeout 1m --until-log "ready to accept connections" --until-port 5432 ctrID`,
 	}
 
 	containerWaitCommand = &cobra.Command{
@@ -35,13 +37,18 @@ var (
 		RunE:              waitCommand.RunE,
 		ValidArgsFunction: waitCommand.ValidArgsFunction,
 		Example: `podman container wait --interval 5s ctrID
-  podman container wait ctrID1 ctrID2`,
+  podman container wait ctrID1 ctrID2
+  podman container wait --timeout 1m --until-log "ready to accept connections" --until-port 5432 ctrID`,
 	}
 )
 
 var (
-	waitOptions  = entities.WaitOptions{}
+	waitOptions = entities.WaitOptions{
+		Filters: make(map[string][]string),
+	}
 	waitInterval string
+	waitTimeout  string
+	waitFormat   string
 )
 
 func waitFlags(cmd *cobra.Command) {
@@ -56,6 +63,28 @@ func waitFlags(cmd *cobra.Command) {
 	conditionFlagName := "condition"
 	flags.StringSliceVar(&waitOptions.Conditions, conditionFlagName, []string{}, "Condition to wait on")
 	_ = cmd.RegisterFlagCompletionFunc(conditionFlagName, common.AutocompleteWaitCondition)
+
+	timeoutFlagName := "timeout"
+	flags.StringVar(&waitTimeout, timeoutFlagName, "0", "Maximum time to wait, 0 to wait forever")
+	_ = cmd.RegisterFlagCompletionFunc(timeoutFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&waitOptions.Any, "any", false, "Return once any of the containers is done waiting instead of all of them")
+
+	untilLogFlagName := "until-log"
+	flags.StringVar(&waitOptions.UntilLog, untilLogFlagName, "", "Wait until the container logs a line matching the regular expression")
+	_ = cmd.RegisterFlagCompletionFunc(untilLogFlagName, completion.AutocompleteNone)
+
+	untilPortFlagName := "until-port"
+	flags.Uint16Var(&waitOptions.UntilPort, untilPortFlagName, 0, "Wait until the TCP port accepts connections inside the container")
+	_ = cmd.RegisterFlagCompletionFunc(untilPortFlagName, completion.AutocompleteNone)
+
+	filterFlagName := "filter"
+	flags.StringArrayVarP(&filters, filterFlagName, "f", []string{}, "Filter output based on conditions given")
+	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
+
+	formatFlagName := "format"
+	flags.StringVar(&waitFormat, formatFlagName, "", "Print a report for each container in the given format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
 }
 
 func init() {
@@ -74,10 +103,10 @@ func init() {
 }
 
 func wait(cmd *cobra.Command, args []string) error {
-	var (
-		err  error
-		errs utils.OutputErrors
-	)
+	var err error
+	if err := utils.ValidateBulkFormat(waitFormat); err != nil {
+		return err
+	}
 	args = utils.RemoveSlash(args)
 	if waitOptions.Interval, err = time.ParseDuration(waitInterval); err != nil {
 		var err1 error
@@ -85,24 +114,50 @@ func wait(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	}
-
-	if !waitOptions.Latest && len(args) == 0 {
-		return fmt.Errorf("%q requires a name, id, or the \"--latest\" flag", cmd.CommandPath())
+	if waitOptions.Timeout, err = time.ParseDuration(waitTimeout); err != nil {
+		var err1 error
+		if waitOptions.Timeout, err1 = time.ParseDuration(waitTimeout + "s"); err1 != nil {
+			return err
+		}
 	}
-	if waitOptions.Latest && len(args) > 0 {
-		return errors.New("--latest and containers cannot be used together")
+	if waitOptions.Timeout < 0 {
+		return errors.New("--timeout must not be negative")
+	}
+
+	if len(filters) > 0 {
+		if len(args) > 0 || waitOptions.Latest {
+			return errors.New("--filter takes no arguments")
+		}
+		for _, f := range filters {
+			fname, filter, hasFilter := parse.SplitFilter(f)
+			if !hasFilter {
+				return fmt.Errorf("invalid filter %q", f)
+			}
+			waitOptions.Filters[fname] = append(waitOptions.Filters[fname], filter)
+		}
+	} else {
+		if !waitOptions.Latest && len(args) == 0 {
+			return fmt.Errorf("%q requires a name, id, or the \"--latest\" flag", cmd.CommandPath())
+		}
+		if waitOptions.Latest && len(args) > 0 {
+			return errors.New("--latest and containers cannot be used together")
+		}
 	}
 
+	// Print the container instead of the exit code if it was only waited
+	// for to log or listen.
+	printExitCode := len(waitOptions.Conditions) > 0 || (waitOptions.UntilLog == "" && waitOptions.UntilPort == 0)
 	responses, err := registry.ContainerEngine().ContainerWait(context.Background(), args, waitOptions)
 	if err != nil {
 		return err
 	}
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		if r.Error == nil {
-			fmt.Println(r.ExitCode)
-		} else {
-			errs = append(errs, r.Error)
+		report := utils.BulkReport{Id: r.Id, RawInput: r.RawInput, Err: r.Error}
+		if r.Error == nil && printExitCode {
+			report.Result = r.ExitCode
 		}
+		reports = append(reports, report)
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(waitFormat, reports)
 }
@@ -2,25 +2,41 @@ package healthcheck
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 )
 
 var (
 	runCmd = &cobra.Command{
-		Use:               "run CONTAINER",
-		Short:             "Run the health check of a container",
-		Long:              "Run the health check of a container",
-		Example:           `podman healthcheck run mywebapp`,
-		RunE:              run,
-		Args:              cobra.ExactArgs(1),
+		Use:   "run [options] CONTAINER",
+		Short: "Run the health check of a container",
+		Long:  "Run the health check of a container",
+		Example: `podman healthcheck run mywebapp
+  podman healthcheck run --filter label=tier=web`,
+		RunE: run,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("filter") {
+				if len(args) > 0 {
+					return errors.New("--filter takes no arguments")
+				}
+				return nil
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		ValidArgsFunction: common.AutocompleteContainersRunning,
 	}
+
+	runFilters []string
+	runFormat  string
 )
 
 func init() {
@@ -28,16 +44,62 @@ func init() {
 		Command: runCmd,
 		Parent:  healthCmd,
 	})
+	flags := runCmd.Flags()
+
+	filterFlagName := "filter"
+	flags.StringArrayVarP(&runFilters, filterFlagName, "f", []string{}, "Run the health checks of the running containers matching the given conditions")
+	_ = runCmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePsFilters)
+
+	formatFlagName := "format"
+	flags.StringVar(&runFormat, formatFlagName, "", "Print a report for each container in the given format (json)")
+	_ = runCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
 }
 
 func run(cmd *cobra.Command, args []string) error {
-	response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), args[0], entities.HealthCheckOptions{})
-	if err != nil {
+	if err := utils.ValidateBulkFormat(runFormat); err != nil {
+		return err
+	}
+	if len(runFilters) == 0 && runFormat == "" {
+		response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), args[0], entities.HealthCheckOptions{})
+		if err != nil {
+			return err
+		}
+		if response.Status == define.HealthCheckUnhealthy || response.Status == define.HealthCheckStarting {
+			registry.SetExitCode(1)
+			fmt.Println(response.Status)
+		}
+		return err
+	}
+
+	ids := args
+	if len(runFilters) > 0 {
+		filterMap, err := parse.FilterArgumentsIntoFilters(runFilters)
+		if err != nil {
+			return err
+		}
+		ids, err = utils.ContainerIDsByFilters(filterMap, false)
+		if err != nil {
+			return err
+		}
+	}
+	reports := utils.RunBulkOp(ids, func(id string) utils.BulkReport {
+		report := utils.BulkReport{Id: id}
+		response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), id, entities.HealthCheckOptions{})
+		if err != nil {
+			report.Err = err
+		} else {
+			report.Result = response.Status
+		}
+		return report
+	})
+	unhealthy := slices.ContainsFunc(reports, func(report utils.BulkReport) bool {
+		return report.Result == define.HealthCheckUnhealthy || report.Result == define.HealthCheckStarting
+	})
+	if err := utils.PrintBulkReports(runFormat, reports); err != nil {
 		return err
 	}
-	if response.Status == define.HealthCheckUnhealthy || response.Status == define.HealthCheckStarting {
+	if unhealthy && registry.GetExitCode() == 0 {
 		registry.SetExitCode(1)
-		fmt.Println(response.Status)
 	}
-	return err
+	return nil
 }
@@ -0,0 +1,24 @@
+package hooks
+
+import (
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman _hooks_
+	hooksCmd = &cobra.Command{
+		Use:         "hooks",
+		Short:       "Manage OCI hooks",
+		Long:        "Manage the OCI hooks of the hooks directories",
+		RunE:        validate.SubCommandExists,
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: hooksCmd,
+	})
+}
@@ -0,0 +1,80 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/spf13/cobra"
+)
+
+var (
+	inspectCmd = &cobra.Command{
+		Use:               "inspect [options] HOOK [HOOK...]",
+		Short:             "Inspect OCI hooks",
+		Long:              "Display detailed information on one or more OCI hooks",
+		RunE:              inspect,
+		Example:           "podman hooks inspect oci-systemd-hook.json",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: common.AutocompleteHooks,
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+	}
+	inspectFormat string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: inspectCmd,
+		Parent:  hooksCmd,
+	})
+	flags := inspectCmd.Flags()
+	formatFlagName := "format"
+	flags.StringVarP(&inspectFormat, formatFlagName, "f", "", "Format inspect output using Go template")
+	_ = inspectCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&define.OCIHook{}))
+}
+
+func inspect(cmd *cobra.Command, args []string) error {
+	inspected, errs, err := registry.ContainerEngine().HooksInspect(context.Background(), args)
+	if err != nil {
+		return err
+	}
+
+	// always print valid list
+	if len(inspected) == 0 {
+		inspected = []*define.OCIHook{}
+	}
+
+	if cmd.Flags().Changed("format") {
+		rpt := report.New(os.Stdout, cmd.Name())
+		defer rpt.Flush()
+
+		rpt, err := rpt.Parse(report.OriginUser, inspectFormat)
+		if err != nil {
+			return err
+		}
+		if err := rpt.Execute(inspected); err != nil {
+			return err
+		}
+	} else {
+		buf, err := json.MarshalIndent(inspected, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+	}
+
+	if len(errs) > 0 {
+		if len(errs) > 1 {
+			for _, err := range errs[1:] {
+				fmt.Fprintf(os.Stderr, "error inspecting hook: %v\n", err)
+			}
+		}
+		return fmt.Errorf("inspecting hook: %w", errs[0])
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lsCmd = &cobra.Command{
+		Use:               "ls [options]",
+		Aliases:           []string{"list"},
+		Short:             "List OCI hooks",
+		Long:              "List the OCI hooks of the hooks directories",
+		RunE:              ls,
+		Example:           "podman hooks ls",
+		Args:              validate.NoArgs,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+	}
+	listFlag = listFlagType{}
+)
+
+type listFlagType struct {
+	format    string
+	noHeading bool
+	quiet     bool
+}
+
+type hookReporter struct {
+	define.OCIHook
+}
+
+func (h hookReporter) Stages() string {
+	return strings.Join(h.OCIHook.Stages, ",")
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: lsCmd,
+		Parent:  hooksCmd,
+	})
+
+	flags := lsCmd.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&listFlag.format, formatFlagName, "{{range .}}{{.Name}}\t{{.Stages}}\t{{.Path}}\n{{end -}}", "Format hooks output using Go template")
+	_ = lsCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&hookReporter{}))
+
+	noHeadingFlagName := "noheading"
+	flags.BoolVarP(&listFlag.noHeading, noHeadingFlagName, "n", false, "Do not print headers")
+
+	quietFlagName := "quiet"
+	flags.BoolVarP(&listFlag.quiet, quietFlagName, "q", false, "Print hook names only")
+}
+
+func ls(cmd *cobra.Command, args []string) error {
+	responses, err := registry.ContainerEngine().HooksList(context.Background())
+	if err != nil {
+		return err
+	}
+
+	listed := make([]hookReporter, 0, len(responses))
+	for _, response := range responses {
+		if response.Error != "" {
+			logrus.Warnf("Invalid hook %s: %s", response.Path, response.Error)
+		}
+		listed = append(listed, hookReporter{*response})
+	}
+
+	if listFlag.quiet && !cmd.Flags().Changed("format") {
+		for _, hook := range listed {
+			fmt.Println(hook.Name)
+		}
+		return nil
+	}
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	if cmd.Flags().Changed("format") {
+		rpt, err = rpt.Parse(report.OriginUser, listFlag.format)
+	} else {
+		rpt, err = rpt.Parse(report.OriginPodman, listFlag.format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders && !listFlag.noHeading {
+		headers := report.Headers(hookReporter{}, nil)
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("failed to write report column headers: %w", err)
+		}
+	}
+	return rpt.Execute(listed)
+}
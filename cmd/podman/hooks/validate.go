@@ -0,0 +1,111 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	validateDescription = `Check the syntax of an OCI hook configuration file.
+
+  The when conditions of the hook are checked against a hypothetical container described by the --annotation, --command and --bind-mounts options.`
+	validateCmd = &cobra.Command{
+		Use:               "validate [options] FILE",
+		Short:             "Validate an OCI hook configuration file",
+		Long:              validateDescription,
+		RunE:              validateHook,
+		Example:           `podman hooks validate --command /usr/bin/init /usr/share/containers/oci/hooks.d/oci-systemd-hook.json`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+	}
+	validateFlag = validateFlagType{}
+)
+
+type validateFlagType struct {
+	annotations []string
+	format      string
+	options     entities.HooksValidateOptions
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: validateCmd,
+		Parent:  hooksCmd,
+	})
+	flags := validateCmd.Flags()
+
+	annotationFlagName := "annotation"
+	flags.StringArrayVar(&validateFlag.annotations, annotationFlagName, nil, "Annotation of the hypothetical container (key=value)")
+	_ = validateCmd.RegisterFlagCompletionFunc(annotationFlagName, completion.AutocompleteNone)
+
+	commandFlagName := "command"
+	flags.StringVar(&validateFlag.options.Command, commandFlagName, "", "Command of the hypothetical container")
+	_ = validateCmd.RegisterFlagCompletionFunc(commandFlagName, completion.AutocompleteDefault)
+
+	flags.BoolVar(&validateFlag.options.HasBindMounts, "bind-mounts", false, "The hypothetical container has bind mounts")
+
+	formatFlagName := "format"
+	flags.StringVarP(&validateFlag.format, formatFlagName, "f", "", "Change the output format to JSON or a Go template")
+	_ = validateCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.HooksValidateReport{}))
+}
+
+func validateHook(cmd *cobra.Command, args []string) error {
+	annotations := make(map[string]string, len(validateFlag.annotations))
+	for _, annotation := range validateFlag.annotations {
+		key, val, hasVal := strings.Cut(annotation, "=")
+		if !hasVal {
+			return errors.New("annotations must be formatted KEY=VALUE")
+		}
+		annotations[key] = val
+	}
+	validateFlag.options.Annotations = annotations
+
+	result, err := registry.ContainerEngine().HooksValidate(context.Background(), args[0], validateFlag.options)
+	if err != nil {
+		return err
+	}
+	if result.Error != "" {
+		return fmt.Errorf("invalid hook %s: %s", result.Path, result.Error)
+	}
+
+	switch {
+	case report.IsJSON(validateFlag.format):
+		b, err := json.MarshalIndent(result, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	case cmd.Flags().Changed("format"):
+		rpt := report.New(os.Stdout, cmd.Name())
+		defer rpt.Flush()
+
+		// Use OriginUnknown so it does not add an extra range since it
+		// will only be called for a single element and not a slice.
+		rpt, err := rpt.Parse(report.OriginUnknown, validateFlag.format)
+		if err != nil {
+			return err
+		}
+		return rpt.Execute(result)
+	}
+
+	fmt.Printf("%s is valid\n", result.Path)
+	if result.Match {
+		fmt.Printf("The hook would run at the %s stages of the container\n", strings.Join(result.Stages, ", "))
+	} else {
+		fmt.Println("The hook would not be used by the container")
+	}
+	return nil
+}
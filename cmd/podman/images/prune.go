@@ -2,6 +2,7 @@ package images
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -48,6 +49,7 @@ func init() {
 	flags.BoolVarP(&pruneOpts.All, "all", "a", false, "Remove all images not in use by containers, not just dangling ones")
 	flags.BoolVarP(&pruneOpts.External, "external", "", false, "Remove images even when they are used by external containers (e.g., by build containers)")
 	flags.BoolVarP(&force, "force", "f", false, "Do not prompt for confirmation")
+	flags.BoolVar(&pruneOpts.Policy, "policy", false, "Remove images according to the image retention policy in containers.conf")
 
 	filterFlagName := "filter"
 	flags.StringArrayVar(&filter, filterFlagName, []string{}, "Provide filter values (e.g. 'label=<key>=<value>')")
@@ -55,6 +57,9 @@ func init() {
 }
 
 func prune(cmd *cobra.Command, args []string) error {
+	if pruneOpts.Policy && pruneOpts.All {
+		return errors.New("--policy and --all cannot be used together")
+	}
 	if !force {
 		reader := bufio.NewReader(os.Stdin)
 		fmt.Printf("%s", createPruneWarningMessage(pruneOpts))
@@ -85,6 +90,9 @@ func prune(cmd *cobra.Command, args []string) error {
 
 func createPruneWarningMessage(pruneOpts entities.ImagePruneOptions) string {
 	question := "Are you sure you want to continue? [y/N] "
+	if pruneOpts.Policy {
+		return "WARNING! This command removes all images selected by the image retention policy.\n" + question
+	}
 	if pruneOpts.All {
 		return "WARNING! This command removes all images without at least one container associated with them.\n" + question
 	}
@@ -24,6 +24,7 @@ func AddInspectFlagSet(cmd *cobra.Command) *entities.InspectOptions {
 
 	flags := cmd.Flags()
 	flags.BoolVarP(&opts.Size, "size", "s", false, "Display total file size")
+	flags.BoolVar(&opts.ShowHooks, "show-hooks", false, "Display the OCI hooks the container uses")
 
 	formatFlagName := "format"
 	flags.StringVarP(&opts.Format, formatFlagName, "f", "json", "Format the output to a Go template or json")
@@ -63,6 +64,12 @@ func newInspector(options entities.InspectOptions) (*inspector, error) {
 			return nil, fmt.Errorf("size is not supported for type %q", common.ImageType)
 		}
 	}
+	if options.ShowHooks && options.Type != common.AllType && options.Type != common.ContainerType {
+		return nil, fmt.Errorf("show-hooks is not supported for type %q", options.Type)
+	}
+	if options.Live && options.Type != common.NetworkType {
+		return nil, fmt.Errorf("live is not supported for type %q", options.Type)
+	}
 	if options.Type == common.PodType && options.Size {
 		return nil, fmt.Errorf("size is not supported for type %q", common.PodType)
 	}
@@ -134,6 +141,17 @@ func (i *inspector) inspect(namesOrIDs []string) error {
 		}
 
 	case common.NetworkType:
+		if i.options.Live {
+			liveData, allErrs, err := i.containerEngine.NetworkInspectLive(ctx, namesOrIDs, i.options)
+			if err != nil {
+				return err
+			}
+			errs = allErrs
+			for i := range liveData {
+				data = append(data, liveData[i])
+			}
+			break
+		}
 		networkData, allErrs, err := registry.ContainerEngine().NetworkInspect(ctx, namesOrIDs, i.options)
 		if err != nil {
 			return err
@@ -11,6 +11,7 @@ import (
 	_ "github.com/containers/podman/v5/cmd/podman/farm"
 	_ "github.com/containers/podman/v5/cmd/podman/generate"
 	_ "github.com/containers/podman/v5/cmd/podman/healthcheck"
+	_ "github.com/containers/podman/v5/cmd/podman/hooks"
 	_ "github.com/containers/podman/v5/cmd/podman/images"
 	_ "github.com/containers/podman/v5/cmd/podman/kube"
 	_ "github.com/containers/podman/v5/cmd/podman/machine"
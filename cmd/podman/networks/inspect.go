@@ -1,7 +1,6 @@
 package network
 
 import (
-	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/inspect"
 	"github.com/containers/podman/v5/cmd/podman/registry"
@@ -33,7 +32,9 @@ func init() {
 
 	formatFlagName := "format"
 	flags.StringVarP(&inspectOpts.Format, formatFlagName, "f", "", "Pretty-print network to JSON or using a Go template")
-	_ = networkinspectCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&types.Network{}))
+	_ = networkinspectCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.NetworkInspectLiveReport{}))
+
+	flags.BoolVar(&inspectOpts.Live, "live", false, "Include the connected containers with their interfaces and traffic counters")
 }
 
 func networkInspect(_ *cobra.Command, args []string) error {
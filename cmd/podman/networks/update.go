@@ -3,8 +3,10 @@ package network
 import (
 	"fmt"
 
+	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/spf13/cobra"
@@ -19,12 +21,23 @@ var (
 		RunE:              networkUpdate,
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: common.AutocompleteNetworks,
-		Example:           `podman network update podman1`,
+		Example: `podman network update podman1
+  podman network update --subnet-add 10.90.0.0/24 --gateway 10.90.0.254 podman1
+  podman network update --internal --isolate strict podman1`,
 	}
 )
 
 var (
 	networkUpdateOptions entities.NetworkUpdateOptions
+	networkUpdateValues  struct {
+		add      []string
+		drop     []string
+		ranges   []string
+		labels   []string
+		opts     []string
+		internal bool
+		isolate  string
+	}
 )
 
 func networkUpdateFlags(cmd *cobra.Command) {
@@ -36,6 +49,39 @@ func networkUpdateFlags(cmd *cobra.Command) {
 	flags.StringSliceVar(&networkUpdateOptions.RemoveDNSServers, removeDNSServerFlagName, nil, "remove network level nameservers")
 	_ = cmd.RegisterFlagCompletionFunc(addDNSServerFlagName, completion.AutocompleteNone)
 	_ = cmd.RegisterFlagCompletionFunc(removeDNSServerFlagName, completion.AutocompleteNone)
+
+	addSubnetFlagName := "subnet-add"
+	flags.StringArrayVar(&networkUpdateValues.add, addSubnetFlagName, nil, "add subnets in CIDR format")
+	_ = cmd.RegisterFlagCompletionFunc(addSubnetFlagName, completion.AutocompleteNone)
+	removeSubnetFlagName := "subnet-drop"
+	flags.StringArrayVar(&networkUpdateValues.drop, removeSubnetFlagName, nil, "remove subnets in CIDR format")
+	_ = cmd.RegisterFlagCompletionFunc(removeSubnetFlagName, completion.AutocompleteNone)
+
+	gatewayFlagName := "gateway"
+	flags.IPSliceVar(&networkUpdateOptions.Gateways, gatewayFlagName, nil, "set the IPv4 or IPv6 gateway of the subnet containing it")
+	_ = cmd.RegisterFlagCompletionFunc(gatewayFlagName, completion.AutocompleteNone)
+	ipRangeFlagName := "ip-range"
+	flags.StringArrayVar(&networkUpdateValues.ranges, ipRangeFlagName, nil, "allocate container IP from range in the subnet containing it")
+	_ = cmd.RegisterFlagCompletionFunc(ipRangeFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&networkUpdateValues.internal, "internal", false, "restrict external access from this network")
+	isolateFlagName := "isolate"
+	flags.StringVar(&networkUpdateValues.isolate, isolateFlagName, "", "isolate the network from other networks (true, false or strict)")
+	_ = cmd.RegisterFlagCompletionFunc(isolateFlagName, common.AutocompleteNetworkIsolate)
+
+	labelAddFlagName := "label-add"
+	flags.StringArrayVar(&networkUpdateValues.labels, labelAddFlagName, nil, "add or change labels of the network")
+	_ = cmd.RegisterFlagCompletionFunc(labelAddFlagName, completion.AutocompleteNone)
+	labelDropFlagName := "label-drop"
+	flags.StringSliceVar(&networkUpdateOptions.RemoveLabels, labelDropFlagName, nil, "remove labels of the network")
+	_ = cmd.RegisterFlagCompletionFunc(labelDropFlagName, completion.AutocompleteNone)
+
+	optAddFlagName := "opt-add"
+	flags.StringArrayVar(&networkUpdateValues.opts, optAddFlagName, nil, "add or change driver specific options")
+	_ = cmd.RegisterFlagCompletionFunc(optAddFlagName, completion.AutocompleteNone)
+	optDropFlagName := "opt-drop"
+	flags.StringSliceVar(&networkUpdateOptions.RemoveOptions, optDropFlagName, nil, "remove driver specific options")
+	_ = cmd.RegisterFlagCompletionFunc(optDropFlagName, completion.AutocompleteNone)
 }
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
@@ -48,7 +94,52 @@ func init() {
 func networkUpdate(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	err := registry.ContainerEngine().NetworkUpdate(registry.Context(), name, networkUpdateOptions)
+	for _, s := range networkUpdateValues.add {
+		subnet, err := types.ParseCIDR(s)
+		if err != nil {
+			return err
+		}
+		networkUpdateOptions.AddSubnets = append(networkUpdateOptions.AddSubnets, types.Subnet{Subnet: subnet})
+	}
+	for _, s := range networkUpdateValues.drop {
+		subnet, err := types.ParseCIDR(s)
+		if err != nil {
+			return err
+		}
+		networkUpdateOptions.RemoveSubnets = append(networkUpdateOptions.RemoveSubnets, subnet)
+	}
+	for _, r := range networkUpdateValues.ranges {
+		leaseRange, err := parseRange(r)
+		if err != nil {
+			return err
+		}
+		networkUpdateOptions.IPRanges = append(networkUpdateOptions.IPRanges, *leaseRange)
+	}
+	if cmd.Flags().Changed("internal") {
+		networkUpdateOptions.Internal = &networkUpdateValues.internal
+	}
+
+	var err error
+	if len(networkUpdateValues.labels) > 0 {
+		networkUpdateOptions.AddLabels, err = parse.GetAllLabels([]string{}, networkUpdateValues.labels)
+		if err != nil {
+			return fmt.Errorf("failed to parse labels: %w", err)
+		}
+	}
+	if len(networkUpdateValues.opts) > 0 {
+		networkUpdateOptions.AddOptions, err = parse.GetAllLabels([]string{}, networkUpdateValues.opts)
+		if err != nil {
+			return fmt.Errorf("unable to parse options: %w", err)
+		}
+	}
+	if cmd.Flags().Changed("isolate") {
+		if networkUpdateOptions.AddOptions == nil {
+			networkUpdateOptions.AddOptions = make(map[string]string)
+		}
+		networkUpdateOptions.AddOptions[types.IsolateOption] = networkUpdateValues.isolate
+	}
+
+	err = registry.ContainerEngine().NetworkUpdate(registry.Context(), name, networkUpdateOptions)
 	if err != nil {
 		return err
 	}
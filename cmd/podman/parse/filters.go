@@ -9,7 +9,7 @@ import (
 func FilterArgumentsIntoFilters(filters []string) (url.Values, error) {
 	parsedFilters := make(url.Values)
 	for _, f := range filters {
-		fname, filter, hasFilter := strings.Cut(f, "=")
+		fname, filter, hasFilter := SplitFilter(f)
 		if !hasFilter {
 			return parsedFilters, fmt.Errorf("filter input must be in the form of filter=value: %s is invalid", f)
 		}
@@ -17,3 +17,17 @@ func FilterArgumentsIntoFilters(filters []string) (url.Values, error) {
 	}
 	return parsedFilters, nil
 }
+
+// SplitFilter splits a filter argument into its name and value.  Besides
+// name=value, comparisons like exited>0 or restart-count<=3 are accepted, in
+// which case the comparison operator is kept as prefix of the value.
+func SplitFilter(f string) (string, string, bool) {
+	i := strings.IndexAny(f, "=<>")
+	if i <= 0 {
+		return "", "", false
+	}
+	if f[i] == '=' {
+		return f[:i], f[i+1:], true
+	}
+	return f[:i], f[i:], true
+}
@@ -0,0 +1,32 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		name   string
+		value  string
+		ok     bool
+	}{
+		{"label=foo=bar", "label", "foo=bar", true},
+		{"label!=foo", "label!", "foo", true},
+		{"exited=1", "exited", "1", true},
+		{"exited>0", "exited", ">0", true},
+		{"restart-count<=3", "restart-count", "<=3", true},
+		{"exited=>=2", "exited", ">=2", true},
+		{"label=a>b", "label", "a>b", true},
+		{"exited", "", "", false},
+		{">0", "", "", false},
+	}
+	for _, tt := range tests {
+		name, value, ok := SplitFilter(tt.filter)
+		assert.Equal(t, tt.ok, ok, tt.filter)
+		assert.Equal(t, tt.name, name, tt.filter)
+		assert.Equal(t, tt.value, value, tt.filter)
+	}
+}
@@ -79,6 +79,10 @@ func init() {
 	flags.StringVarP(&createOptions.ExitPolicy, policyFlag, "", string(containerConfig.Engine.PodExitPolicy), "Behaviour when the last container exits")
 	_ = createCommand.RegisterFlagCompletionFunc(policyFlag, common.AutocompletePodExitPolicy)
 
+	restartPodFlagName := "restart-pod"
+	flags.StringVar(&createOptions.RestartPod, restartPodFlagName, "", `Restart policy of the whole pod, infra container included, when a critical container exits or turns unhealthy ("always"|"no"|"on-failure[:max_restarts]"|"unless-stopped")`)
+	_ = createCommand.RegisterFlagCompletionFunc(restartPodFlagName, common.AutocompleteRestartOption)
+
 	infraImageFlagName := "infra-image"
 	var defInfraImage string
 	if !registry.IsRemote() {
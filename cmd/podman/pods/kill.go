@@ -2,7 +2,7 @@ package pods
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
@@ -31,7 +31,8 @@ var (
 )
 
 var (
-	killOpts entities.PodKillOptions
+	killOpts   entities.PodKillOptions
+	killFormat string
 )
 
 func init() {
@@ -46,24 +47,27 @@ func init() {
 	flags.StringVarP(&killOpts.Signal, signalFlagName, "s", "KILL", "Signal to send to the containers in the pod")
 	_ = killCommand.RegisterFlagCompletionFunc(signalFlagName, common.AutocompleteStopSignal)
 
+	addBulkFlags(killCommand, "f", &killFormat)
+
 	validate.AddLatestFlag(killCommand, &killOpts.Latest)
 }
 
 func kill(_ *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(killFormat); err != nil {
+		return err
+	}
+	filters, err := parseBulkFilters()
+	if err != nil {
+		return err
+	}
+	killOpts.Filters = filters
 	responses, err := registry.ContainerEngine().PodKill(context.Background(), args, killOpts)
 	if err != nil {
 		return err
 	}
-	// in the cli, first we print out all the successful attempts
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		if len(r.Errs) == 0 {
-			fmt.Println(r.Id)
-		} else {
-			errs = append(errs, r.Errs...)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, Err: errors.Join(r.Errs...)})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(killFormat, reports)
 }
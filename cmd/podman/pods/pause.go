@@ -2,7 +2,7 @@ package pods
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
@@ -32,6 +32,7 @@ var (
 
 var (
 	pauseOptions entities.PodPauseOptions
+	pauseFormat  string
 )
 
 func init() {
@@ -41,23 +42,26 @@ func init() {
 	})
 	flags := pauseCommand.Flags()
 	flags.BoolVarP(&pauseOptions.All, "all", "a", false, "Pause all running pods")
+	addBulkFlags(pauseCommand, "f", &pauseFormat)
+
 	validate.AddLatestFlag(pauseCommand, &pauseOptions.Latest)
 }
 func pause(_ *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(pauseFormat); err != nil {
+		return err
+	}
+	filters, err := parseBulkFilters()
+	if err != nil {
+		return err
+	}
+	pauseOptions.Filters = filters
 	responses, err := registry.ContainerEngine().PodPause(context.Background(), args, pauseOptions)
 	if err != nil {
 		return err
 	}
-	// in the cli, first we print out all the successful attempts
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		if len(r.Errs) == 0 {
-			fmt.Println(r.Id)
-		} else {
-			errs = append(errs, r.Errs...)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, Err: errors.Join(r.Errs...)})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(pauseFormat, reports)
 }
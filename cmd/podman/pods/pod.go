@@ -1,6 +1,10 @@
 package pods
 
 import (
+	"fmt"
+
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/pkg/util"
@@ -26,3 +30,34 @@ func init() {
 		Command: podCmd,
 	})
 }
+
+// addBulkFlags adds the --filter and --format flags to a command operating
+// on several pods.  The filters are shared with podman pod ps.
+func addBulkFlags(cmd *cobra.Command, filterShorthand string, format *string) {
+	flags := cmd.Flags()
+
+	filterFlagName := "filter"
+	flags.StringArrayVarP(&inputFilters, filterFlagName, filterShorthand, []string{}, "Filter pods based on conditions given")
+	_ = cmd.RegisterFlagCompletionFunc(filterFlagName, common.AutocompletePodPsFilters)
+
+	formatFlagName := "format"
+	flags.StringVar(format, formatFlagName, "", "Print a report for each pod in the given format (json)")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
+}
+
+// parseBulkFilters returns the filters given via --filter, nil if there are
+// none.
+func parseBulkFilters() (map[string][]string, error) {
+	if len(inputFilters) == 0 {
+		return nil, nil
+	}
+	filters := make(map[string][]string)
+	for _, f := range inputFilters {
+		fname, filter, hasFilter := parse.SplitFilter(f)
+		if !hasFilter {
+			return nil, fmt.Errorf("invalid filter %q", f)
+		}
+		filters[fname] = append(filters[fname], filter)
+	}
+	return filters, nil
+}
@@ -2,7 +2,7 @@ package pods
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
@@ -32,6 +32,7 @@ var (
 
 var (
 	restartOptions = entities.PodRestartOptions{}
+	restartFormat  string
 )
 
 func init() {
@@ -42,24 +43,27 @@ func init() {
 
 	flags := restartCommand.Flags()
 	flags.BoolVarP(&restartOptions.All, "all", "a", false, "Restart all running pods")
+	addBulkFlags(restartCommand, "f", &restartFormat)
+
 	validate.AddLatestFlag(restartCommand, &restartOptions.Latest)
 }
 
 func restart(cmd *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(restartFormat); err != nil {
+		return err
+	}
+	filters, err := parseBulkFilters()
+	if err != nil {
+		return err
+	}
+	restartOptions.Filters = filters
 	responses, err := registry.ContainerEngine().PodRestart(context.Background(), args, restartOptions)
 	if err != nil {
 		return err
 	}
-	// in the cli, first we print out all the successful attempts
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		if len(r.Errs) == 0 {
-			fmt.Println(r.Id)
-		} else {
-			errs = append(errs, r.Errs...)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, Err: errors.Join(r.Errs...)})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(restartFormat, reports)
 }
@@ -23,6 +23,7 @@ type podRmOptionsWrapper struct {
 	entities.PodRmOptions
 
 	PodIDFiles []string
+	format     string
 }
 
 var (
@@ -65,6 +66,9 @@ func init() {
 	flags.IntVarP(&stopTimeout, timeFlagName, "t", int(containerConfig.Engine.StopTimeout), "Seconds to wait for pod stop before killing the container")
 	_ = rmCommand.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
 
+	// -f is --force
+	addBulkFlags(rmCommand, "", &rmOptions.format)
+
 	validate.AddLatestFlag(rmCommand, &rmOptions.Latest)
 
 	if registry.IsRemote() {
@@ -75,6 +79,9 @@ func init() {
 func rm(cmd *cobra.Command, args []string) error {
 	var errs utils.OutputErrors
 
+	if err := utils.ValidateBulkFormat(rmOptions.format); err != nil {
+		return err
+	}
 	if cmd.Flag("time").Changed {
 		if !rmOptions.Force {
 			return errors.New("--force option must be specified to use the --time option")
@@ -82,6 +89,15 @@ func rm(cmd *cobra.Command, args []string) error {
 		timeout := uint(stopTimeout)
 		rmOptions.Timeout = &timeout
 	}
+	filters, err := parseBulkFilters()
+	if err != nil {
+		return err
+	}
+	rmOptions.Filters = filters
+
+	if rmOptions.format != "" {
+		return rmWithReports(args)
+	}
 
 	errs = append(errs, removePods(args, rmOptions.PodRmOptions, true)...)
 
@@ -103,6 +119,46 @@ func rm(cmd *cobra.Command, args []string) error {
 	return errs.PrintErrors()
 }
 
+// rmWithReports removes the specified pods and prints a report for each of
+// them in the requested format.
+func rmWithReports(namesOrIDs []string) error {
+	idFiles := make(map[string]string, len(rmOptions.PodIDFiles))
+	for _, idFile := range rmOptions.PodIDFiles {
+		id, err := specgenutil.ReadPodIDFile(idFile)
+		if err != nil {
+			return err
+		}
+		idFiles[id] = idFile
+		namesOrIDs = append(namesOrIDs, id)
+	}
+
+	responses, err := registry.ContainerEngine().PodRm(context.Background(), namesOrIDs, rmOptions.PodRmOptions)
+	if err != nil {
+		setExitCode(err)
+		return err
+	}
+	reports := make([]utils.BulkReport, 0, len(responses))
+	for _, r := range responses {
+		report := utils.BulkReport{Id: r.Id, Err: r.Err}
+		if r.Err != nil {
+			setExitCode(r.Err)
+			errs := []error{r.Err}
+			for ctr, err := range r.RemovedCtrs {
+				if err != nil {
+					errs = append(errs, fmt.Errorf("error removing container %s from pod %s: %w", ctr, r.Id, err))
+				}
+			}
+			report.Err = errors.Join(errs...)
+		} else if idFile, ok := idFiles[r.Id]; ok {
+			if err := os.Remove(idFile); err != nil {
+				report.Err = err
+			}
+		}
+		reports = append(reports, report)
+	}
+	return utils.PrintBulkReports(rmOptions.format, reports)
+}
+
 // removePods removes the specified pods (names or IDs).  Allows for sharing
 // pod-removal logic across commands.
 func removePods(namesOrIDs []string, rmOptions entities.PodRmOptions, printIDs bool) utils.OutputErrors {
@@ -2,7 +2,7 @@ package pods
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
@@ -41,6 +41,7 @@ var (
 
 var (
 	startOptions = podStartOptionsWrapper{}
+	startFormat  string
 )
 
 func init() {
@@ -56,13 +57,20 @@ func init() {
 	flags.StringArrayVarP(&startOptions.PodIDFiles, podIDFileFlagName, "", nil, "Read the pod ID from the file")
 	_ = startCommand.RegisterFlagCompletionFunc(podIDFileFlagName, completion.AutocompleteDefault)
 
+	addBulkFlags(startCommand, "f", &startFormat)
+
 	validate.AddLatestFlag(startCommand, &startOptions.Latest)
 }
 
 func start(cmd *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(startFormat); err != nil {
+		return err
+	}
+	filters, err := parseBulkFilters()
+	if err != nil {
+		return err
+	}
+	startOptions.Filters = filters
 
 	ids, err := specgenutil.ReadPodIDFiles(startOptions.PodIDFiles)
 	if err != nil {
@@ -74,13 +82,9 @@ func start(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	// in the cli, first we print out all the successful attempts
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		if len(r.Errs) == 0 {
-			fmt.Println(r.Id)
-		} else {
-			errs = append(errs, r.Errs...)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, Err: errors.Join(r.Errs...)})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(startFormat, reports)
 }
@@ -2,7 +2,7 @@ package pods
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
@@ -20,6 +20,7 @@ type podStopOptionsWrapper struct {
 
 	podIDFiles []string
 	timeoutCLI int
+	format     string
 }
 
 var (
@@ -61,6 +62,8 @@ func init() {
 	flags.StringArrayVarP(&stopOptions.podIDFiles, podIDFileFlagName, "", nil, "Write the pod ID to the file")
 	_ = stopCommand.RegisterFlagCompletionFunc(podIDFileFlagName, completion.AutocompleteDefault)
 
+	addBulkFlags(stopCommand, "f", &stopOptions.format)
+
 	validate.AddLatestFlag(stopCommand, &stopOptions.Latest)
 
 	if registry.IsRemote() {
@@ -71,13 +74,19 @@ func init() {
 }
 
 func stop(cmd *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(stopOptions.format); err != nil {
+		return err
+	}
 	if cmd.Flag("time").Changed {
 		stopOptions.Timeout = stopOptions.timeoutCLI
 	}
 
+	filters, err := parseBulkFilters()
+	if err != nil {
+		return err
+	}
+	stopOptions.Filters = filters
+
 	ids, err := specgenutil.ReadPodIDFiles(stopOptions.podIDFiles)
 	if err != nil {
 		return err
@@ -88,13 +97,9 @@ func stop(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	// in the cli, first we print out all the successful attempts
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		if len(r.Errs) == 0 {
-			fmt.Println(r.Id)
-		} else {
-			errs = append(errs, r.Errs...)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, Err: errors.Join(r.Errs...)})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(stopOptions.format, reports)
 }
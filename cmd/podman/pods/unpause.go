@@ -2,7 +2,7 @@ package pods
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
@@ -32,6 +32,7 @@ var (
 
 var (
 	unpauseOptions entities.PodunpauseOptions
+	unpauseFormat  string
 )
 
 func init() {
@@ -41,24 +42,27 @@ func init() {
 	})
 	flags := unpauseCommand.Flags()
 	flags.BoolVarP(&unpauseOptions.All, "all", "a", false, "Unpause all running pods")
+	addBulkFlags(unpauseCommand, "f", &unpauseFormat)
+
 	validate.AddLatestFlag(unpauseCommand, &unpauseOptions.Latest)
 }
 
 func unpause(_ *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
+	if err := utils.ValidateBulkFormat(unpauseFormat); err != nil {
+		return err
+	}
+	filters, err := parseBulkFilters()
+	if err != nil {
+		return err
+	}
+	unpauseOptions.Filters = filters
 	responses, err := registry.ContainerEngine().PodUnpause(context.Background(), args, unpauseOptions)
 	if err != nil {
 		return err
 	}
-	// in the cli, first we print out all the successful attempts
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		if len(r.Errs) == 0 {
-			fmt.Println(r.Id)
-		} else {
-			errs = append(errs, r.Errs...)
-		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, Err: errors.Join(r.Errs...)})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(unpauseFormat, reports)
 }
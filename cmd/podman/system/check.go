@@ -0,0 +1,125 @@
+//go:build !remote
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/cmd/podman/validate"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	checkDescription = `
+        podman system check
+
+        Check the libpod database, the local storage, volume mount points, lock
+        allocation and network configurations for consistency, and optionally
+        repair the problems found.
+`
+
+	checkCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "check [options]",
+		Args:              validate.NoArgs,
+		Short:             "Check the local state for consistency",
+		Long:              checkDescription,
+		RunE:              check,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman system check
+  podman system check --repair
+  podman system check --quick --format json`,
+	}
+)
+
+var (
+	checkOptions     entities.SystemCheckOptions
+	checkFormat      string
+	checkMaxLayerAge time.Duration
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: checkCommand,
+		Parent:  systemCmd,
+	})
+
+	flags := checkCommand.Flags()
+	flags.BoolVarP(&checkOptions.RepairLossy, "force", "f", false, "Remove damaged containers, unknown storage containers and containers whose storage is missing")
+	flags.BoolVarP(&checkOptions.Quick, "quick", "q", false, "Skip the most time-intensive storage checks")
+	flags.BoolVarP(&checkOptions.Repair, "repair", "r", false, "Repair the problems found")
+
+	maxFlagName := "max"
+	flags.DurationVarP(&checkMaxLayerAge, maxFlagName, "m", 24*time.Hour, "Maximum allowed age of unreferenced layers")
+	_ = checkCommand.RegisterFlagCompletionFunc(maxFlagName, completion.AutocompleteNone)
+
+	formatFlagName := "format"
+	flags.StringVar(&checkFormat, formatFlagName, "", "Format the problems found using a Go template or \"json\"")
+	_ = checkCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&define.SystemCheckProblem{}))
+}
+
+func check(cmd *cobra.Command, args []string) error {
+	if checkOptions.RepairLossy {
+		checkOptions.Repair = true
+	}
+	if cmd.Flags().Changed("max") {
+		checkOptions.UnreferencedLayerMaximumAge = &checkMaxLayerAge
+	}
+
+	checkReport, err := registry.ContainerEngine().SystemCheck(registry.Context(), checkOptions)
+	if err != nil {
+		return err
+	}
+
+	if err := printCheckReport(cmd, checkReport); err != nil {
+		return err
+	}
+
+	if checkReport.Errors {
+		if !checkOptions.Repair {
+			return errors.New("inconsistencies found, run podman system check --repair to fix them")
+		}
+		return errors.New("inconsistencies remain which could not be repaired")
+	}
+	return nil
+}
+
+func printCheckReport(cmd *cobra.Command, checkReport *entities.SystemCheckReport) error {
+	if report.IsJSON(checkFormat) {
+		bytes, err := json.MarshalIndent(checkReport, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(bytes))
+		return nil
+	}
+
+	if checkFormat == "" && len(checkReport.Problems) == 0 {
+		fmt.Println("No inconsistencies found")
+		return nil
+	}
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	var err error
+	if checkFormat != "" {
+		rpt, err = rpt.Parse(report.OriginUser, checkFormat)
+	} else {
+		rpt, err = rpt.Parse(report.OriginPodman, "{{range . }}{{.Type}}\t{{.ID}}\t{{.Problem}}\t{{.Repaired}}\n{{end -}}")
+	}
+	if err != nil {
+		return err
+	}
+	hdrs := report.Headers(define.SystemCheckProblem{}, nil)
+	return writeTemplate(rpt, hdrs, checkReport.Problems)
+}
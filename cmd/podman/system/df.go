@@ -45,6 +45,7 @@ func init() {
 	})
 	flags := dfSystemCommand.Flags()
 	flags.BoolVarP(&dfOptions.Verbose, "verbose", "v", false, "Show detailed information on disk usage")
+	flags.BoolVar(&dfOptions.Analyze, "analyze", false, "Show layer sharing between images, build cache and prune estimates (requires --verbose)")
 
 	formatFlagName := "format"
 	flags.StringVar(&dfOptions.Format, formatFlagName, "", "Pretty-print images using a Go template")
@@ -52,6 +53,10 @@ func init() {
 }
 
 func df(cmd *cobra.Command, args []string) error {
+	if dfOptions.Analyze && !dfOptions.Verbose {
+		return errors.New("--analyze can only be used with --verbose")
+	}
+
 	reports, err := registry.ContainerEngine().SystemDf(registry.Context(), dfOptions)
 	if err != nil {
 		return err
@@ -230,7 +235,78 @@ func printVerbose(cmd *cobra.Command, reports *entities.SystemDfReport) error {
 	if err != nil {
 		return err
 	}
-	return writeTemplate(rpt, hdrs, dfVolumes)
+	if err := writeTemplate(rpt, hdrs, dfVolumes); err != nil {
+		return err
+	}
+
+	if reports.Analysis == nil {
+		return nil
+	}
+	return printAnalysis(rpt, reports.Analysis)
+}
+
+func printAnalysis(rpt *report.Formatter, analysis *entities.SystemDfAnalysisReport) error {
+	fmt.Fprint(rpt.Writer(), "\nImages exclusive and shared size:\n\n")
+	dfAnalyses := make([]*dfImageAnalysis, 0, len(analysis.Images))
+	for _, d := range analysis.Images {
+		dfAnalyses = append(dfAnalyses, &dfImageAnalysis{SystemDfImageAnalysis: d})
+	}
+	hdrs := report.Headers(entities.SystemDfImageAnalysis{}, map[string]string{
+		"ImageID":       "IMAGE ID",
+		"ExclusiveSize": "EXCLUSIVE SIZE",
+		"SharedSize":    "SHARED SIZE",
+	})
+	imageRow := "{{range .}}{{.ImageID}}\t{{.Names}}\t{{.Layers}}\t{{.Size}}\t{{.ExclusiveSize}}\t{{.SharedSize}}\t{{.Containers}}\n{{end -}}"
+	rpt, err := rpt.Parse(report.OriginPodman, imageRow)
+	if err != nil {
+		return err
+	}
+	if err := writeTemplate(rpt, hdrs, dfAnalyses); err != nil {
+		return err
+	}
+
+	fmt.Fprint(rpt.Writer(), "\nLayers space usage:\n\n")
+	dfLayers := make([]*dfLayer, 0, len(analysis.Layers))
+	for _, d := range analysis.Layers {
+		dfLayers = append(dfLayers, &dfLayer{SystemDfLayerReport: d})
+	}
+	hdrs = report.Headers(entities.SystemDfLayerReport{}, map[string]string{
+		"LayerID": "LAYER ID",
+	})
+	layerRow := "{{range .}}{{.LayerID}}\t{{.Size}}\t{{.Images}}\n{{end -}}"
+	rpt, err = rpt.Parse(report.OriginPodman, layerRow)
+	if err != nil {
+		return err
+	}
+	if err := writeTemplate(rpt, hdrs, dfLayers); err != nil {
+		return err
+	}
+
+	fmt.Fprint(rpt.Writer(), "\nBuild cache space usage:\n\n")
+	dfBuildCaches := make([]*dfBuildCache, 0, len(analysis.BuildCache))
+	for _, d := range analysis.BuildCache {
+		dfBuildCaches = append(dfBuildCaches, &dfBuildCache{SystemDfBuildCacheReport: d})
+	}
+	hdrs = report.Headers(entities.SystemDfBuildCacheReport{}, map[string]string{
+		"ImageID":         "IMAGE ID",
+		"ReclaimableSize": "RECLAIMABLE",
+	})
+	buildCacheRow := "{{range .}}{{.ImageID}}\t{{.Created}}\t{{.Size}}\t{{.ReclaimableSize}}\t{{.Intermediate}}\n{{end -}}"
+	rpt, err = rpt.Parse(report.OriginPodman, buildCacheRow)
+	if err != nil {
+		return err
+	}
+	if err := writeTemplate(rpt, hdrs, dfBuildCaches); err != nil {
+		return err
+	}
+
+	prune := analysis.Prune
+	fmt.Fprint(rpt.Writer(), "\nEstimated space reclaimed by prune:\n\n")
+	fmt.Fprintf(rpt.Writer(), "Stopped containers:\t%s\n", units.HumanSize(float64(prune.Containers)))
+	fmt.Fprintf(rpt.Writer(), "Dangling images:\t%s\n", units.HumanSize(float64(prune.DanglingImages)))
+	fmt.Fprintf(rpt.Writer(), "Unused images (--all):\t%s\n", units.HumanSize(float64(prune.UnusedImages)))
+	fmt.Fprintf(rpt.Writer(), "Unused volumes (--volumes):\t%s\n", units.HumanSize(float64(prune.Volumes)))
+	return nil
 }
 
 func writeTemplate(rpt *report.Formatter, hdrs []map[string]string, output interface{}) error {
@@ -298,6 +374,73 @@ func (d *dfVolume) Size() string {
 	return units.HumanSize(float64(d.SystemDfVolumeReport.Size))
 }
 
+type dfImageAnalysis struct {
+	*entities.SystemDfImageAnalysis
+}
+
+func (d *dfImageAnalysis) ImageID() string {
+	return d.SystemDfImageAnalysis.ImageID[0:12]
+}
+
+func (d *dfImageAnalysis) Names() string {
+	if len(d.SystemDfImageAnalysis.Names) == 0 {
+		return "<none>"
+	}
+	return strings.Join(d.SystemDfImageAnalysis.Names, ",")
+}
+
+func (d *dfImageAnalysis) Size() string {
+	return units.HumanSize(float64(d.SystemDfImageAnalysis.Size))
+}
+
+func (d *dfImageAnalysis) ExclusiveSize() string {
+	return units.HumanSize(float64(d.SystemDfImageAnalysis.ExclusiveSize))
+}
+
+func (d *dfImageAnalysis) SharedSize() string {
+	return units.HumanSize(float64(d.SystemDfImageAnalysis.SharedSize))
+}
+
+type dfLayer struct {
+	*entities.SystemDfLayerReport
+}
+
+func (d *dfLayer) LayerID() string {
+	return d.SystemDfLayerReport.LayerID[0:12]
+}
+
+func (d *dfLayer) Size() string {
+	return units.HumanSize(float64(d.SystemDfLayerReport.Size))
+}
+
+func (d *dfLayer) Images() string {
+	ids := make([]string, 0, len(d.SystemDfLayerReport.Images))
+	for _, id := range d.SystemDfLayerReport.Images {
+		ids = append(ids, id[0:12])
+	}
+	return strings.Join(ids, ",")
+}
+
+type dfBuildCache struct {
+	*entities.SystemDfBuildCacheReport
+}
+
+func (d *dfBuildCache) ImageID() string {
+	return d.SystemDfBuildCacheReport.ImageID[0:12]
+}
+
+func (d *dfBuildCache) Created() string {
+	return units.HumanDuration(time.Since(d.SystemDfBuildCacheReport.Created))
+}
+
+func (d *dfBuildCache) Size() string {
+	return units.HumanSize(float64(d.SystemDfBuildCacheReport.Size))
+}
+
+func (d *dfBuildCache) ReclaimableSize() string {
+	return units.HumanSize(float64(d.SystemDfBuildCacheReport.ReclaimableSize))
+}
+
 type dfSummary struct {
 	Type           string
 	Total          int
@@ -0,0 +1,42 @@
+//go:build !remote
+
+package system
+
+import (
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportDescription = `
+        podman system export FILE
+
+        Export images, volumes, networks, secret metadata, pods, containers and
+        quadlet files into a single bundle which can be restored on another host
+        with podman system import.
+`
+
+	exportCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "export FILE",
+		Args:              cobra.ExactArgs(1),
+		Short:             "Export the local state into a bundle",
+		Long:              exportDescription,
+		RunE:              export,
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Example:           `podman system export /tmp/podman-state.tar`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: exportCommand,
+		Parent:  systemCmd,
+	})
+}
+
+func export(cmd *cobra.Command, args []string) error {
+	return registry.ContainerEngine().SystemExport(registry.Context(), entities.SystemExportOptions{Output: args[0]})
+}
@@ -0,0 +1,58 @@
+//go:build !remote
+
+package system
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importDescription = `
+        podman system import FILE
+
+        Import a bundle written by podman system export.  Objects keep their
+        names, containers are created but not started.
+`
+
+	importCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "import FILE",
+		Args:              cobra.ExactArgs(1),
+		Short:             "Import a bundle written by podman system export",
+		Long:              importDescription,
+		RunE:              importBundle,
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Example:           `podman system import /tmp/podman-state.tar`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: importCommand,
+		Parent:  systemCmd,
+	})
+}
+
+func importBundle(cmd *cobra.Command, args []string) error {
+	report, err := registry.ContainerEngine().SystemImport(registry.Context(), entities.SystemImportOptions{Input: args[0]})
+	if report != nil {
+		printImported("Image", report.Images)
+		printImported("Volume", report.Volumes)
+		printImported("Network", report.Networks)
+		printImported("Pod", report.Pods)
+		printImported("Container", report.Containers)
+		printImported("Quadlet", report.Quadlets)
+	}
+	return err
+}
+
+func printImported(kind string, names []string) {
+	for _, name := range names {
+		fmt.Printf("%s: %s\n", kind, name)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v5/cmd/podman/common"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/validate"
 	"github.com/containers/podman/v5/libpod/define"
@@ -50,9 +51,25 @@ func init() {
 	newRuntimeFlagName := "new-runtime"
 	flags.StringVar(&migrateOptions.NewRuntime, newRuntimeFlagName, "", "Specify a new runtime for all containers")
 	_ = migrateCommand.RegisterFlagCompletionFunc(newRuntimeFlagName, completion.AutocompleteNone)
+
+	databaseFlagName := "database"
+	flags.StringVar(&migrateOptions.Database, databaseFlagName, "", "Migrate the state to the given database backend (sqlite)")
+	_ = migrateCommand.RegisterFlagCompletionFunc(databaseFlagName, common.AutocompleteDatabaseBackend)
+
+	flags.BoolVar(&migrateOptions.DryRun, "dry-run", false, "Copy and verify the database without switching to it")
+	flags.BoolVar(&migrateOptions.Rollback, "rollback", false, "Undo the last database migration")
+
+	migrateCommand.MarkFlagsMutuallyExclusive("rollback", databaseFlagName)
+	migrateCommand.MarkFlagsMutuallyExclusive("rollback", "dry-run")
+	migrateCommand.MarkFlagsMutuallyExclusive(databaseFlagName, newRuntimeFlagName)
+	migrateCommand.MarkFlagsMutuallyExclusive("rollback", newRuntimeFlagName)
 }
 
 func migrate(cmd *cobra.Command, args []string) {
+	if migrateOptions.DryRun && migrateOptions.Database == "" {
+		fmt.Println("--dry-run can only be used with --database")
+		os.Exit(define.ExecErrorCodeGeneric)
+	}
 	if err := registry.ContainerEngine().Migrate(registry.Context(), migrateOptions); err != nil {
 		fmt.Println(err)
 
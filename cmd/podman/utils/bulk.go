@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/containers/podman/v5/cmd/podman/registry"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/parallel"
+)
+
+// BulkReport is the outcome of an operation on one of the objects selected by
+// a command operating on several containers, pods or volumes.
+type BulkReport struct {
+	Id       string `json:"Id"` //nolint:revive,stylecheck
+	RawInput string `json:"RawInput,omitempty"`
+	// Result of the operation, for instance the exit code of a container
+	// waited for.  If set, it is printed instead of the ID.
+	Result any    `json:"Result,omitempty"`
+	Err    error  `json:"-"`
+	Error  string `json:"Error,omitempty"`
+}
+
+// ValidateBulkFormat returns an error if format is not supported by
+// PrintBulkReports.
+func ValidateBulkFormat(format string) error {
+	if format != "" && format != "json" {
+		return fmt.Errorf("unsupported format %q, only json is supported", format)
+	}
+	return nil
+}
+
+// PrintBulkReports prints the reports, one line per object, or as a JSON
+// array if format is "json".  In the former case the errors are printed to
+// stderr with the last one returned, in the latter they are part of the
+// reports and only the exit code is set, unless already set by the caller.
+func PrintBulkReports(format string, reports []BulkReport) error {
+	var errs OutputErrors
+	switch format {
+	case "":
+		for _, r := range reports {
+			switch {
+			case r.Err != nil:
+				// Print every error of a pod on its own line.
+				if joined, ok := r.Err.(interface{ Unwrap() []error }); ok {
+					errs = append(errs, joined.Unwrap()...)
+				} else {
+					errs = append(errs, r.Err)
+				}
+			case r.Result != nil:
+				fmt.Println(r.Result)
+			case r.RawInput != "":
+				fmt.Println(r.RawInput)
+			default:
+				fmt.Println(r.Id)
+			}
+		}
+		return errs.PrintErrors()
+	case "json":
+		for i := range reports {
+			if reports[i].Err != nil {
+				reports[i].Error = reports[i].Err.Error()
+				errs = append(errs, reports[i].Err)
+			}
+		}
+		if reports == nil {
+			reports = []BulkReport{}
+		}
+		b, err := json.MarshalIndent(reports, "", "     ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		if len(errs) > 0 && registry.GetExitCode() == 0 {
+			registry.SetExitCode(define.ExecErrorCodeGeneric)
+		}
+		return nil
+	default:
+		return ValidateBulkFormat(format)
+	}
+}
+
+// RunBulkOp runs op for each of the IDs and returns the reports in the order
+// of the IDs.  With a local engine the operations run in parallel using
+// pkg/parallel; the remote client runs them one after the other.
+func RunBulkOp(ids []string, op func(id string) BulkReport) []BulkReport {
+	reports := make([]BulkReport, len(ids))
+	if registry.IsRemote() {
+		for i, id := range ids {
+			reports[i] = op(id)
+		}
+		return reports
+	}
+	errChans := make([]<-chan error, 0, len(ids))
+	for i, id := range ids {
+		i, id := i, id
+		errChans = append(errChans, parallel.Enqueue(context.Background(), func() error {
+			reports[i] = op(id)
+			return nil
+		}))
+	}
+	for i, errChan := range errChans {
+		if err := <-errChan; err != nil {
+			reports[i] = BulkReport{Id: ids[i], Err: err}
+		}
+	}
+	return reports
+}
+
+// ContainerIDsByFilters returns the IDs of the containers matching the
+// filters, for commands which operate on a single container by themselves.
+// Only running containers are considered unless all is set.
+func ContainerIDsByFilters(filters map[string][]string, all bool) ([]string, error) {
+	ctrs, err := registry.ContainerEngine().ContainerList(context.Background(), entities.ContainerListOptions{All: all, Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(ctrs))
+	for _, c := range ctrs {
+		ids = append(ids, c.ID)
+	}
+	return ids, nil
+}
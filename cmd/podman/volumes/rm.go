@@ -3,11 +3,11 @@ package volumes
 import (
 	"context"
 	"errors"
-	"fmt"
 	"strings"
 
 	"github.com/containers/common/pkg/completion"
 	"github.com/containers/podman/v5/cmd/podman/common"
+	"github.com/containers/podman/v5/cmd/podman/parse"
 	"github.com/containers/podman/v5/cmd/podman/registry"
 	"github.com/containers/podman/v5/cmd/podman/utils"
 	"github.com/containers/podman/v5/libpod/define"
@@ -28,12 +28,15 @@ var (
 		ValidArgsFunction: common.AutocompleteVolumes,
 		Example: `podman volume rm myvol1 myvol2
   podman volume rm --all
-  podman volume rm --force myvol`,
+  podman volume rm --force myvol
+  podman volume rm --filter dangling=true`,
 	}
 )
 
 var (
 	rmOptions   = entities.VolumeRmOptions{}
+	rmFilters   []string
+	rmFormat    string
 	stopTimeout int
 )
 
@@ -48,13 +51,31 @@ func init() {
 	timeFlagName := "time"
 	flags.IntVarP(&stopTimeout, timeFlagName, "t", int(containerConfig.Engine.StopTimeout), "Seconds to wait for running containers to stop before killing the container")
 	_ = rmCommand.RegisterFlagCompletionFunc(timeFlagName, completion.AutocompleteNone)
+
+	// -f is --force
+	filterFlagName := "filter"
+	flags.StringArrayVar(&rmFilters, filterFlagName, []string{}, "Remove the volumes matching the given conditions")
+	_ = rmCommand.RegisterFlagCompletionFunc(filterFlagName, common.AutocompleteVolumeFilters)
+
+	formatFlagName := "format"
+	flags.StringVar(&rmFormat, formatFlagName, "", "Print a report for each volume in the given format (json)")
+	_ = rmCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteBulkFormat)
 }
 
 func rm(cmd *cobra.Command, args []string) error {
-	var (
-		errs utils.OutputErrors
-	)
-	if (len(args) > 0 && rmOptions.All) || (len(args) < 1 && !rmOptions.All) {
+	if err := utils.ValidateBulkFormat(rmFormat); err != nil {
+		return err
+	}
+	if len(rmFilters) > 0 {
+		if len(args) > 0 || rmOptions.All {
+			return errors.New("--filter cannot be used with volume names or --all")
+		}
+		filters, err := parse.FilterArgumentsIntoFilters(rmFilters)
+		if err != nil {
+			return err
+		}
+		rmOptions.Filters = filters
+	} else if (len(args) > 0 && rmOptions.All) || (len(args) < 1 && !rmOptions.All) {
 		return errors.New("choose either one or more volumes or all")
 	}
 	if cmd.Flag("time").Changed {
@@ -72,18 +93,17 @@ func rm(cmd *cobra.Command, args []string) error {
 		setExitCode(err)
 		return err
 	}
+	reports := make([]utils.BulkReport, 0, len(responses))
 	for _, r := range responses {
-		if r.Err == nil {
-			fmt.Println(r.Id)
-		} else {
+		if r.Err != nil {
 			if rmOptions.Force && strings.Contains(r.Err.Error(), define.ErrNoSuchVolume.Error()) {
 				continue
 			}
 			setExitCode(r.Err)
-			errs = append(errs, r.Err)
 		}
+		reports = append(reports, utils.BulkReport{Id: r.Id, Err: r.Err})
 	}
-	return errs.PrintErrors()
+	return utils.PrintBulkReports(rmFormat, reports)
 }
 
 func setExitCode(err error) {
@@ -262,13 +262,24 @@ func serve(listener net.Listener, pm rkport.Manager) {
 	}
 }
 
+// handler reloads the exposed ports.  The request is either the JSON encoded
+// child IP to expose the same ports with, or a rootlessport.Config with the
+// child IP and the port mappings to replace the exposed ports with.
 func handler(ctx context.Context, conn io.Reader, pm rkport.Manager) error {
-	var childIP string
+	var request json.RawMessage
 	dec := json.NewDecoder(conn)
-	err := dec.Decode(&childIP)
+	err := dec.Decode(&request)
 	if err != nil {
 		return fmt.Errorf("rootless port failed to decode ports: %w", err)
 	}
+	var childIP string
+	var cfg *rootlessport.Config
+	if err := json.Unmarshal(request, &childIP); err != nil {
+		cfg = &rootlessport.Config{}
+		if err := json.Unmarshal(request, cfg); err != nil {
+			return fmt.Errorf("rootless port failed to decode ports: %w", err)
+		}
+	}
 	portStatus, err := pm.ListPorts(ctx)
 	if err != nil {
 		return fmt.Errorf("rootless port failed to list ports: %w", err)
@@ -279,6 +290,13 @@ func handler(ctx context.Context, conn io.Reader, pm rkport.Manager) error {
 			return fmt.Errorf("rootless port failed to remove port: %w", err)
 		}
 	}
+	if cfg != nil {
+		// expose the new port mappings
+		if err := exposePorts(pm, cfg.Mappings, cfg.ChildIP); err != nil {
+			return fmt.Errorf("rootless port failed to add port: %w", err)
+		}
+		return nil
+	}
 	// add the ports with the new child IP
 	for _, status := range portStatus {
 		// set the new child IP
@@ -196,6 +196,12 @@ type ContainerState struct {
 	// restart policy. This is NOT incremented by normal container restarts
 	// (only by restart policy).
 	RestartCount uint `json:"restartCount,omitempty"`
+	// RestartBackoff is the delay applied before the last restart by the
+	// restart policy.
+	RestartBackoff time.Duration `json:"restartBackoff,omitempty"`
+	// RestartingAt is the time at which the container is restarted by
+	// its restart policy, while it waits for the backoff delay.
+	RestartingAt time.Time `json:"restartingAt,omitempty"`
 	// StartupHCPassed indicates that the startup healthcheck has
 	// succeeded and the main healthcheck can begin.
 	StartupHCPassed bool `json:"startupHCPassed,omitempty"`
@@ -275,6 +281,17 @@ type ContainerImageVolume struct {
 	ReadWrite bool `json:"rw"`
 }
 
+// ContainerRootfsVolume is a volume based on the root filesystem of another
+// container.  The root filesystem must be mounted on the host, which is the
+// case while the container is running, and is then bind-mounted into the
+// container.
+type ContainerRootfsVolume struct {
+	// Source is the ID of the container whose root filesystem is mounted.
+	Source string `json:"source"`
+	// Dest is the absolute path of the mount in the container.
+	Dest string `json:"dest"`
+}
+
 // ContainerSecret is a secret that is mounted in a container
 type ContainerSecret struct {
 	// Secret is the secret
@@ -498,6 +515,11 @@ func (c *Container) Dependencies() []string {
 		dependsCtrs[c.config.CgroupNsCtr] = true
 	}
 
+	// Containers whose root filesystem is mounted must be running
+	for _, vol := range c.config.RootfsVolumes {
+		dependsCtrs[vol.Source] = true
+	}
+
 	// Add all generic dependencies
 	for _, id := range c.config.Dependencies {
 		dependsCtrs[id] = true
@@ -642,6 +664,16 @@ func (c *Container) RestartPolicy() string {
 	return c.config.RestartPolicy
 }
 
+// RestartBackoff returns the backoff of restarts by the restart policy, or nil
+// if the container is restarted right away.
+func (c *Container) RestartBackoff() *define.RestartBackoff {
+	if c.config.RestartBackoff == nil {
+		return nil
+	}
+	backoff := *c.config.RestartBackoff
+	return &backoff
+}
+
 // RestartRetries returns the number of retries that will be attempted when
 // using the "on-failure" restart policy
 func (c *Container) RestartRetries() uint {
@@ -739,6 +771,20 @@ func (c *Container) State() (define.ContainerStatus, error) {
 	return c.state.State, nil
 }
 
+// RestartingAt returns the time at which the container is restarted by its
+// restart policy, or the zero time if it does not wait for a restart.
+func (c *Container) RestartingAt() (time.Time, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return time.Time{}, err
+		}
+	}
+	return c.state.RestartingAt, nil
+}
+
 func (c *Container) RestartCount() (uint, error) {
 	if !c.batched {
 		c.lock.Lock()
@@ -821,14 +821,34 @@ func (c *Container) Cleanup(ctx context.Context) error {
 		return nil
 	}
 
-	// Handle restart policy.
-	// Returns a bool indicating whether we actually restarted.
-	// If we did, don't proceed to cleanup - just exit.
-	didRestart, err := c.handleRestartPolicy(ctx)
+	// A critical container restarts its whole pod rather than itself, so
+	// it is cleaned up normally before the pod is restarted.
+	pod, err := c.shouldRestartPod()
 	if err != nil {
 		return err
 	}
-	if didRestart {
+	if pod != nil {
+		c.restartPod(pod)
+	} else {
+		// Handle restart policy.
+		// Returns a bool indicating whether we actually restarted.
+		// If we did, don't proceed to cleanup - just exit.
+		didRestart, err := c.handleRestartPolicy(ctx)
+		if err != nil {
+			// The container may have been removed while waiting for
+			// the restart backoff, which also cleaned it up.
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				return nil
+			}
+			return err
+		}
+		if didRestart {
+			return nil
+		}
+	}
+	// While waiting for the restart backoff, the container may have been
+	// started again or cleaned up by another process.
+	if c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused, define.ContainerStateConfigured, define.ContainerStateExited) {
 		return nil
 	}
 
@@ -1087,9 +1107,42 @@ func (c *Container) ShouldRestart(ctx context.Context) bool {
 	return c.shouldRestart()
 }
 
+// CopyFromArchiveOptions are the options for copying an archive into a
+// container.
+type CopyFromArchiveOptions struct {
+	// Chown changes the ownership of the copied files to the primary
+	// user and group of the container.
+	Chown bool
+	// NoOverwriteDirNonDir prevents an existing directory from being
+	// replaced by a non-directory and vice versa.
+	NoOverwriteDirNonDir bool
+	// NoOverwriteDir keeps the ownership, permissions and timestamps of
+	// directories which already exist in the container.
+	NoOverwriteDir bool
+	// StripXattrs drops the extended attributes of the copied files.
+	StripXattrs bool
+	// StripSpecialBits drops the setuid, setgid and sticky bits of the
+	// copied files.
+	StripSpecialBits bool
+	// Rename maps path names of the archive to the names they are
+	// copied to.
+	Rename map[string]string
+	// Remove are paths relative to the destination which are removed,
+	// including their contents, before the archive is extracted.
+	Remove []string
+}
+
+// CopyToArchiveOptions are the options for copying from a container into
+// an archive.
+type CopyToArchiveOptions struct {
+	// NoChown keeps the user and group IDs of the copied files instead of
+	// changing them to the ones of the container user on the host.
+	NoChown bool
+}
+
 // CopyFromArchive copies the contents from the specified tarStream to path
 // *inside* the container.
-func (c *Container) CopyFromArchive(_ context.Context, containerPath string, chown, noOverwriteDirNonDir bool, rename map[string]string, tarStream io.Reader) (func() error, error) {
+func (c *Container) CopyFromArchive(_ context.Context, containerPath string, options CopyFromArchiveOptions, tarStream io.Reader) (func() error, error) {
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -1099,12 +1152,12 @@ func (c *Container) CopyFromArchive(_ context.Context, containerPath string, cho
 		}
 	}
 
-	return c.copyFromArchive(containerPath, chown, noOverwriteDirNonDir, rename, tarStream)
+	return c.copyFromArchive(containerPath, options, tarStream)
 }
 
 // CopyToArchive copies the contents from the specified path *inside* the
 // container to the tarStream.
-func (c *Container) CopyToArchive(ctx context.Context, containerPath string, tarStream io.Writer) (func() error, error) {
+func (c *Container) CopyToArchive(ctx context.Context, containerPath string, options CopyToArchiveOptions, tarStream io.Writer) (func() error, error) {
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -1114,7 +1167,7 @@ func (c *Container) CopyToArchive(ctx context.Context, containerPath string, tar
 		}
 	}
 
-	return c.copyToArchive(containerPath, tarStream)
+	return c.copyToArchive(containerPath, options, tarStream)
 }
 
 // Stat the specified path *inside* the container and return a file info.
@@ -81,6 +81,13 @@ type ContainerConfig struct {
 	// Dependencies are the IDs of dependency containers.
 	// These containers must be started before this container is started.
 	Dependencies []string
+	// DependencyConditions are the conditions, indexed by the ID of the
+	// dependency container, which must be met before this container is
+	// started. Dependencies without a condition must be running.
+	DependencyConditions map[string]string `json:"dependencyConditions,omitempty"`
+	// DependencyTimeout is the time to wait for the dependency conditions
+	// to be met.
+	DependencyTimeout time.Duration `json:"dependencyTimeout,omitempty"`
 
 	// rewrite is an internal bool to indicate that the config was modified after
 	// a read from the db, e.g. to migrate config fields after an upgrade.
@@ -162,6 +169,9 @@ type ContainerRootFSConfig struct {
 	// moved out of Libpod into pkg/specgen).
 	// Please DO NOT reuse the `imageVolumes` name in container JSON again.
 	ImageVolumes []*ContainerImageVolume `json:"ctrImageVolumes,omitempty"`
+	// RootfsVolumes lists the root filesystems of other containers to
+	// mount into the container.
+	RootfsVolumes []*ContainerRootfsVolume `json:"rootfsVolumes,omitempty"`
 	// CreateWorkingDir indicates that Libpod should create the container's
 	// working directory if it does not exist. Some OCI runtimes do this by
 	// default, but others do not.
@@ -299,6 +309,10 @@ type ContainerNetworkConfig struct {
 	// Hosts to add in container
 	// Will be appended to host's host file
 	HostAdd []string `json:"hostsAdd,omitempty"`
+	// HostsGroup is the name of a group of containers which add their
+	// names and addresses to the hosts files of each other while running.
+	// Only used when the container has its own network namespace.
+	HostsGroup string `json:"hostsGroup,omitempty"`
 	// Network names with the network specific options.
 	// Please note that these can be altered at runtime. The actual list is
 	// stored in the DB and should be retrieved from there via c.networks()
@@ -317,6 +331,11 @@ type ContainerNetworkConfig struct {
 	NetMode namespaces.NetworkMode `json:"networkMode,omitempty"`
 	// NetworkOptions are additional options for each network
 	NetworkOptions map[string][]string `json:"network_options,omitempty"`
+	// NetworkRates are the bandwidth limits of the container, keyed by the
+	// network name. The limit with the empty key applies to all networks
+	// without a limit of their own.
+	// These are not used unless CreateNetNS is true
+	NetworkRates map[string]define.NetworkRate `json:"networkRates,omitempty"`
 }
 
 // ContainerImageConfig is an embedded sub-config providing image configuration
@@ -391,6 +410,13 @@ type ContainerMiscConfig struct {
 	// restart the container. Used only if RestartPolicy is set to
 	// "on-failure".
 	RestartRetries uint `json:"restart_retries,omitempty"`
+	// RestartBackoff, if set, delays restarts by the restart policy
+	// exponentially.
+	RestartBackoff *define.RestartBackoff `json:"restart_backoff,omitempty"`
+	// PodCritical indicates that the whole pod of the container is
+	// restarted according to the pod's restart policy when the container
+	// exits or turns unhealthy.
+	PodCritical bool `json:"pod_critical,omitempty"`
 	// PostConfigureNetNS needed when a user namespace is created by an OCI runtime
 	// if the network namespace is created before the user namespace it will be
 	// owned by the wrong user namespace.
@@ -413,6 +439,10 @@ type ContainerMiscConfig struct {
 	HealthCheckConfig *manifest.Schema2HealthConfig `json:"healthcheck"`
 	// HealthCheckOnFailureAction defines an action to take once the container turns unhealthy.
 	HealthCheckOnFailureAction define.HealthCheckOnFailureAction `json:"healthcheck_on_failure_action"`
+	// HealthCheckCheckpointRetention is the number of checkpoint archives
+	// kept for the checkpoint on-failure action. If 0, the default of
+	// define.DefaultHealthCheckCheckpointRetention is used.
+	HealthCheckCheckpointRetention uint `json:"healthcheck_checkpoint_retention,omitempty"`
 	// StartupHealthCheckConfig is the configuration of the startup
 	// healthcheck for the container. This will run before the regular HC
 	// runs, and when it passes the regular HC will be activated.
@@ -4,6 +4,7 @@ package libpod
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"path/filepath"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/containers/buildah/pkg/chrootuser"
 	"github.com/containers/buildah/util"
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/copy"
 	"github.com/containers/podman/v5/pkg/rootless"
 	"github.com/containers/storage/pkg/archive"
 	"github.com/containers/storage/pkg/idtools"
@@ -19,7 +21,7 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func (c *Container) copyFromArchive(path string, chown, noOverwriteDirNonDir bool, rename map[string]string, reader io.Reader) (func() error, error) {
+func (c *Container) copyFromArchive(path string, options CopyFromArchiveOptions, reader io.Reader) (func() error, error) {
 	var (
 		mountPoint   string
 		resolvedRoot string
@@ -34,6 +36,12 @@ func (c *Container) copyFromArchive(path string, chown, noOverwriteDirNonDir boo
 		path = "/."
 	}
 
+	for _, name := range options.Remove {
+		if !filepath.IsLocal(name) {
+			return nil, fmt.Errorf("path %q to remove is not below the destination: %w", name, define.ErrInvalidArg)
+		}
+	}
+
 	// Optimization: only mount if the container is not already.
 	if c.state.Mounted {
 		mountPoint = c.state.Mountpoint
@@ -58,7 +66,7 @@ func (c *Container) copyFromArchive(path string, chown, noOverwriteDirNonDir boo
 	}
 
 	var idPair *idtools.IDPair
-	if chown {
+	if options.Chown {
 		// Make sure we chown the files to the container's main user and group ID.
 		user, err := getContainerUser(c, mountPoint)
 		if err != nil {
@@ -74,6 +82,36 @@ func (c *Container) copyFromArchive(path string, chown, noOverwriteDirNonDir boo
 		return nil, err
 	}
 
+	var tarStream io.Reader = decompressed
+	var filterErr chan error
+	if options.NoOverwriteDir {
+		// Drop the headers of existing directories, so that their
+		// metadata is left alone.  The filter runs outside of the
+		// mount namespace, so look up the directories from the host.
+		filter, err := copy.NewArchiveFilter(nil, nil)
+		if err != nil {
+			decompressed.Close()
+			unmount()
+			return nil, err
+		}
+		hostRoot := c.copyTargetHostRoot(resolvedRoot)
+		rel, err := filepath.Rel(resolvedRoot, resolvedPath)
+		if err != nil {
+			decompressed.Close()
+			unmount()
+			return nil, err
+		}
+		filter.SkipDirectory = copy.ExistingDirectory(hostRoot, rel, options.Rename)
+		pipeReader, pipeWriter := io.Pipe()
+		filterErr = make(chan error, 1)
+		go func() {
+			err := filter.Copy(pipeWriter, decompressed)
+			pipeWriter.CloseWithError(err)
+			filterErr <- err
+		}()
+		tarStream = pipeReader
+	}
+
 	logrus.Debugf("Container copy *to* %q (resolved: %q) on container %q (ID: %s)", path, resolvedPath, c.Name(), c.ID())
 
 	return func() error {
@@ -84,20 +122,39 @@ func (c *Container) copyFromArchive(path string, chown, noOverwriteDirNonDir boo
 			GIDMap:               c.config.IDMappings.GIDMap,
 			ChownDirs:            idPair,
 			ChownFiles:           idPair,
-			NoOverwriteDirNonDir: noOverwriteDirNonDir,
-			NoOverwriteNonDirDir: noOverwriteDirNonDir,
-			Rename:               rename,
+			NoOverwriteDirNonDir: options.NoOverwriteDirNonDir,
+			NoOverwriteNonDirDir: options.NoOverwriteDirNonDir,
+			StripXattrs:          options.StripXattrs,
+			StripSetuidBit:       options.StripSpecialBits,
+			StripSetgidBit:       options.StripSpecialBits,
+			StripStickyBit:       options.StripSpecialBits,
+			Rename:               options.Rename,
 		}
 
-		return c.joinMountAndExec(
+		err := c.joinMountAndExec(
 			func() error {
-				return buildahCopiah.Put(resolvedRoot, resolvedPath, putOptions, decompressed)
+				for _, name := range options.Remove {
+					if err := buildahCopiah.Remove(resolvedRoot, filepath.Join(resolvedPath, name), buildahCopiah.RemoveOptions{All: true}); err != nil {
+						return err
+					}
+				}
+				return buildahCopiah.Put(resolvedRoot, resolvedPath, putOptions, tarStream)
 			},
 		)
+		if filterErr != nil {
+			// Unblock the filter if Put stopped early.
+			if closer, ok := tarStream.(io.Closer); ok {
+				closer.Close()
+			}
+			if ferr := <-filterErr; err == nil && ferr != nil && !errors.Is(ferr, io.ErrClosedPipe) {
+				err = ferr
+			}
+		}
+		return err
 	}, nil
 }
 
-func (c *Container) copyToArchive(path string, writer io.Writer) (func() error, error) {
+func (c *Container) copyToArchive(path string, options CopyToArchiveOptions, writer io.Writer) (func() error, error) {
 	var (
 		mountPoint string
 		unmount    func()
@@ -130,22 +187,25 @@ func (c *Container) copyToArchive(path string, writer io.Writer) (func() error,
 	// We optimistically chown to the host user.  In case of a hypothetical
 	// container-to-container copy, the reading side will chown back to the
 	// container user.
-	user, err := getContainerUser(c, mountPoint)
-	if err != nil {
-		unmount()
-		return nil, err
-	}
-	hostUID, hostGID, err := util.GetHostIDs(
-		idtoolsToRuntimeSpec(c.config.IDMappings.UIDMap),
-		idtoolsToRuntimeSpec(c.config.IDMappings.GIDMap),
-		user.UID,
-		user.GID,
-	)
-	if err != nil {
-		unmount()
-		return nil, err
+	var idPair *idtools.IDPair
+	if !options.NoChown {
+		user, err := getContainerUser(c, mountPoint)
+		if err != nil {
+			unmount()
+			return nil, err
+		}
+		hostUID, hostGID, err := util.GetHostIDs(
+			idtoolsToRuntimeSpec(c.config.IDMappings.UIDMap),
+			idtoolsToRuntimeSpec(c.config.IDMappings.GIDMap),
+			user.UID,
+			user.GID,
+		)
+		if err != nil {
+			unmount()
+			return nil, err
+		}
+		idPair = &idtools.IDPair{UID: int(hostUID), GID: int(hostGID)}
 	}
-	idPair := idtools.IDPair{UID: int(hostUID), GID: int(hostGID)}
 
 	logrus.Debugf("Container copy *from* %q (resolved: %q) on container %q (ID: %s)", path, resolvedPath, c.Name(), c.ID())
 
@@ -156,8 +216,8 @@ func (c *Container) copyToArchive(path string, writer io.Writer) (func() error,
 			KeepDirectoryNames: statInfo.IsDir && filepath.Base(path) != ".",
 			UIDMap:             c.config.IDMappings.UIDMap,
 			GIDMap:             c.config.IDMappings.GIDMap,
-			ChownDirs:          &idPair,
-			ChownFiles:         &idPair,
+			ChownDirs:          idPair,
+			ChownFiles:         idPair,
 			Excludes:           []string{"dev", "proc", "sys"},
 			// Ignore EPERMs when copying from rootless containers
 			// since we cannot read TTY devices.  Those are owned
@@ -13,3 +13,9 @@ func (c *Container) joinMountAndExec(f func() error) error {
 func (c *Container) resolveCopyTarget(mountPoint string, containerPath string) (string, string, error) {
 	return c.resolvePath(mountPoint, containerPath)
 }
+
+// copyTargetHostRoot returns the path of root as resolved by
+// resolveCopyTarget as seen from the host.
+func (c *Container) copyTargetHostRoot(root string) string {
+	return root
+}
@@ -88,3 +88,13 @@ func (c *Container) resolveCopyTarget(mountPoint string, containerPath string) (
 	}
 	return c.resolvePath(mountPoint, containerPath)
 }
+
+// copyTargetHostRoot returns the path of root as resolved by
+// resolveCopyTarget as seen from the host.  For running containers, it is
+// relative to the container's mount namespace.
+func (c *Container) copyTargetHostRoot(root string) string {
+	if c.state.State == define.ContainerStateRunning {
+		return fmt.Sprintf("/proc/%d/root%s", c.state.PID, root)
+	}
+	return root
+}
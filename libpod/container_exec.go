@@ -79,6 +79,10 @@ type ExecConfig struct {
 	// exiting, and the exit command being executed. If set to 0, there is
 	// no delay. If set, ExitCommand must also be set.
 	ExitCommandDelay uint `json:"exitCommandDelay,omitempty"`
+	// Name is an optional name of the exec session, which can be used
+	// instead of its ID to attach to it. Names are unique among the
+	// exec sessions of all containers which have not stopped.
+	Name string `json:"name,omitempty"`
 }
 
 // ExecSession contains information on a single exec session attached to a given
@@ -130,6 +134,7 @@ func (e *ExecSession) Inspect() (*define.InspectExecSession, error) {
 	}
 	output.ExitCode = e.ExitCode
 	output.ID = e.Id
+	output.Name = e.Config.Name
 	output.OpenStderr = e.Config.AttachStderr
 	output.OpenStdin = e.Config.AttachStdin
 	output.OpenStdout = e.Config.AttachStdout
@@ -186,6 +191,17 @@ func (c *Container) ExecCreate(config *ExecConfig) (string, error) {
 		return "", fmt.Errorf("can only create exec sessions on running containers: %w", define.ErrCtrStateInvalid)
 	}
 
+	if config.Name != "" {
+		if !define.NameRegex.MatchString(config.Name) {
+			return "", define.RegexError
+		}
+		if _, _, err := c.runtime.lookupExecSessionName(config.Name); err == nil {
+			return "", fmt.Errorf("the exec session name %q is already in use: %w", config.Name, define.ErrExecSessionExists)
+		} else if !errors.Is(err, define.ErrNoSuchExecSession) {
+			return "", err
+		}
+	}
+
 	// Generate an ID for our new exec session
 	sessionID := stringid.GenerateRandomID()
 	found := true
@@ -556,6 +572,107 @@ func (c *Container) ExecHTTPStartAndAttach(sessionID string, r *http.Request, w
 	return lastErr
 }
 
+// runningExecSession returns the PID and a copy of the config of the exec
+// session with the given ID after verifying that it can be attached to.
+// The container lock is released again before it returns, so the session
+// must not be read from the container state afterwards.
+func (c *Container) runningExecSession(sessionID string) (int, *ExecConfig, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	session, ok := c.state.ExecSessions[sessionID]
+	if !ok {
+		return 0, nil, fmt.Errorf("container %s has no exec session with ID %s: %w", c.ID(), sessionID, define.ErrNoSuchExecSession)
+	}
+
+	if !c.ensureState(define.ContainerStateRunning) {
+		return 0, nil, fmt.Errorf("can only attach to exec sessions when their container is running: %w", define.ErrCtrStateInvalid)
+	}
+
+	if session.State != define.ExecStateRunning {
+		return 0, nil, fmt.Errorf("can only attach to running exec sessions, while container %s session %s state is %q: %w", c.ID(), session.ID(), session.State.String(), define.ErrExecSessionStateInvalid)
+	}
+	config := *session.Config
+	return session.PID, &config, nil
+}
+
+// ExecAttach attaches to a running exec session. Unlike ExecStartAndAttach,
+// the exec session is not stopped when the attach ends, so it can be attached
+// to again later. If detachKeys is nil, the detach keys of the exec session
+// are used.
+func (c *Container) ExecAttach(sessionID string, streams *define.AttachStreams, detachKeys *string, resizeChan <-chan resize.TerminalSize) error {
+	// We are NOT holding the lock for the duration of the function.
+	pid, config, err := c.runningExecSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	opts := new(AttachOptions)
+	opts.Streams = streams
+	opts.DetachKeys = detachKeys
+	if opts.DetachKeys == nil {
+		opts.DetachKeys = config.DetachKeys
+	}
+
+	if config.Terminal {
+		registerResizeFunc(resizeChan, c.execBundlePath(sessionID))
+
+		// Send a SIGWINCH after attach succeeds so that most programs
+		// redraw the screen, like Attach does for containers.
+		attachRdy := make(chan bool, 1)
+		opts.AttachReady = attachRdy
+		go func() {
+			<-attachRdy
+			if err := unix.Kill(pid, unix.SIGWINCH); err != nil {
+				logrus.Debugf("Unable to send SIGWINCH to container %s exec session %s after attach: %v", c.ID(), sessionID, err)
+			}
+		}()
+	}
+
+	logrus.Infof("Attaching to container %s exec session %s", c.ID(), sessionID)
+
+	c.newContainerEvent(events.Attach)
+	return c.ociRuntime.ExecAttach(c, sessionID, opts)
+}
+
+// ExecHTTPAttach performs an HTTP attach to a running exec session. Like
+// ExecAttach, the exec session keeps running when the attach ends.
+func (c *Container) ExecHTTPAttach(sessionID string, r *http.Request, w http.ResponseWriter,
+	streams *HTTPAttachStreams, detachKeys *string, cancel <-chan bool, hijackDone chan<- bool) error {
+	// Ensure that we don't leak a goroutine here
+	defer func() {
+		close(hijackDone)
+	}()
+
+	// We are NOT holding the lock for the duration of the function.
+	_, config, err := c.runningExecSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	if streams == nil {
+		// the output streams are not recorded for detached sessions
+		streams = new(HTTPAttachStreams)
+		streams.Stdin = config.AttachStdin
+		streams.Stdout = true
+		streams.Stderr = true
+	}
+	if detachKeys == nil {
+		detachKeys = config.DetachKeys
+	}
+
+	logrus.Infof("Performing HTTP Hijack attach to container %s exec session %s", c.ID(), sessionID)
+
+	c.newContainerEvent(events.Attach)
+	return c.ociRuntime.ExecHTTPAttach(c, sessionID, r, w, streams, detachKeys, config.Terminal, cancel, hijackDone)
+}
+
 // ExecStop stops an exec session in the container.
 // If a timeout is provided, it will be used; otherwise, the timeout will
 // default to the stop timeout of the container.
@@ -249,6 +249,15 @@ func startNode(ctx context.Context, node *containerNode, setError bool, ctrError
 		ctrErrored = true
 	}
 
+	// Wait for the dependencies to meet their conditions, before the
+	// container is locked
+	if !ctrErrored {
+		if err := node.container.waitDependencyConditions(ctx); err != nil {
+			ctrErrors[node.id] = err
+			ctrErrored = true
+		}
+	}
+
 	// Lock before we start
 	node.container.lock.Lock()
 
@@ -166,12 +166,19 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 		GraphDriver:             driverData,
 		Mounts:                  inspectMounts,
 		Dependencies:            c.Dependencies(),
+		DependencyConditions:    config.DependencyConditions,
 		IsInfra:                 c.IsInfra(),
 		IsService:               c.IsService(),
 		KubeExitCodePropagation: config.KubeExitCodePropagation.String(),
 		LockNumber:              c.lock.ID(),
 	}
 
+	if !runtimeInfo.RestartingAt.IsZero() {
+		data.State.Restarting = true
+		data.State.RestartBackoff = runtimeInfo.RestartBackoff.String()
+		data.State.RestartingAt = runtimeInfo.RestartingAt
+	}
+
 	if config.RootfsImageID != "" { // May not be set if the container was created with --rootfs
 		image, _, err := c.runtime.libimageRuntime.LookupImage(config.RootfsImageID, nil)
 		if err != nil {
@@ -411,6 +418,9 @@ func (c *Container) generateInspectContainerConfig(spec *spec.Spec) *define.Insp
 	ctrConfig.Healthcheck = c.config.HealthCheckConfig
 
 	ctrConfig.HealthcheckOnFailureAction = c.config.HealthCheckOnFailureAction.String()
+	if c.config.HealthCheckOnFailureAction == define.HealthCheckOnFailureActionCheckpoint {
+		ctrConfig.HealthcheckCheckpointRetention = c.healthCheckCheckpointRetention()
+	}
 
 	ctrConfig.CreateCommand = c.config.CreateCommand
 
@@ -468,6 +478,7 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 	restartPolicy := new(define.InspectRestartPolicy)
 	restartPolicy.Name = c.config.RestartPolicy
 	restartPolicy.MaximumRetryCount = c.config.RestartRetries
+	restartPolicy.Backoff = c.RestartBackoff()
 	hostConfig.RestartPolicy = restartPolicy
 	if c.config.NoCgroups {
 		hostConfig.Cgroups = "disabled"
@@ -217,7 +217,8 @@ func (c *Container) handleExitFile(exitFile string, fi os.FileInfo) error {
 }
 
 func (c *Container) shouldRestart() bool {
-	if c.config.HealthCheckOnFailureAction == define.HealthCheckOnFailureActionRestart {
+	if c.config.HealthCheckOnFailureAction == define.HealthCheckOnFailureActionRestart ||
+		c.config.HealthCheckOnFailureAction == define.HealthCheckOnFailureActionCheckpoint {
 		isUnhealthy, err := c.isUnhealthy()
 		if err != nil {
 			logrus.Errorf("Checking if container is unhealthy: %v", err)
@@ -265,6 +266,13 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 	}
 	logrus.Debugf("Restarting container %s due to restart policy %s", c.ID(), c.config.RestartPolicy)
 
+	if c.config.RestartBackoff != nil {
+		restart, err := c.waitRestartBackoff(ctx)
+		if err != nil || !restart {
+			return false, err
+		}
+	}
+
 	// Need to check if dependencies are alive.
 	if err := c.checkDependenciesAndHandleError(); err != nil {
 		return false, err
@@ -341,6 +349,79 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 	return true, nil
 }
 
+// waitRestartBackoff waits for the backoff delay before the container is
+// restarted by its restart policy.  The delay starts at the initial delay
+// and doubles whenever the container exits before it ran for the reset
+// window.  Unless the container is batched, it is unlocked while waiting so
+// that it can be inspected, stopped or removed in the meantime.  Returns
+// false if the restart was canceled.
+func (c *Container) waitRestartBackoff(ctx context.Context) (bool, error) {
+	ranFor := c.state.FinishedTime.Sub(c.state.StartedTime)
+	delay, crashLoop := nextRestartBackoff(c.config.RestartBackoff, c.state.RestartBackoff, ranFor, c.wasHealthy())
+	if crashLoop {
+		c.newContainerEvent(events.CrashLoop)
+	}
+	restartingAt := time.Now().Add(delay)
+	c.state.RestartBackoff = delay
+	c.state.RestartingAt = restartingAt
+	if err := c.save(); err != nil {
+		return false, err
+	}
+	logrus.Infof("Restarting container %s in %s", c.ID(), delay)
+
+	if !c.batched {
+		c.lock.Unlock()
+	}
+	timer := time.NewTimer(delay)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		timer.Stop()
+	}
+	if !c.batched {
+		c.lock.Lock()
+		if err := c.syncContainer(); err != nil {
+			return false, err
+		}
+	}
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	// Stopping or starting the container cancels the restart.
+	if !c.state.RestartingAt.Equal(restartingAt) {
+		logrus.Debugf("Restart of container %s was canceled", c.ID())
+		return false, nil
+	}
+	c.state.RestartingAt = time.Time{}
+	return true, c.save()
+}
+
+// nextRestartBackoff returns the delay before the next restart, given the
+// previous delay, how long the container ran and whether it was healthy.
+// crashLoop is true if the container exited again within the reset window
+// after a delayed restart.
+func nextRestartBackoff(backoff *define.RestartBackoff, previous, ranFor time.Duration, healthy bool) (delay time.Duration, crashLoop bool) {
+	if previous == 0 || (ranFor >= backoff.Reset && healthy) {
+		return backoff.Initial, false
+	}
+	delay = previous * 2
+	if delay > backoff.Max {
+		delay = backoff.Max
+	}
+	return delay, true
+}
+
+// wasHealthy returns true if the container has no healthcheck or was healthy
+// when it exited.
+func (c *Container) wasHealthy() bool {
+	if c.config.HealthCheckConfig == nil {
+		return true
+	}
+	status, err := c.healthCheckStatus()
+	return err == nil && status == define.HealthCheckHealthy
+}
+
 // Ensure that the container is in a specific state or state.
 // Returns true if the container is in one of the given states,
 // or false otherwise.
@@ -531,6 +612,10 @@ func (c *Container) setupStorage(ctx context.Context) error {
 		return fmt.Errorf("creating container storage: %w", containerInfoErr)
 	}
 
+	if c.config.RootfsImageID != "" {
+		c.runtime.markImageUsed(c.config.RootfsImageID)
+	}
+
 	// Only reconfig IDMappings if layer was mounted from storage.
 	// If it's an external overlay do not reset IDmappings.
 	if !c.config.RootfsOverlay {
@@ -656,6 +741,8 @@ func resetContainerState(state *ContainerState) {
 	state.StoppedByUser = false
 	state.RestartPolicyMatch = false
 	state.RestartCount = 0
+	state.RestartBackoff = 0
+	state.RestartingAt = time.Time{}
 	state.Checkpointed = false
 	state.Restored = false
 	state.CheckpointedTime = time.Time{}
@@ -844,6 +931,10 @@ func (c *Container) prepareToStart(ctx context.Context, recursive bool) (retErr
 		}
 	}
 
+	if err := c.waitDependencyConditionsUnlocked(ctx); err != nil {
+		return err
+	}
+
 	defer func() {
 		if retErr != nil {
 			if err := c.cleanup(ctx); err != nil {
@@ -870,6 +961,33 @@ func (c *Container) prepareToStart(ctx context.Context, recursive bool) (retErr
 	return nil
 }
 
+// waitDependencyConditionsUnlocked waits for the dependency conditions of
+// the locked container.  Unless the container is batched, it is unlocked
+// while waiting so that it can be inspected in the meantime, and its state
+// is checked again afterwards.
+func (c *Container) waitDependencyConditionsUnlocked(ctx context.Context) error {
+	if len(c.config.DependencyConditions) == 0 {
+		return nil
+	}
+	if !c.batched {
+		c.lock.Unlock()
+	}
+	err := c.waitDependencyConditions(ctx)
+	if !c.batched {
+		c.lock.Lock()
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if !c.ensureState(define.ContainerStateConfigured, define.ContainerStateCreated, define.ContainerStateStopped, define.ContainerStateExited) {
+		return fmt.Errorf("container %s changed state to %s while waiting for its dependencies: %w", c.ID(), c.state.State, define.ErrCtrStateInvalid)
+	}
+	return nil
+}
+
 // checks dependencies are running and prints a helpful message
 func (c *Container) checkDependenciesAndHandleError() error {
 	notRunning, err := c.checkDependenciesRunning()
@@ -962,9 +1080,14 @@ func (c *Container) getAllDependencies(visited map[string]*Container) error {
 			if err != nil {
 				return err
 			}
+			completed, err := c.dependencyCompleted(dep, status)
+			if err != nil {
+				return err
+			}
 			// if the dependency is already running, we can assume its dependencies are also running
 			// so no need to add them to those we need to start
-			if status != define.ContainerStateRunning {
+			// dependencies which had to complete and did so must not be started again
+			if status != define.ContainerStateRunning && !completed {
 				visited[depID] = dep
 				if err := dep.getAllDependencies(visited); err != nil {
 					return err
@@ -997,7 +1120,13 @@ func (c *Container) checkDependenciesRunning() ([]string, error) {
 			return nil, fmt.Errorf("retrieving state of dependency %s of container %s: %w", dep, c.ID(), err)
 		}
 		if state != define.ContainerStateRunning && !depCtr.config.IsInfra {
-			notRunning = append(notRunning, dep)
+			completed, err := c.dependencyCompleted(depCtr, state)
+			if err != nil {
+				return nil, err
+			}
+			if !completed {
+				notRunning = append(notRunning, dep)
+			}
 		}
 		depCtrs[dep] = depCtr
 	}
@@ -1005,6 +1134,107 @@ func (c *Container) checkDependenciesRunning() ([]string, error) {
 	return notRunning, nil
 }
 
+// dependencyCondition returns the condition the dependency depID must meet
+// before the container is started.
+func (c *Container) dependencyCondition(depID string) string {
+	if condition, ok := c.config.DependencyConditions[depID]; ok {
+		return condition
+	}
+	return define.DependencyConditionStarted
+}
+
+// dependencyCompleted returns true if the dependency dep, which is in the
+// given state, must complete successfully before the container is started and
+// already did so.  Such dependencies do not need to be running.
+func (c *Container) dependencyCompleted(dep *Container, state define.ContainerStatus) (bool, error) {
+	if c.dependencyCondition(dep.ID()) != define.DependencyConditionCompletedSuccessfully {
+		return false, nil
+	}
+	if state != define.ContainerStateExited && state != define.ContainerStateStopped {
+		return false, nil
+	}
+	exitCode, exited, err := dep.ExitCode()
+	if err != nil {
+		return false, err
+	}
+	return exited && exitCode == 0, nil
+}
+
+// waitDependencyConditions waits until the dependencies of the container meet
+// their conditions, or the dependency timeout expires.  The container itself
+// is not locked; the dependencies are locked as needed.
+func (c *Container) waitDependencyConditions(ctx context.Context) error {
+	if len(c.config.DependencyConditions) == 0 {
+		return nil
+	}
+	timeout := c.config.DependencyTimeout
+	if timeout == 0 {
+		timeout = define.DefaultDependencyTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for depID, condition := range c.config.DependencyConditions {
+		if condition == define.DependencyConditionStarted {
+			continue
+		}
+		dep, err := c.runtime.state.Container(depID)
+		if err != nil {
+			return fmt.Errorf("retrieving dependency %s of container %s from state: %w", depID, c.ID(), err)
+		}
+		logrus.Debugf("Waiting for dependency %s of container %s to be %s", depID, c.ID(), condition)
+		if err := dep.waitForCondition(ctx, condition); err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("dependency %s of container %s is not %s after %s: %w", dep.Name(), c.ID(), condition, timeout, define.ErrCtrStateInvalid)
+			}
+			return fmt.Errorf("dependency %s of container %s: %w", dep.Name(), c.ID(), err)
+		}
+	}
+	return nil
+}
+
+// waitForCondition waits until the container meets the dependency condition
+// or the context is done.  The container must not be locked.
+func (c *Container) waitForCondition(ctx context.Context, condition string) error {
+	switch condition {
+	case define.DependencyConditionCompletedSuccessfully:
+		exitCode, err := c.Wait(ctx)
+		if err != nil {
+			return err
+		}
+		if exitCode != 0 {
+			return fmt.Errorf("container %s exited with code %d: %w", c.Name(), exitCode, define.ErrCtrStateInvalid)
+		}
+		return nil
+	case define.DependencyConditionHealthy:
+		if !c.HasHealthCheck() {
+			return fmt.Errorf("container %s has no healthcheck: %w", c.Name(), define.ErrInvalidArg)
+		}
+		for {
+			status, err := c.HealthCheckStatus()
+			if err != nil {
+				return err
+			}
+			if status == define.HealthCheckHealthy {
+				return nil
+			}
+			state, err := c.State()
+			if err != nil {
+				return err
+			}
+			if state != define.ContainerStateRunning && state != define.ContainerStatePaused {
+				return fmt.Errorf("container %s is %s: %w", c.Name(), state, define.ErrCtrStateInvalid)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(DefaultWaitInterval):
+			}
+		}
+	}
+	return nil
+}
+
 func (c *Container) completeNetworkSetup() error {
 	netDisabled, err := c.NetworkDisabled()
 	if err != nil {
@@ -1012,7 +1242,10 @@ func (c *Container) completeNetworkSetup() error {
 	}
 	if netDisabled {
 		// with net=none we still want to set up /etc/hosts
-		return c.addHosts()
+		if err := c.addHosts(); err != nil {
+			return err
+		}
+		return c.joinHostsGroup()
 	}
 	if c.config.NetNsCtr != "" {
 		return nil
@@ -1032,6 +1265,9 @@ func (c *Container) completeNetworkSetup() error {
 	if err := c.addHosts(); err != nil {
 		return err
 	}
+	if err := c.joinHostsGroup(); err != nil {
+		return err
+	}
 
 	return c.addResolvConf()
 }
@@ -1272,6 +1508,8 @@ func (c *Container) start(ctx context.Context) error {
 	logrus.Debugf("Started container %s", c.ID())
 
 	c.state.State = define.ContainerStateRunning
+	// A pending restart by the restart policy is not needed anymore.
+	c.state.RestartingAt = time.Time{}
 
 	// Unless being ignored, set the MAINPID to conmon.
 	if c.config.SdNotifyMode != define.SdNotifyModeIgnore {
@@ -1306,7 +1544,7 @@ func (c *Container) start(ctx context.Context) error {
 		return err
 	}
 
-	if c.config.SdNotifyMode != define.SdNotifyModeHealthy {
+	if c.config.SdNotifyMode != define.SdNotifyModeHealthy && c.config.SdNotifyMode != define.SdNotifyModeHealthyThenWatchdog {
 		return nil
 	}
 
@@ -1327,6 +1565,13 @@ func (c *Container) start(ctx context.Context) error {
 	} else {
 		logrus.Debugf("Notify sent successfully")
 	}
+
+	// Only a process of the systemd service, started with its
+	// NOTIFY_SOCKET, can keep the watchdog alive.
+	if c.config.SdNotifyMode == define.SdNotifyModeHealthyThenWatchdog && c.config.SdNotifySocket != "" &&
+		os.Getenv("NOTIFY_SOCKET") == c.config.SdNotifySocket {
+		return c.notifyWatchdog(ctx)
+	}
 	return nil
 }
 
@@ -1371,6 +1616,10 @@ func (c *Container) stop(timeout uint) error {
 	}
 
 	c.state.StoppedByUser = true
+	// Cancel a pending restart, the restart policy process notices once
+	// it gets the lock again.
+	c.state.RestartingAt = time.Time{}
+	c.state.RestartBackoff = 0
 	if cannotStopErr == nil {
 		// Set the container state to "stopping" and unlock the container
 		// before handing it over to conmon to unblock other commands.  #8501
@@ -2007,7 +2256,14 @@ func (c *Container) cleanup(ctx context.Context) error {
 		if hoststFile, ok := c.state.BindMounts[config.DefaultHostsFile]; ok {
 			if _, err := os.Stat(hoststFile); err == nil {
 				// we cannot use the dependency container lock due ABBA deadlocks
-				if lock, err := lockfile.GetLockFile(hoststFile); err == nil {
+				var lock *lockfile.LockFile
+				depCtr, err := c.runtime.state.Container(c.config.NetNsCtr)
+				if err == nil {
+					lock, err = depCtr.hostsFileLock(hoststFile)
+				} else {
+					lock, err = lockfile.GetLockFile(hoststFile)
+				}
+				if err == nil {
 					lock.Lock()
 					// make sure to ignore ENOENT error in case the netns container was cleaned up before this one
 					if err := etchosts.Remove(hoststFile, getLocalhostHostEntry(c)); err != nil && !errors.Is(err, os.ErrNotExist) {
@@ -2117,6 +2373,61 @@ func (c *Container) stopPodIfNeeded(ctx context.Context) error {
 	return nil
 }
 
+// podRestartPolicy returns the pod of the container and the pod's policy to
+// restart as a whole if the container is critical for it, or nil if the
+// container does not restart its pod.
+func (c *Container) podRestartPolicy() (*Pod, string, error) {
+	if !c.config.PodCritical || c.config.Pod == "" {
+		return nil, "", nil
+	}
+	pod, err := c.runtime.state.Pod(c.config.Pod)
+	if err != nil {
+		return nil, "", fmt.Errorf("container %s is in pod %s, but pod cannot be retrieved: %w", c.ID(), c.config.Pod, err)
+	}
+	policy, _ := pod.RestartPodPolicy()
+	if policy == define.RestartPolicyNone || policy == define.RestartPolicyNo {
+		return nil, "", nil
+	}
+	return pod, policy, nil
+}
+
+// shouldRestartPod returns the pod of the container if the container is
+// critical for its pod and its exit requires the whole pod to be restarted.
+// Like the restart action of failed healthchecks, an unhealthy container
+// restarts its pod even though it was stopped.
+func (c *Container) shouldRestartPod() (*Pod, error) {
+	pod, policy, err := c.podRestartPolicy()
+	if err != nil || pod == nil {
+		return nil, err
+	}
+	isUnhealthy, err := c.isUnhealthy()
+	if err != nil {
+		logrus.Errorf("Checking if container is unhealthy: %v", err)
+	} else if isUnhealthy {
+		return pod, nil
+	}
+	if c.state.StoppedByUser {
+		return nil, nil
+	}
+	if policy == define.RestartPolicyOnFailure && c.state.ExitCode == 0 {
+		return nil, nil
+	}
+	return pod, nil
+}
+
+// restartPod restarts the pod of the critical container.  Like stopping the
+// pod for its exit policy, the restart is done by the runtime's work queue to
+// avoid deadlocks, as the container is locked.
+func (c *Container) restartPod(pod *Pod) {
+	c.runtime.queueWork(func() {
+		if err := pod.restartAfterCriticalExit(context.Background(), c.ID()); err != nil {
+			if !errors.Is(err, define.ErrNoSuchPod) && !errors.Is(err, define.ErrPodRemoved) {
+				logrus.Errorf("Restarting pod %s after exit of container %s: %v", pod.ID(), c.ID(), err)
+			}
+		}
+	})
+}
+
 // delete deletes the container and runs any configured poststop
 // hooks.
 func (c *Container) delete(ctx context.Context) error {
@@ -2261,7 +2572,7 @@ func (c *Container) setupOCIHooks(ctx context.Context, config *spec.Spec) (map[s
 			return nil, nil
 		}
 		for _, hDir := range []string{hooks.DefaultDir, hooks.OverrideDir} {
-			manager, err := hooks.New(ctx, []string{hDir}, []string{"precreate", "poststop"})
+			manager, err := hooks.New(ctx, []string{hDir}, ociHookExtensionStages)
 			if err != nil {
 				if os.IsNotExist(err) {
 					continue
@@ -2280,7 +2591,7 @@ func (c *Container) setupOCIHooks(ctx context.Context, config *spec.Spec) (map[s
 			}
 		}
 	} else {
-		manager, err := hooks.New(ctx, c.runtime.config.Engine.HooksDir.Get(), []string{"precreate", "poststop"})
+		manager, err := hooks.New(ctx, c.runtime.config.Engine.HooksDir.Get(), ociHookExtensionStages)
 		if err != nil {
 			return nil, err
 		}
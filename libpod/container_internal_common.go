@@ -44,7 +44,6 @@ import (
 	"github.com/containers/podman/v5/version"
 	"github.com/containers/storage/pkg/archive"
 	"github.com/containers/storage/pkg/idtools"
-	"github.com/containers/storage/pkg/lockfile"
 	"github.com/containers/storage/pkg/unshare"
 	stypes "github.com/containers/storage/types"
 	securejoin "github.com/cyphar/filepath-securejoin"
@@ -468,6 +467,27 @@ func (c *Container) generateSpec(ctx context.Context) (s *spec.Spec, cleanupFunc
 		g.AddMount(overlayMount)
 	}
 
+	// Add the root filesystems of other containers as bind mounts
+	for _, volume := range c.config.RootfsVolumes {
+		srcCtr, err := c.runtime.state.Container(volume.Source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("looking up container %s for rootfs volume %q: %w", volume.Source, volume.Dest, err)
+		}
+		mounted, mountPoint, err := srcCtr.Mounted()
+		if err != nil {
+			return nil, nil, fmt.Errorf("checking mount of container %s for rootfs volume %q: %w", volume.Source, volume.Dest, err)
+		}
+		if !mounted {
+			return nil, nil, fmt.Errorf("root filesystem of container %s for rootfs volume %q is not mounted: %w", volume.Source, volume.Dest, define.ErrCtrStateInvalid)
+		}
+		g.AddMount(spec.Mount{
+			Type:        define.TypeBind,
+			Source:      mountPoint,
+			Destination: volume.Dest,
+			Options:     []string{"rbind", "rprivate"},
+		})
+	}
+
 	err = c.setHomeEnvIfNeeded()
 	if err != nil {
 		return nil, nil, err
@@ -1880,7 +1900,7 @@ func (c *Container) makeBindMounts() error {
 			hostsPath, exists := bindMounts[config.DefaultHostsFile]
 			if !c.config.UseImageHosts && exists {
 				// we cannot use the dependency container lock due ABBA deadlocks in cleanup()
-				lock, err := lockfile.GetLockFile(hostsPath)
+				lock, err := depCtr.hostsFileLock(hostsPath)
 				if err != nil {
 					return fmt.Errorf("failed to lock hosts file: %w", err)
 				}
@@ -128,6 +128,9 @@ func (c *Container) cleanupNetwork() error {
 	if c.config.NetNsCtr != "" {
 		return nil
 	}
+	// remove the entries before the network status is gone
+	c.leaveHostsGroup()
+
 	netDisabled, err := c.NetworkDisabled()
 	if err != nil {
 		return err
@@ -10,7 +10,9 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/storage/pkg/idtools"
 	stypes "github.com/containers/storage/types"
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
@@ -213,3 +215,29 @@ func init() {
 		panic("we need a reliable executable path on Windows")
 	}
 }
+
+func TestNextRestartBackoff(t *testing.T) {
+	backoff := &define.RestartBackoff{Initial: time.Second, Max: 5 * time.Second, Reset: time.Minute}
+
+	tests := []struct {
+		name      string
+		previous  time.Duration
+		ranFor    time.Duration
+		healthy   bool
+		delay     time.Duration
+		crashLoop bool
+	}{
+		{"first restart", 0, time.Second, true, time.Second, false},
+		{"quick exit doubles", 2 * time.Second, time.Second, true, 4 * time.Second, true},
+		{"capped at max", 4 * time.Second, time.Second, true, 5 * time.Second, true},
+		{"reset after running long enough", 5 * time.Second, time.Hour, true, time.Second, false},
+		{"no reset when unhealthy", 2 * time.Second, time.Hour, false, 4 * time.Second, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, crashLoop := nextRestartBackoff(backoff, tt.previous, tt.ranFor, tt.healthy)
+			assert.Equal(t, tt.delay, delay)
+			assert.Equal(t, tt.crashLoop, crashLoop)
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"sync"
 	"time"
 
 	"github.com/containers/podman/v5/libpod/define"
@@ -35,6 +37,70 @@ func (r *Runtime) Log(ctx context.Context, containers []*Container, options *log
 	return nil
 }
 
+// WaitForLog blocks until the container logs a line matching pattern, lines
+// logged before the call included.  If the container has not started yet, its
+// log is checked again every interval.  It fails if the container exits
+// without logging such a line.
+func (c *Container) WaitForLog(ctx context.Context, interval time.Duration, pattern *regexp.Regexp) error {
+	if !c.valid {
+		return define.ErrCtrRemoved
+	}
+	for {
+		// Get the state before reading the log so that the lines
+		// logged right before the container exited are not missed.
+		state, err := c.State()
+		if err != nil {
+			return err
+		}
+		found, err := c.logMatches(ctx, pattern)
+		if err != nil || found {
+			return err
+		}
+		switch state {
+		case define.ContainerStateExited, define.ContainerStateStopped, define.ContainerStateRemoving:
+			return fmt.Errorf("container %s exited without logging a line matching %q", c.ID(), pattern)
+		}
+		select {
+		case <-ctx.Done():
+			return define.ErrCanceled
+		case <-time.After(interval):
+		}
+	}
+}
+
+// logMatches follows the log of the container until a line matches pattern
+// or the container stops logging.
+func (c *Container) logMatches(ctx context.Context, pattern *regexp.Regexp) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	options := &logs.LogOptions{
+		Follow:    true,
+		Tail:      -1,
+		WaitGroup: &wg,
+	}
+	logChannel := make(chan *logs.LogLine)
+	if err := c.ReadLog(ctx, options, logChannel, 0); err != nil {
+		return false, err
+	}
+	go func() {
+		wg.Wait()
+		close(logChannel)
+	}()
+
+	found := false
+	// Keep draining the channel after a match until the readers
+	// noticed the cancellation, they would block otherwise.
+	for line := range logChannel {
+		if !found && pattern.MatchString(line.Msg) {
+			found = true
+			cancel()
+		}
+	}
+	return found, nil
+}
+
 // ReadLog reads a container's log based on the input options and returns log lines over a channel.
 func (c *Container) ReadLog(ctx context.Context, options *logs.LogOptions, logChannel chan *logs.LogLine, colorID int64) error {
 	switch c.LogDriver() {
@@ -10,6 +10,8 @@ import (
 	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/podman/v5/libpod/define"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 // Validate that the configuration of a container is valid.
@@ -89,6 +91,22 @@ func (c *Container) validate() error {
 		return fmt.Errorf("cannot set static IP or MAC address if joining more than one network: %w", define.ErrInvalidArg)
 	}
 
+	// Network rates are only applied to the interfaces of bridge networks
+	// and could be removed by a container which can change its qdiscs.
+	if len(c.config.NetworkRates) > 0 {
+		if !c.config.CreateNetNS || !c.config.NetMode.IsBridge() {
+			return fmt.Errorf("network rates can only be set for containers using bridge networks: %w", define.ErrInvalidArg)
+		}
+		if c.hasNetAdminCapability() {
+			return fmt.Errorf("network rates cannot be enforced for containers with the CAP_NET_ADMIN capability: %w", define.ErrInvalidArg)
+		}
+	}
+
+	// Egress rules are only applied to the interfaces of bridge networks.
+	if c.hasEgressOptions() && (!c.config.CreateNetNS || !c.config.NetMode.IsBridge()) {
+		return fmt.Errorf("egress rules can only be set for containers using bridge networks: %w", define.ErrInvalidArg)
+	}
+
 	// Using image resolv.conf conflicts with various DNS settings.
 	if c.config.UseImageResolvConf &&
 		(len(c.config.DNSSearch) > 0 || len(c.config.DNSServer) > 0 ||
@@ -100,6 +118,17 @@ func (c *Container) validate() error {
 		return fmt.Errorf("cannot add to /etc/hosts if using image's /etc/hosts: %w", define.ErrInvalidArg)
 	}
 
+	if c.config.HostsGroup != "" {
+		if c.config.UseImageHosts {
+			return fmt.Errorf("cannot join a hosts group if using image's /etc/hosts: %w", define.ErrInvalidArg)
+		}
+		// containers sharing a network namespace share the hosts file of
+		// its owner, so the group must be set there
+		if c.config.NetNsCtr != "" {
+			return fmt.Errorf("cannot join a hosts group when joining the network namespace of another container, set it on the pod or the other container instead: %w", define.ErrInvalidArg)
+		}
+	}
+
 	// Check named volume, overlay volume and image volume destination conflist
 	destinations := make(map[string]bool)
 	for _, vol := range c.config.NamedVolumes {
@@ -147,6 +176,10 @@ func (c *Container) validate() error {
 		return fmt.Errorf("cannot set on-failure action to %s without a health check", c.config.HealthCheckOnFailureAction.String())
 	}
 
+	if c.config.HealthCheckCheckpointRetention != 0 && c.config.HealthCheckOnFailureAction != define.HealthCheckOnFailureActionCheckpoint {
+		return fmt.Errorf("cannot set checkpoint retention with on-failure action %s: %w", c.config.HealthCheckOnFailureAction.String(), define.ErrInvalidArg)
+	}
+
 	if value, exists := c.config.Labels[define.AutoUpdateLabel]; exists {
 		// TODO: we cannot reference pkg/autoupdate here due to
 		// circular dependencies.  It's worth considering moving the
@@ -166,6 +199,77 @@ func (c *Container) validate() error {
 	return nil
 }
 
+// hasNetAdminCapability returns true if the container can change the
+// configuration of its network namespace.
+func (c *Container) hasNetAdminCapability() bool {
+	return c.config.Spec.Process != nil && c.config.Spec.Process.Capabilities != nil &&
+		slices.Contains(c.config.Spec.Process.Capabilities.Bounding, "CAP_NET_ADMIN")
+}
+
+// validateNetAdminCapability checks that a container with the CAP_NET_ADMIN
+// capability does not use a network namespace with network rates or egress
+// rules, which it could remove. The egress rules can also come from the
+// labels of the networks, so this needs the runtime.
+func (c *Container) validateNetAdminCapability() error {
+	if !c.hasNetAdminCapability() {
+		return nil
+	}
+	if c.config.CreateNetNS && c.config.NetMode.IsBridge() {
+		hasRules, err := c.hasEgressRules(maps.Keys(c.config.Networks))
+		if err != nil {
+			return err
+		}
+		if hasRules {
+			return fmt.Errorf("egress rules cannot be enforced for containers with the CAP_NET_ADMIN capability: %w", define.ErrInvalidArg)
+		}
+	}
+	if c.config.NetNsCtr == "" {
+		return nil
+	}
+	netNsCtr, err := c.runtime.state.Container(c.config.NetNsCtr)
+	if err != nil {
+		return err
+	}
+	hasRules := false
+	if netNsCtr.config.NetMode.IsBridge() {
+		networks, err := netNsCtr.networks()
+		if err != nil {
+			return err
+		}
+		hasRules, err = netNsCtr.hasEgressRules(maps.Keys(networks))
+		if err != nil {
+			return err
+		}
+	}
+	if hasRules || len(netNsCtr.config.NetworkRates) > 0 {
+		return fmt.Errorf("container with the CAP_NET_ADMIN capability cannot join the network namespace of container %s with network rates or egress rules: %w", netNsCtr.ID(), define.ErrInvalidArg)
+	}
+	return nil
+}
+
+// validateEgressNetAdmin returns an error if the container or a container
+// joining its network namespace has the CAP_NET_ADMIN capability and could
+// therefore remove egress rules.
+func (c *Container) validateEgressNetAdmin() error {
+	if c.hasNetAdminCapability() {
+		return fmt.Errorf("egress rules cannot be enforced for container %s with the CAP_NET_ADMIN capability: %w", c.ID(), define.ErrInvalidArg)
+	}
+	deps, err := c.runtime.state.ContainerInUse(c)
+	if err != nil {
+		return err
+	}
+	for _, id := range deps {
+		dep, err := c.runtime.state.Container(id)
+		if err != nil {
+			return err
+		}
+		if dep.config.NetNsCtr == c.ID() && dep.hasNetAdminCapability() {
+			return fmt.Errorf("egress rules cannot be enforced for container %s, container %s with the CAP_NET_ADMIN capability joins its network namespace: %w", c.ID(), dep.ID(), define.ErrInvalidArg)
+		}
+	}
+	return nil
+}
+
 // validateAutoUpdateImageReference checks if the specified imageName is a
 // fully-qualified image reference to the docker transport. Such a reference
 // includes a domain, name and tag (e.g., quay.io/podman/stable:latest).  The
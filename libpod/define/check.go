@@ -0,0 +1,57 @@
+package define
+
+import "time"
+
+// Types of objects a SystemCheckProblem can refer to.
+const (
+	// CheckTypeLayer is a layer in containers/storage.
+	CheckTypeLayer = "layer"
+	// CheckTypeImage is an image in containers/storage.
+	CheckTypeImage = "image"
+	// CheckTypeContainer is a container known to libpod.
+	CheckTypeContainer = "container"
+	// CheckTypeStorageContainer is a container in containers/storage.
+	CheckTypeStorageContainer = "storage-container"
+	// CheckTypeVolume is a volume known to libpod.
+	CheckTypeVolume = "volume"
+	// CheckTypeLock is a lock of the lock manager.
+	CheckTypeLock = "lock"
+	// CheckTypeNetwork is a network configuration.
+	CheckTypeNetwork = "network"
+)
+
+// SystemCheckOptions describes which checks and repairs SystemCheck performs.
+type SystemCheckOptions struct {
+	// Quick skips the most time-intensive checks of containers/storage.
+	Quick bool
+	// Repair fixes the problems which can be fixed without losing data
+	// used by containers.
+	Repair bool
+	// RepairLossy additionally removes damaged containers and containers
+	// whose storage is missing.  Implies Repair.
+	RepairLossy bool
+	// UnreferencedLayerMaximumAge is the age after which a layer that is
+	// not used by any image or container is reported.  Defaults to 24h.
+	UnreferencedLayerMaximumAge *time.Duration
+}
+
+// SystemCheckProblem is an inconsistency found by SystemCheck.
+type SystemCheckProblem struct {
+	// Type is the type of the object, one of the CheckType constants.
+	Type string `json:"type"`
+	// ID is the ID (or name for volumes and networks, number for locks)
+	// of the object.
+	ID string `json:"id"`
+	// Problem describes the inconsistency.
+	Problem string `json:"problem"`
+	// Repaired is set when the problem has been fixed.
+	Repaired bool `json:"repaired"`
+}
+
+// SystemCheckReport is the result of SystemCheck.
+type SystemCheckReport struct {
+	// Errors is set when problems which have not been repaired remain.
+	Errors bool `json:"errors"`
+	// Problems lists all inconsistencies found.
+	Problems []SystemCheckProblem `json:"problems"`
+}
@@ -1,5 +1,10 @@
 package define
 
+import (
+	"fmt"
+	"time"
+)
+
 // Valid restart policy types.
 const (
 	// RestartPolicyNone indicates that no restart policy has been requested
@@ -27,6 +32,58 @@ var RestartPolicyMap = map[string]string{
 	RestartPolicyUnlessStopped: RestartPolicyUnlessStopped,
 }
 
+// Defaults of the restart backoff.
+const (
+	// DefaultRestartBackoffMax is the default maximum delay before a
+	// restart.
+	DefaultRestartBackoffMax = 5 * time.Minute
+	// DefaultRestartBackoffReset is the default time a container must run
+	// for the delay to be reset.
+	DefaultRestartBackoffReset = 10 * time.Minute
+)
+
+// RestartBackoff configures an exponential delay before a container is
+// restarted by its restart policy.
+type RestartBackoff struct {
+	// Initial is the delay before the first restart.  It doubles with
+	// every restart of a container which exited less than Reset after it
+	// was started, up to Max.
+	Initial time.Duration `json:"Initial"`
+	// Max is the maximum delay.
+	Max time.Duration `json:"Max"`
+	// Reset is the time a container must run, and be healthy if it has
+	// a healthcheck, for the delay to go back to Initial.
+	Reset time.Duration `json:"Reset"`
+}
+
+// Dependency conditions, which must be met by a dependency container before
+// the containers depending on it are started.
+const (
+	// DependencyConditionStarted is met once the dependency is running.
+	DependencyConditionStarted = "started"
+	// DependencyConditionHealthy is met once the healthcheck of the
+	// dependency reports healthy.
+	DependencyConditionHealthy = "healthy"
+	// DependencyConditionCompletedSuccessfully is met once the dependency
+	// exited with code 0.
+	DependencyConditionCompletedSuccessfully = "completed-successfully"
+)
+
+// DefaultDependencyTimeout is the default time to wait for the conditions of
+// the dependencies of a container to be met.
+const DefaultDependencyTimeout = 5 * time.Minute
+
+// ValidDependencyCondition returns nil if condition is a valid dependency
+// condition.
+func ValidDependencyCondition(condition string) error {
+	switch condition {
+	case DependencyConditionStarted, DependencyConditionHealthy, DependencyConditionCompletedSuccessfully:
+		return nil
+	}
+	return fmt.Errorf("invalid dependency condition %q, must be %q, %q or %q: %w", condition,
+		DependencyConditionStarted, DependencyConditionHealthy, DependencyConditionCompletedSuccessfully, ErrInvalidArg)
+}
+
 // InitContainerTypes
 const (
 	// AlwaysInitContainer is an init container that runs on each
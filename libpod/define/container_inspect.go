@@ -57,6 +57,9 @@ type InspectContainerConfig struct {
 	Healthcheck *manifest.Schema2HealthConfig `json:"Healthcheck,omitempty"`
 	// HealthcheckOnFailureAction defines an action to take once the container turns unhealthy.
 	HealthcheckOnFailureAction string `json:"HealthcheckOnFailureAction,omitempty"`
+	// HealthcheckCheckpointRetention is the number of checkpoint archives
+	// kept when the on-failure action is checkpoint.
+	HealthcheckCheckpointRetention uint `json:"HealthcheckCheckpointRetention,omitempty"`
 	// CreateCommand is the full command plus arguments of the process the
 	// container has been created with.
 	CreateCommand []string `json:"CreateCommand,omitempty"`
@@ -101,6 +104,8 @@ type InspectRestartPolicy struct {
 	// "on-failure" restart policy is in use. Not used if "on-failure" is
 	// not set.
 	MaximumRetryCount uint `json:"MaximumRetryCount"`
+	// Backoff is the exponential delay of restarts, if set.
+	Backoff *RestartBackoff `json:"Backoff,omitempty"`
 }
 
 // InspectLogConfig holds information about a container's configured log driver
@@ -210,7 +215,7 @@ type InspectContainerState struct {
 	Status         string              `json:"Status"`
 	Running        bool                `json:"Running"`
 	Paused         bool                `json:"Paused"`
-	Restarting     bool                `json:"Restarting"` // TODO
+	Restarting     bool                `json:"Restarting"`
 	OOMKilled      bool                `json:"OOMKilled"`
 	Dead           bool                `json:"Dead"`
 	Pid            int                 `json:"Pid"`
@@ -229,6 +234,12 @@ type InspectContainerState struct {
 	RestoreLog     string              `json:"RestoreLog,omitempty"`
 	Restored       bool                `json:"Restored,omitempty"`
 	StoppedByUser  bool                `json:"StoppedByUser,omitempty"`
+	// RestartBackoff is the delay the restart policy waits for before
+	// restarting the container, while Restarting is set.
+	RestartBackoff string `json:"RestartBackoff,omitempty"`
+	// RestartingAt is the time the container is restarted at, while
+	// Restarting is set.
+	RestartingAt time.Time `json:"RestartingAt,omitempty"`
 }
 
 // Healthcheck returns the HealthCheckResults. This is used for old podman compat
@@ -630,6 +641,11 @@ type InspectAdditionalNetwork struct {
 	Links []string `json:"Links"`
 	// Aliases are any network aliases the container has in this network.
 	Aliases []string `json:"Aliases,omitempty"`
+	// NetworkRate is the bandwidth limit of the container in this network.
+	NetworkRate *NetworkRate `json:"NetworkRate,omitempty"`
+	// EgressRules are the destinations the container is allowed to reach
+	// in this network, all destinations are allowed if empty.
+	EgressRules []string `json:"EgressRules,omitempty"`
 }
 
 // InspectNetworkSettings holds information about the network settings of the
@@ -688,8 +704,10 @@ type InspectContainerData struct {
 	GraphDriver             *DriverData                 `json:"GraphDriver"`
 	SizeRw                  *int64                      `json:"SizeRw,omitempty"`
 	SizeRootFs              int64                       `json:"SizeRootFs,omitempty"`
+	OCIHooks                []*OCIHook                  `json:"OCIHooks,omitempty"`
 	Mounts                  []InspectMount              `json:"Mounts"`
 	Dependencies            []string                    `json:"Dependencies"`
+	DependencyConditions    map[string]string           `json:"DependencyConditions,omitempty"`
 	NetworkSettings         *InspectNetworkSettings     `json:"NetworkSettings"`
 	Namespace               string                      `json:"Namespace"`
 	IsInfra                 bool                        `json:"IsInfra"`
@@ -717,6 +735,8 @@ type InspectExecSession struct {
 	ExitCode int `json:"ExitCode"`
 	// ID is the ID of the exec session.
 	ID string `json:"ID"`
+	// Name is the name of the exec session, if it was given one.
+	Name string `json:"Name,omitempty"`
 	// OpenStderr is whether the container's STDERR stream will be attached.
 	// Always set to true if the exec session created a TTY.
 	OpenStderr bool `json:"OpenStderr"`
@@ -29,6 +29,9 @@ var (
 	// does not exist.
 	ErrNoSuchExitCode = errors.New("no such exit code")
 
+	// ErrNoSuchHook indicates the requested OCI hook does not exist.
+	ErrNoSuchHook = errors.New("no such hook")
+
 	// ErrDepExists indicates that the current object has dependencies and
 	// cannot be removed before them.
 	ErrDepExists = errors.New("dependency exists")
@@ -56,6 +56,8 @@ const (
 	DefaultHealthCheckStartPeriod = "0s"
 	// DefaultHealthCheckTimeout default value
 	DefaultHealthCheckTimeout = "30s"
+	// DefaultHealthCheckCheckpointRetention default value
+	DefaultHealthCheckCheckpointRetention uint = 3
 )
 
 // HealthConfig.Test options
@@ -84,15 +86,18 @@ const (
 	HealthCheckOnFailureActionRestart = iota
 	// HealthCheckOnFailureActionNonce instructs Podman to stop the container on an unhealthy status.
 	HealthCheckOnFailureActionStop = iota
+	// HealthCheckOnFailureActionCheckpoint instructs Podman to checkpoint and then restart the container on an unhealthy status.
+	HealthCheckOnFailureActionCheckpoint = iota
 )
 
 // String representations for on-failure actions.
 const (
-	strHealthCheckOnFailureActionNone    = "none"
-	strHealthCheckOnFailureActionInvalid = "invalid"
-	strHealthCheckOnFailureActionKill    = "kill"
-	strHealthCheckOnFailureActionRestart = "restart"
-	strHealthCheckOnFailureActionStop    = "stop"
+	strHealthCheckOnFailureActionNone       = "none"
+	strHealthCheckOnFailureActionInvalid    = "invalid"
+	strHealthCheckOnFailureActionKill       = "kill"
+	strHealthCheckOnFailureActionRestart    = "restart"
+	strHealthCheckOnFailureActionStop       = "stop"
+	strHealthCheckOnFailureActionCheckpoint = "checkpoint"
 )
 
 // SupportedHealthCheckOnFailureActions lists all supported healthcheck restart policies.
@@ -101,6 +106,7 @@ var SupportedHealthCheckOnFailureActions = []string{
 	strHealthCheckOnFailureActionKill,
 	strHealthCheckOnFailureActionRestart,
 	strHealthCheckOnFailureActionStop,
+	strHealthCheckOnFailureActionCheckpoint,
 }
 
 // String returns the string representation of the HealthCheckOnFailureAction.
@@ -114,6 +120,8 @@ func (h HealthCheckOnFailureAction) String() string {
 		return strHealthCheckOnFailureActionRestart
 	case HealthCheckOnFailureActionStop:
 		return strHealthCheckOnFailureActionStop
+	case HealthCheckOnFailureActionCheckpoint:
+		return strHealthCheckOnFailureActionCheckpoint
 	default:
 		return strHealthCheckOnFailureActionInvalid
 	}
@@ -131,6 +139,8 @@ func ParseHealthCheckOnFailureAction(s string) (HealthCheckOnFailureAction, erro
 		return HealthCheckOnFailureActionRestart, nil
 	case strHealthCheckOnFailureActionStop:
 		return HealthCheckOnFailureActionStop, nil
+	case strHealthCheckOnFailureActionCheckpoint:
+		return HealthCheckOnFailureActionCheckpoint, nil
 	default:
 		err := fmt.Errorf("invalid on-failure action %q for health check: supported actions are %s", s, strings.Join(SupportedHealthCheckOnFailureActions, ","))
 		return HealthCheckOnFailureActionInvalid, err
@@ -0,0 +1,23 @@
+package define
+
+import (
+	hook "github.com/containers/common/pkg/hooks/1.0.0"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// OCIHook describes an OCI hook configuration file of a hooks directory.
+type OCIHook struct {
+	// Name of the hook, the name of its configuration file.
+	Name string `json:"Name"`
+	// Path of the configuration file.
+	Path string `json:"Path"`
+	// Stages the hook runs at.
+	Stages []string `json:"Stages,omitempty"`
+	// Hook is the program run at the stages.
+	Hook *spec.Hook `json:"Hook,omitempty"`
+	// When holds the conditions a container must meet to use the hook.
+	When *hook.When `json:"When,omitempty"`
+	// Error reading or validating the configuration file.  Containers
+	// cannot start while a configuration file is invalid.
+	Error string `json:"Error,omitempty"`
+}
@@ -0,0 +1,218 @@
+package define
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// NetworkRate is the bandwidth limit of a container on a network. The rates
+// are given in bits per second, zero means unlimited.
+type NetworkRate struct {
+	// Ingress is the maximum rate of traffic received by the container.
+	Ingress uint64 `json:"ingress,omitempty"`
+	// Egress is the maximum rate of traffic sent by the container.
+	Egress uint64 `json:"egress,omitempty"`
+}
+
+// IsZero returns true if neither an ingress nor an egress rate is set.
+func (r NetworkRate) IsZero() bool {
+	return r.Ingress == 0 && r.Egress == 0
+}
+
+// String returns the rate in the ingress=RATE,egress=RATE format accepted by
+// the --network-rate option.
+func (r NetworkRate) String() string {
+	parts := make([]string, 0, 2)
+	if r.Ingress > 0 {
+		parts = append(parts, "ingress="+FormatBitRate(r.Ingress))
+	}
+	if r.Egress > 0 {
+		parts = append(parts, "egress="+FormatBitRate(r.Egress))
+	}
+	return strings.Join(parts, ",")
+}
+
+// FormatBitRate formats a rate in bits per second with the largest tc style
+// unit which represents it exactly.
+func FormatBitRate(rate uint64) string {
+	units := []string{"bit", "kbit", "mbit", "gbit", "tbit"}
+	i := 0
+	for ; i < len(units)-1 && rate >= 1000 && rate%1000 == 0; i++ {
+		rate /= 1000
+	}
+	return strconv.FormatUint(rate, 10) + units[i]
+}
+
+// NetworkEgressLabel is the network label with the egress allow-list of a
+// network, a comma separated list of egress rules.
+const NetworkEgressLabel = "io.podman.network.egress"
+
+// NetworkEgressOption is the network option with the egress allow-list of a
+// network. The network backends reject unknown options, so the allow-list is
+// stored in the NetworkEgressLabel label instead.
+const NetworkEgressOption = "egress"
+
+// MoveEgressOption validates the NetworkEgressOption in options and moves it
+// to the NetworkEgressLabel label in labels, which is allocated if needed.
+func MoveEgressOption(options, labels map[string]string) (map[string]string, error) {
+	value, ok := options[NetworkEgressOption]
+	if !ok {
+		return labels, nil
+	}
+	rules, err := ParseEgressRules(value)
+	if err != nil {
+		return labels, err
+	}
+	formatted := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		formatted = append(formatted, rule.String())
+	}
+	delete(options, NetworkEgressOption)
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[NetworkEgressLabel] = strings.Join(formatted, ",")
+	return labels, nil
+}
+
+// EgressRule allows traffic from a container to a destination. Traffic to
+// destinations not allowed by any rule is rejected once an interface has at
+// least one rule.
+type EgressRule struct {
+	// Destination is an IP address, a subnet in CIDR notation or a DNS
+	// name which is resolved when the rules are installed.
+	Destination string `json:"destination"`
+	// StartPort and EndPort restrict the rule to a TCP and UDP port range.
+	// All ports are allowed when StartPort is 0.
+	StartPort uint16 `json:"startPort,omitempty"`
+	EndPort   uint16 `json:"endPort,omitempty"`
+}
+
+// String returns the rule in the DEST[:PORT[-PORT]] format accepted by
+// ParseEgressRule.
+func (e EgressRule) String() string {
+	dest := e.Destination
+	if e.StartPort == 0 {
+		return dest
+	}
+	if strings.Contains(dest, ":") {
+		dest = "[" + dest + "]"
+	}
+	ports := strconv.Itoa(int(e.StartPort))
+	if e.EndPort != e.StartPort {
+		ports += "-" + strconv.Itoa(int(e.EndPort))
+	}
+	return dest + ":" + ports
+}
+
+// ParseEgressRule parses an egress rule in the DEST[:PORT[-PORT]] format.
+// DEST is an IP address, a subnet in CIDR notation or a DNS name, IPv6
+// destinations with ports must be put in brackets, e.g. [fd00::/8]:443.
+func ParseEgressRule(rule string) (EgressRule, error) {
+	var (
+		egress EgressRule
+		dest   = rule
+		ports  string
+	)
+	if strings.HasPrefix(rule, "[") {
+		var rest string
+		var ok bool
+		dest, rest, ok = strings.Cut(rule[1:], "]")
+		if !ok {
+			return egress, fmt.Errorf("invalid egress rule %q: missing closing bracket", rule)
+		}
+		if rest != "" {
+			if !strings.HasPrefix(rest, ":") {
+				return egress, fmt.Errorf("invalid egress rule %q: expected a port after the bracket", rule)
+			}
+			ports = rest[1:]
+		}
+	} else if strings.Count(rule, ":") == 1 {
+		dest, ports, _ = strings.Cut(rule, ":")
+	}
+
+	switch {
+	case dest == "":
+		return egress, fmt.Errorf("invalid egress rule %q: destination must not be empty", rule)
+	case strings.Contains(dest, "/"):
+		_, subnet, err := net.ParseCIDR(dest)
+		if err != nil {
+			return egress, fmt.Errorf("invalid egress rule %q: %w", rule, err)
+		}
+		dest = subnet.String()
+	case net.ParseIP(dest) != nil:
+		dest = net.ParseIP(dest).String()
+	case !egressHostnameRegex.MatchString(dest):
+		return egress, fmt.Errorf("invalid egress rule %q: %q is not an IP address, subnet or DNS name", rule, dest)
+	}
+	egress.Destination = dest
+
+	if ports != "" {
+		start, end, isRange := strings.Cut(ports, "-")
+		if !isRange {
+			end = start
+		}
+		startPort, err := strconv.ParseUint(start, 10, 16)
+		if err != nil || startPort == 0 {
+			return egress, fmt.Errorf("invalid egress rule %q: invalid port %q", rule, start)
+		}
+		endPort, err := strconv.ParseUint(end, 10, 16)
+		if err != nil || endPort < startPort {
+			return egress, fmt.Errorf("invalid egress rule %q: invalid port range %q", rule, ports)
+		}
+		egress.StartPort = uint16(startPort)
+		egress.EndPort = uint16(endPort)
+	}
+	return egress, nil
+}
+
+// ParseEgressRules parses a comma separated list of egress rules.
+func ParseEgressRules(rules string) ([]EgressRule, error) {
+	var result []EgressRule
+	for _, rule := range strings.Split(rules, ",") {
+		if rule == "" {
+			continue
+		}
+		egress, err := ParseEgressRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, egress)
+	}
+	return result, nil
+}
+
+// egressHostnameRegex matches DNS names.
+var egressHostnameRegex = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// NetworkEndpoint describes a container attached to a network as shown by
+// podman network inspect --live. Together with the network it forms a graph
+// where the network and containers are nodes and every endpoint is an edge.
+type NetworkEndpoint struct {
+	// ContainerID is the ID of the container owning the network namespace.
+	ContainerID string `json:"container_id"`
+	// ContainerName is the name of the container.
+	ContainerName string `json:"container_name"`
+	// PodID and PodName are set when the container is part of a pod.
+	PodID   string `json:"pod_id,omitempty"`
+	PodName string `json:"pod_name,omitempty"`
+	// State is the state of the container.
+	State string `json:"state"`
+	// InterfaceName is the name of the interface in the container.
+	InterfaceName string `json:"interface_name,omitempty"`
+	// MacAddress is the MAC address of the interface.
+	MacAddress string `json:"mac_address,omitempty"`
+	// IPAddresses are the addresses of the interface in CIDR notation.
+	IPAddresses []string `json:"ip_addresses,omitempty"`
+	// Aliases are the network scoped DNS names of the container.
+	Aliases []string `json:"aliases,omitempty"`
+	// SharedWith are the IDs of containers joining the network namespace
+	// of the container, e.g. the other containers of a pod.
+	SharedWith []string `json:"shared_with,omitempty"`
+	// Statistics are the traffic counters of the interface, only set
+	// while the container is running.
+	Statistics *ContainerNetworkStats `json:"statistics,omitempty"`
+}
@@ -85,6 +85,12 @@ type InspectPodData struct {
 	BlkioWeightDevice []InspectBlkioWeightDevice `json:"blkio_weight_device,omitempty"`
 	// RestartPolicy of the pod.
 	RestartPolicy string `json:"RestartPolicy,omitempty"`
+	// RestartPodPolicy is the policy to restart the whole pod when a
+	// critical container exits or turns unhealthy.
+	RestartPodPolicy string `json:"RestartPodPolicy,omitempty"`
+	// RestartCount is how many times the pod was restarted by its
+	// RestartPodPolicy.
+	RestartCount uint `json:"RestartCount,omitempty"`
 	// Number of the pod's Libpod lock.
 	LockNumber uint32
 }
@@ -150,4 +156,7 @@ type InspectPodContainerInfo struct {
 	Name string
 	// State is the current status of the container.
 	State string
+	// Critical indicates that the pod is restarted according to its
+	// RestartPodPolicy when the container exits or turns unhealthy.
+	Critical bool `json:"Critical,omitempty"`
 }
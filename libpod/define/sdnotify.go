@@ -8,14 +8,17 @@ const (
 	SdNotifyModeContainer = "container"
 	SdNotifyModeHealthy   = "healthy"
 	SdNotifyModeIgnore    = "ignore"
+	// SdNotifyModeHealthyThenWatchdog sends READY once the container turned
+	// healthy, and WATCHDOG=1 on every successful healthcheck afterwards.
+	SdNotifyModeHealthyThenWatchdog = "healthy-then-watchdog"
 )
 
 // ValidateSdNotifyMode validates the specified mode.
 func ValidateSdNotifyMode(mode string) error {
 	switch mode {
-	case "", SdNotifyModeContainer, SdNotifyModeConmon, SdNotifyModeIgnore, SdNotifyModeHealthy:
+	case "", SdNotifyModeContainer, SdNotifyModeConmon, SdNotifyModeIgnore, SdNotifyModeHealthy, SdNotifyModeHealthyThenWatchdog:
 		return nil
 	default:
-		return fmt.Errorf("%w: invalid sdnotify value %q: must be %s, %s, %s or %s", ErrInvalidArg, mode, SdNotifyModeConmon, SdNotifyModeContainer, SdNotifyModeHealthy, SdNotifyModeIgnore)
+		return fmt.Errorf("%w: invalid sdnotify value %q: must be %s, %s, %s, %s or %s", ErrInvalidArg, mode, SdNotifyModeConmon, SdNotifyModeContainer, SdNotifyModeHealthy, SdNotifyModeHealthyThenWatchdog, SdNotifyModeIgnore)
 	}
 }
@@ -138,6 +138,9 @@ const (
 	Copy Status = "copy"
 	// Create ...
 	Create Status = "create"
+	// CrashLoop indicates that a container exited again shortly after it
+	// was restarted by its restart policy.
+	CrashLoop Status = "crash-loop"
 	// Exec ...
 	Exec Status = "exec"
 	// ExecDied indicates that an exec session in a container died.
@@ -162,6 +162,8 @@ func StringToStatus(name string) (Status, error) {
 		return Commit, nil
 	case Create.String():
 		return Create, nil
+	case CrashLoop.String():
+		return CrashLoop, nil
 	case Exec.String():
 		return Exec, nil
 	case ExecDied.String():
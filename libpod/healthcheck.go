@@ -14,6 +14,9 @@ import (
 
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
+	"github.com/containers/podman/v5/pkg/systemd/notifyproxy"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/coreos/go-systemd/v22/daemon"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
 )
@@ -24,6 +27,11 @@ const (
 	MaxHealthCheckNumberLogs int = 5
 	// MaxHealthCheckLogLength in characters
 	MaxHealthCheckLogLength = 500
+
+	// healthCheckCheckpointPrefix and healthCheckCheckpointSuffix frame
+	// the names of archives written by the checkpoint on-failure action.
+	healthCheckCheckpointPrefix = "checkpoint-"
+	healthCheckCheckpointSuffix = ".tar.zst"
 )
 
 // HealthCheck verifies the state and validity of the healthcheck configuration
@@ -50,13 +58,74 @@ func (r *Runtime) HealthCheck(ctx context.Context, name string) (define.HealthCh
 
 	hcStatus, logStatus, err := container.runHealthCheck(ctx, isStartupHC)
 	if !isStartupHC {
-		if err := container.processHealthCheckStatus(logStatus); err != nil {
+		if err := container.processHealthCheckStatus(ctx, logStatus); err != nil {
 			return hcStatus, err
 		}
 	}
 	return hcStatus, err
 }
 
+// notifyWatchdog sends a WATCHDOG=1 message to the NOTIFY_SOCKET of the
+// container every time a healthcheck succeeds, until the container stops.
+// systemd only accepts notifications from the processes of the service, and
+// the healthchecks run outside of it, so the messages are sent by the process
+// that started the container and keeps running in the service for that.
+// Must be called with the container unlocked.
+func (c *Container) notifyWatchdog(ctx context.Context) error {
+	var lastCheck string
+	for {
+		running, check, err := c.lastSuccessfulHealthCheck()
+		if err != nil {
+			return err
+		}
+		if !running {
+			return nil
+		}
+		if check != "" && check != lastCheck {
+			lastCheck = check
+			if err := notifyproxy.SendMessage(c.config.SdNotifySocket, daemon.SdNotifyWatchdog); err != nil {
+				logrus.Errorf("Sending WATCHDOG message after successful healthcheck: %v", err)
+			} else {
+				logrus.Debugf("Watchdog notify sent successfully")
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(DefaultWaitInterval):
+		}
+	}
+}
+
+// lastSuccessfulHealthCheck returns whether the container is still running
+// and the start time of the latest healthcheck if it succeeded while the
+// container is healthy.
+func (c *Container) lastSuccessfulHealthCheck() (bool, string, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return false, "", err
+		}
+	}
+	if !c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) {
+		return false, "", nil
+	}
+	results, err := c.getHealthCheckLog()
+	if err != nil {
+		return false, "", err
+	}
+	if results.Status != define.HealthCheckHealthy || len(results.Log) == 0 {
+		return true, "", nil
+	}
+	last := results.Log[len(results.Log)-1]
+	if last.ExitCode != 0 {
+		return true, "", nil
+	}
+	return true, last.Start, nil
+}
+
 func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.HealthCheckStatus, string, error) {
 	var (
 		newCommand    []string
@@ -181,11 +250,25 @@ func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.
 	return hcResult, logStatus, hcErr
 }
 
-func (c *Container) processHealthCheckStatus(status string) error {
+func (c *Container) processHealthCheckStatus(ctx context.Context, status string) error {
 	if status != define.HealthCheckUnhealthy {
 		return nil
 	}
 
+	// A critical container restarts its whole pod, regardless of the
+	// on-failure action.  As for the restart action, the cleanup process
+	// handles the restart.
+	pod, _, err := c.podRestartPolicy()
+	if err != nil {
+		return err
+	}
+	if pod != nil {
+		if err := c.Stop(); err != nil {
+			return fmt.Errorf("stopping container to restart pod %s after health-check turned unhealthy: %w", pod.ID(), err)
+		}
+		return nil
+	}
+
 	switch c.config.HealthCheckOnFailureAction {
 	case define.HealthCheckOnFailureActionNone: // Nothing to do
 
@@ -208,6 +291,17 @@ func (c *Container) processHealthCheckStatus(status string) error {
 			return fmt.Errorf("stopping container after health-check turned unhealthy: %w", err)
 		}
 
+	case define.HealthCheckOnFailureActionCheckpoint:
+		// A failed checkpoint must not prevent the restart, so only
+		// log the error.  As for the restart action, the cleanup
+		// process handles the restart.
+		if err := c.checkpointUnhealthy(ctx); err != nil {
+			logrus.Errorf("Checkpointing container %s after health-check turned unhealthy: %v", c.ID(), err)
+		}
+		if err := c.Stop(); err != nil {
+			return fmt.Errorf("restarting/stopping container after health-check turned unhealthy: %w", err)
+		}
+
 	default: // Should not happen but better be safe than sorry
 		return fmt.Errorf("unsupported on-failure action %d", c.config.HealthCheckOnFailureAction)
 	}
@@ -215,6 +309,73 @@ func (c *Container) processHealthCheckStatus(status string) error {
 	return nil
 }
 
+// healthCheckCheckpointRetention returns the number of checkpoint archives
+// kept for the checkpoint on-failure action.
+func (c *Container) healthCheckCheckpointRetention() uint {
+	if c.config.HealthCheckCheckpointRetention == 0 {
+		return define.DefaultHealthCheckCheckpointRetention
+	}
+	return c.config.HealthCheckCheckpointRetention
+}
+
+// healthCheckCheckpointDir returns the directory holding the checkpoint
+// archives written by the checkpoint on-failure action.  It is not part of
+// the container's storage, so that the archives outlive the container, and
+// it is keyed by the container name, so that a container replacing one with
+// the same name, e.g., in a systemd unit, shares the archives and retention.
+func (c *Container) healthCheckCheckpointDir() string {
+	return filepath.Join(c.runtime.config.Engine.StaticDir, "healthcheck-checkpoints", c.Name())
+}
+
+// checkpointUnhealthy exports a checkpoint of the running container into
+// healthCheckCheckpointDir and removes archives exceeding the retention.
+func (c *Container) checkpointUnhealthy(ctx context.Context) error {
+	dir := c.healthCheckCheckpointDir()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	target := filepath.Join(dir, fmt.Sprintf("%s%d%s", healthCheckCheckpointPrefix, time.Now().UnixNano(), healthCheckCheckpointSuffix))
+	options := ContainerCheckpointOptions{
+		TargetFile:     target,
+		KeepRunning:    true,
+		TCPEstablished: true,
+		Compression:    archive.Zstd,
+	}
+	if _, _, err := c.Checkpoint(ctx, options); err != nil {
+		if rmErr := os.Remove(target); rmErr != nil && !errors.Is(rmErr, os.ErrNotExist) {
+			logrus.Errorf("Removing incomplete checkpoint archive %s: %v", target, rmErr)
+		}
+		return err
+	}
+	logrus.Infof("Checkpointed unhealthy container %s to %s", c.ID(), target)
+	return pruneHealthCheckCheckpoints(dir, c.healthCheckCheckpointRetention())
+}
+
+// pruneHealthCheckCheckpoints removes the oldest checkpoint archives in dir
+// until at most keep archives remain.
+func pruneHealthCheckCheckpoints(dir string, keep uint) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	// Archive names embed a fixed-width timestamp, so the lexical order
+	// returned by ReadDir is the chronological order.
+	archives := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasPrefix(name, healthCheckCheckpointPrefix) && strings.HasSuffix(name, healthCheckCheckpointSuffix) {
+			archives = append(archives, name)
+		}
+	}
+	for len(archives) > int(keep) {
+		if err := os.Remove(filepath.Join(dir, archives[0])); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		archives = archives[1:]
+	}
+	return nil
+}
+
 func checkHealthCheckCanBeRun(c *Container) (define.HealthCheckStatus, error) {
 	cstate, err := c.State()
 	if err != nil {
@@ -0,0 +1,49 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneHealthCheckCheckpoints(t *testing.T) {
+	dir := t.TempDir()
+
+	names := []string{
+		"checkpoint-1700000000000000003.tar.zst",
+		"checkpoint-1700000000000000001.tar.zst",
+		"checkpoint-1700000000000000002.tar.zst",
+		"unrelated.tar.zst",
+	}
+	for _, name := range names {
+		err := os.WriteFile(filepath.Join(dir, name), nil, 0o600)
+		require.NoError(t, err)
+	}
+
+	err := pruneHealthCheckCheckpoints(dir, 2)
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	remaining := []string{}
+	for _, entry := range entries {
+		remaining = append(remaining, entry.Name())
+	}
+	assert.Equal(t, []string{
+		"checkpoint-1700000000000000002.tar.zst",
+		"checkpoint-1700000000000000003.tar.zst",
+		"unrelated.tar.zst",
+	}, remaining)
+
+	err = pruneHealthCheckCheckpoints(dir, 0)
+	require.NoError(t, err)
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "unrelated.tar.zst", entries[0].Name())
+}
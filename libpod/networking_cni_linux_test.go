@@ -0,0 +1,50 @@
+//go:build !remote && cni
+
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/common/libnetwork/cni"
+	"github.com/containers/common/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_cniNetworkUpdate(t *testing.T) {
+	dir := t.TempDir()
+	// The backend only loads config lists whose plugins report a
+	// supported version.
+	pluginDir := filepath.Join(dir, "bin")
+	require.NoError(t, os.Mkdir(pluginDir, 0o755))
+	for _, plugin := range []string{"bridge", "portmap", "firewall", "tuning"} {
+		script := "#!/bin/sh\necho '{\"cniVersion\":\"0.4.0\",\"supportedVersions\":[\"0.3.0\",\"0.3.1\",\"0.4.0\"]}'\n"
+		require.NoError(t, os.WriteFile(filepath.Join(pluginDir, plugin), []byte(script), 0o755))
+	}
+	conf := &cni.InitConfig{
+		CNIConfigDir: filepath.Join(dir, "net.d"),
+		RunDir:       filepath.Join(dir, "run"),
+		Config:       &config.Config{},
+	}
+	conf.Config.Network.CNIPluginDirs.Set([]string{pluginDir})
+	backend, err := cni.NewCNINetworkInterface(conf)
+	require.NoError(t, err)
+	testNetworkUpdate(t, backend)
+
+	// The CNI backend writes a new config list, which must be read back
+	// with the same ID.
+	network, err := backend.NetworkInspect("net1")
+	require.NoError(t, err)
+	backend, err = cni.NewCNINetworkInterface(conf)
+	require.NoError(t, err)
+	reloaded, err := backend.NetworkInspect(network.ID)
+	require.NoError(t, err)
+	require.Equal(t, network.Name, reloaded.Name)
+	require.Equal(t, network.Labels, reloaded.Labels)
+	require.Len(t, reloaded.Subnets, 2)
+	require.Equal(t, "10.190.0.0/24", reloaded.Subnets[1].Subnet.String())
+	require.Equal(t, "10.190.0.1", reloaded.Subnets[1].Gateway.String())
+	require.Equal(t, "10.190.0.100", reloaded.Subnets[1].LeaseRange.StartIP.String())
+	require.True(t, reloaded.Internal)
+}
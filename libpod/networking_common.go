@@ -16,8 +16,8 @@ import (
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/namespaces"
 	"github.com/containers/podman/v5/pkg/rootless"
-	"github.com/containers/storage/pkg/lockfile"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -101,6 +101,15 @@ func (r *Runtime) teardownNetwork(ctr *Container) error {
 
 	if !ctr.config.NetMode.IsSlirp4netns() &&
 		!ctr.config.NetMode.IsPasta() && len(networks) > 0 {
+		hasEgressRules, err := ctr.hasEgressRules(maps.Keys(networks))
+		if err != nil {
+			logrus.Warnf("Failed to get egress rules of container %s: %v", ctr.ID(), err)
+		}
+		if hasEgressRules {
+			if err := r.removeEgressFirewall(ctr.state.NetNS); err != nil {
+				logrus.Warnf("Failed to remove egress rules of container %s: %v", ctr.ID(), err)
+			}
+		}
 		netOpts := ctr.getNetworkOptions(networks)
 		return r.teardownNetworkBackend(ctr.state.NetNS, netOpts)
 	}
@@ -146,6 +155,12 @@ func (r *Runtime) reloadContainerNetwork(ctr *Container) (map[string]types.Statu
 		}
 	}
 
+	return r.reconfigureContainerNetwork(ctr)
+}
+
+// reconfigureContainerNetwork sets up the network of a container whose network
+// has been torn down, preserving its interface names, MAC and IP addresses.
+func (r *Runtime) reconfigureContainerNetwork(ctr *Container) (map[string]types.StatusBlock, error) {
 	networkOpts, err := ctr.networks()
 	if err != nil {
 		return nil, err
@@ -171,6 +186,196 @@ func (r *Runtime) reloadContainerNetwork(ctr *Container) (map[string]types.Statu
 	return r.configureNetNS(ctr, ctr.state.NetNS)
 }
 
+// errRootlessPortUpdateUnsupported is returned when the rootlessport process
+// of a container was started by a version of podman which can only reload the
+// exposed ports, not replace them.
+var errRootlessPortUpdateUnsupported = errors.New("the rootlessport process of the container does not support changing port mappings, restart the container to change them")
+
+// UpdatePortMappings replaces the port mappings of the container.
+// If the network of the container is configured, it is torn down and set up
+// again with the new port mappings, preserving the IP and MAC addresses, so the
+// container does not need to be restarted. This only works with bridge
+// networking at present. Rootless containers must have been started with port
+// mappings, as the rootless port forwarder only runs with them.
+// Otherwise the new port mappings are used the next time the container starts.
+func (c *Container) UpdatePortMappings(ports []types.PortMapping) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	if c.config.NetNsCtr != "" {
+		return fmt.Errorf("container %s shares the network namespace of container %s, change the port mappings of that container instead: %w", c.ID(), c.config.NetNsCtr, define.ErrNetworkModeInvalid)
+	}
+	if !c.config.NetMode.IsBridge() && !c.config.NetMode.IsSlirp4netns() && !c.config.NetMode.IsPasta() {
+		return fmt.Errorf("port mappings are not supported with network mode %q: %w", c.config.NetMode, define.ErrNetworkModeInvalid)
+	}
+
+	oldPorts := c.config.PortMappings
+	if c.state.NetNS != "" && c.ensureState(define.ContainerStateCreated, define.ContainerStateRunning, define.ContainerStatePaused) {
+		if err := isBridgeNetMode(c.config.NetMode); err != nil {
+			return fmt.Errorf("cannot change the port mappings of a running container: %w", err)
+		}
+		// The rootlessport process forwarding the ports from the host is
+		// only started with the container, so it can not be added later.
+		if rootless.IsRootless() && len(oldPorts) == 0 && len(ports) > 0 {
+			return fmt.Errorf("cannot add port mappings to a running rootless container without port mappings, restart the container instead: %w", define.ErrRootless)
+		}
+
+		if err := c.runtime.teardownNetwork(c); err != nil {
+			return fmt.Errorf("tearing down network of container %s: %w", c.ID(), err)
+		}
+		c.config.PortMappings = ports
+		netStatus, err := c.runtime.reconfigureContainerNetwork(c)
+		if err == nil && rootless.IsRootless() {
+			c.state.NetworkStatus = netStatus
+			if err = c.updateRootlessRLKPortMapping(); err != nil {
+				if terr := c.runtime.teardownNetwork(c); terr != nil {
+					logrus.Errorf("Tearing down network of container %s: %v", c.ID(), terr)
+				}
+			}
+		}
+		if err != nil {
+			// Restore the previous port mappings so the container
+			// keeps its network.
+			c.config.PortMappings = oldPorts
+			if oldStatus, rerr := c.runtime.reconfigureContainerNetwork(c); rerr != nil {
+				logrus.Errorf("Restoring network of container %s: %v", c.ID(), rerr)
+			} else if rootless.IsRootless() {
+				c.state.NetworkStatus = oldStatus
+				// An old rootlessport process could not handle the
+				// update and still exposes the previous ports.
+				if !errors.Is(err, errRootlessPortUpdateUnsupported) {
+					if rerr := c.updateRootlessRLKPortMapping(); rerr != nil {
+						logrus.Errorf("Restoring port mappings of container %s: %v", c.ID(), rerr)
+					}
+				}
+			}
+			return fmt.Errorf("setting up network of container %s: %w", c.ID(), err)
+		}
+		c.state.NetworkStatus = netStatus
+		c.perNetworkOpts = nil
+	}
+
+	c.config.PortMappings = ports
+	if err := c.runtime.state.SafeRewriteContainerConfig(c, "", "", c.config); err != nil {
+		return fmt.Errorf("updating port mappings of container %s: %w", c.ID(), err)
+	}
+	return c.save()
+}
+
+// UpdateNetworkRates changes the bandwidth limits of the container. The rates
+// replace the current limits of the networks they are keyed by, the empty key
+// sets the limit of all networks without a limit of their own. A zero rate
+// removes the limit. The limits of a running container are applied directly.
+func (c *Container) UpdateNetworkRates(rates map[string]define.NetworkRate) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	if c.config.NetNsCtr != "" {
+		return fmt.Errorf("container %s shares the network namespace of container %s, change the network rates of that container instead: %w", c.ID(), c.config.NetNsCtr, define.ErrNetworkModeInvalid)
+	}
+	if err := isBridgeNetMode(c.config.NetMode); err != nil {
+		return fmt.Errorf("network rates can only be set for containers using bridge networks: %w", err)
+	}
+
+	networks, err := c.networks()
+	if err != nil {
+		return err
+	}
+	rates, err = c.runtime.normalizeNetworkRates(rates, networks)
+	if err != nil {
+		return err
+	}
+
+	oldRates := c.config.NetworkRates
+	newRates := maps.Clone(oldRates)
+	if newRates == nil {
+		newRates = make(map[string]define.NetworkRate, len(rates))
+	}
+	for netName, rate := range rates {
+		if rate.IsZero() {
+			delete(newRates, netName)
+			continue
+		}
+		newRates[netName] = rate
+	}
+	if len(newRates) == 0 {
+		newRates = nil
+	}
+	if len(newRates) > 0 && c.hasNetAdminCapability() {
+		return fmt.Errorf("network rates cannot be enforced for containers with the CAP_NET_ADMIN capability: %w", define.ErrInvalidArg)
+	}
+
+	c.config.NetworkRates = newRates
+	if c.state.NetNS != "" && c.ensureState(define.ContainerStateCreated, define.ContainerStateRunning, define.ContainerStatePaused) {
+		if err := c.runtime.setupNetworkRates(c, c.state.NetNS, c.getNetworkStatus()); err != nil {
+			c.config.NetworkRates = oldRates
+			return fmt.Errorf("setting network rates of container %s: %w", c.ID(), err)
+		}
+	}
+
+	if err := c.runtime.state.SafeRewriteContainerConfig(c, "", "", c.config); err != nil {
+		return fmt.Errorf("updating network rates of container %s: %w", c.ID(), err)
+	}
+	return nil
+}
+
+// networkRate returns the bandwidth limit of the container on the given
+// network.
+func (c *Container) networkRate(netName string) define.NetworkRate {
+	if rate, ok := c.config.NetworkRates[netName]; ok {
+		return rate
+	}
+	return c.config.NetworkRates[""]
+}
+
+// inspectNetworkRate returns the bandwidth limit of the container on the given
+// network for inspect, nil when there is no limit.
+func (c *Container) inspectNetworkRate(netName string) *define.NetworkRate {
+	rate := c.networkRate(netName)
+	if rate.IsZero() {
+		return nil
+	}
+	return &rate
+}
+
+// normalizeNetworkRates converts the network IDs used as keys of rates to
+// network names and makes sure the container is connected to these networks.
+func (r *Runtime) normalizeNetworkRates(rates map[string]define.NetworkRate, networks map[string]types.PerNetworkOptions) (map[string]define.NetworkRate, error) {
+	normalized := make(map[string]define.NetworkRate, len(rates))
+	for nameOrID, rate := range rates {
+		if nameOrID == "" {
+			normalized[nameOrID] = rate
+			continue
+		}
+		netName, _, err := r.normalizeNetworkName(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		_, connected := networks[netName]
+		if len(networks) == 0 {
+			// no networks given means the default network is used
+			connected = netName == r.config.Network.DefaultNetwork
+		}
+		if !connected {
+			return nil, fmt.Errorf("cannot set network rate, container is not connected to network %s: %w", netName, define.ErrInvalidArg)
+		}
+		normalized[netName] = rate
+	}
+	return normalized, nil
+}
+
 // Produce an InspectNetworkSettings containing information on the container
 // network.
 func (c *Container) getContainerNetworkInfo() (*define.InspectNetworkSettings, error) {
@@ -234,6 +439,8 @@ func (c *Container) getContainerNetworkInfo() (*define.InspectNetworkSettings, e
 				cniNet := new(define.InspectAdditionalNetwork)
 				cniNet.NetworkID = net
 				cniNet.Aliases = opts.Aliases
+				cniNet.NetworkRate = c.inspectNetworkRate(net)
+				cniNet.EgressRules = c.inspectEgressRules(net)
 				settings.Networks[net] = cniNet
 			}
 		} else {
@@ -266,6 +473,8 @@ func (c *Container) getContainerNetworkInfo() (*define.InspectNetworkSettings, e
 			addedNet.NetworkID = name
 			addedNet.Aliases = opts.Aliases
 			addedNet.InspectBasicNetworkConfig = resultToBasicNetworkConfig(result)
+			addedNet.NetworkRate = c.inspectNetworkRate(name)
+			addedNet.EgressRules = c.inspectEgressRules(name)
 
 			settings.Networks[name] = addedNet
 		}
@@ -399,6 +608,12 @@ func (c *Container) NetworkDisconnect(nameOrID, netName string, force bool) erro
 	oldStatus, statusExist := networkStatus[netName]
 	delete(networkStatus, netName)
 	c.state.NetworkStatus = networkStatus
+
+	// the egress rules of the removed interface must not apply to a
+	// later interface with the same name
+	if err := c.updateEgressFirewall(netName, networkStatus); err != nil {
+		return err
+	}
 	err = c.save()
 	if err != nil {
 		return err
@@ -433,7 +648,7 @@ func (c *Container) NetworkDisconnect(nameOrID, netName string, force bool) erro
 			if len(rm) > 0 {
 				// make sure to lock this file to prevent concurrent writes when
 				// this is used a net dependency container
-				lock, err := lockfile.GetLockFile(file)
+				lock, err := c.hostsFileLock(file)
 				if err != nil {
 					return fmt.Errorf("failed to lock hosts file: %w", err)
 				}
@@ -473,6 +688,16 @@ func (c *Container) NetworkConnect(nameOrID, netName string, netOpts types.PerNe
 		return err
 	}
 
+	hasEgressRules, err := c.hasEgressRules([]string{netName})
+	if err != nil {
+		return err
+	}
+	if hasEgressRules {
+		if err := c.validateEgressNetAdmin(); err != nil {
+			return err
+		}
+	}
+
 	if err := c.syncContainer(); err != nil {
 		return err
 	}
@@ -528,6 +753,21 @@ func (c *Container) NetworkConnect(nameOrID, netName string, netOpts types.PerNe
 	if len(results) != 1 {
 		return errors.New("when adding aliases, results must be of length 1")
 	}
+	if len(c.config.NetworkRates) > 0 {
+		if err := c.runtime.setupNetworkRates(c, c.state.NetNS, results); err != nil {
+			return err
+		}
+	}
+	if err := c.runtime.setupEgressFirewall(c, c.state.NetNS, mergeNetworkStatus(networkStatus, results)); err != nil {
+		// do not leave the interface without its egress rules
+		if err := c.runtime.teardownNetworkBackend(c.state.NetNS, opts); err != nil {
+			logrus.Warnf("Failed to tear down network %s of container %s: %v", netName, c.ID(), err)
+		}
+		if err := c.runtime.state.NetworkDisconnect(c, netName); err != nil {
+			logrus.Warnf("Failed to disconnect container %s from network %s: %v", c.ID(), netName, err)
+		}
+		return err
+	}
 
 	// we need to get the old host entries before we add the new one to the status
 	// if we do not add do it here we will get the wrong existing entries which will throw of the logic
@@ -577,7 +817,7 @@ func (c *Container) NetworkConnect(nameOrID, netName string, netOpts types.PerNe
 	if file, ok := c.state.BindMounts[config.DefaultHostsFile]; ok {
 		// make sure to lock this file to prevent concurrent writes when
 		// this is used a net dependency container
-		lock, err := lockfile.GetLockFile(file)
+		lock, err := c.hostsFileLock(file)
 		if err != nil {
 			return fmt.Errorf("failed to lock hosts file: %w", err)
 		}
@@ -640,6 +880,144 @@ func (r *Runtime) ConnectContainerToNetwork(nameOrID, netName string, netOpts ty
 	return ctr.NetworkConnect(nameOrID, netName, netOpts)
 }
 
+// UpdateNetwork changes the configuration of a network.
+// Changes of the DNS servers only are applied by the network backend directly.
+// For all other changes, the network of the running containers connected to
+// the network is torn down with the old configuration, the network backend
+// updates the configuration of the network, and the networks of the containers
+// are set up again the same way as reloadContainerNetwork does, keeping their
+// IP and MAC addresses.
+func (r *Runtime) UpdateNetwork(nameOrID string, options types.NetworkUpdateOptions) error {
+	network, err := r.network.NetworkInspect(nameOrID)
+	if err != nil {
+		return err
+	}
+	if err := moveEgressUpdateOptions(&options); err != nil {
+		return err
+	}
+	if options.OnlyDNS() {
+		return r.network.NetworkUpdate(network.Name, options)
+	}
+	if network.Name == r.config.Network.DefaultNetwork {
+		return fmt.Errorf("default network %s cannot be modified: %w", network.Name, define.ErrInvalidArg)
+	}
+
+	egress := network.Labels[define.NetworkEgressLabel]
+	if slices.Contains(options.RemoveLabels, define.NetworkEgressLabel) {
+		egress = ""
+	}
+	if label, ok := options.AddLabels[define.NetworkEgressLabel]; ok {
+		egress = label
+	}
+
+	ctrs, err := r.state.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	var reload []*Container
+	for _, ctr := range ctrs {
+		networks, err := ctr.networks()
+		if err != nil {
+			return err
+		}
+		netOpts, ok := networks[network.Name]
+		if !ok {
+			continue
+		}
+		if err := checkRemovedSubnets(ctr, network.Name, netOpts, options.RemoveSubnets); err != nil {
+			return err
+		}
+		if egress != "" {
+			if err := ctr.validateEgressNetAdmin(); err != nil {
+				return err
+			}
+		}
+		if ctr.config.NetNsCtr == "" {
+			reload = append(reload, ctr)
+		}
+	}
+
+	var tornDown []*Container
+	defer func() {
+		for _, ctr := range tornDown {
+			if err := ctr.reconfigureNetwork(); err != nil {
+				logrus.Errorf("Setting up network of container %s after updating network %s: %v", ctr.ID(), network.Name, err)
+			}
+		}
+	}()
+	for _, ctr := range reload {
+		ok, err := ctr.teardownNetworkForUpdate()
+		if err != nil {
+			return fmt.Errorf("tearing down network of container %s: %w", ctr.ID(), err)
+		}
+		if ok {
+			tornDown = append(tornDown, ctr)
+		}
+	}
+	return r.network.NetworkUpdate(network.Name, options)
+}
+
+// checkRemovedSubnets makes sure that the container does not use an IP address
+// in one of the removed subnets of the network.
+func checkRemovedSubnets(ctr *Container, netName string, netOpts types.PerNetworkOptions, removed []types.IPNet) error {
+	ips := slices.Clone(netOpts.StaticIPs)
+	if status, ok := ctr.getNetworkStatus()[netName]; ok {
+		for _, netInt := range status.Interfaces {
+			for _, netAddress := range netInt.Subnets {
+				ips = append(ips, netAddress.IPNet.IP)
+			}
+		}
+	}
+	for _, subnet := range removed {
+		for _, ip := range ips {
+			if subnet.Contains(ip) {
+				return fmt.Errorf("subnet %s is in use by container %s: %w", subnet.String(), ctr.ID(), define.ErrNetworkInUse)
+			}
+		}
+	}
+	return nil
+}
+
+// teardownNetworkForUpdate tears down the network of the container if it is
+// configured and uses bridge networking. It returns true if the network was
+// torn down.
+func (c *Container) teardownNetworkForUpdate() (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.syncContainer(); err != nil {
+		return false, err
+	}
+	if c.state.NetNS == "" || !c.config.NetMode.IsBridge() {
+		return false, nil
+	}
+	if err := c.runtime.teardownNetwork(c); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// reconfigureNetwork sets up the network of the container again after it has
+// been torn down by teardownNetworkForUpdate.
+func (c *Container) reconfigureNetwork() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.syncContainer(); err != nil {
+		return err
+	}
+	if c.state.NetNS == "" {
+		// The container was stopped in the meantime.
+		return nil
+	}
+	result, err := c.runtime.reconfigureContainerNetwork(c)
+	if err != nil {
+		return err
+	}
+	c.state.NetworkStatus = result
+	return c.save()
+}
+
 // normalizeNetworkName takes a network name, a partial or a full network ID and
 // returns: 1) the network name and 2) the network_interface name for macvlan
 // and ipvlan drivers if the naming pattern is "device" defined in the
@@ -724,3 +1102,136 @@ func compareOCICNIPorts(i, j types.OCICNIPortMapping) bool {
 
 	return i.ContainerPort < j.ContainerPort
 }
+
+// NetworkEndpoints returns the endpoints of all containers connected to the
+// given network. Running containers include the traffic counters of their
+// interface.
+func (r *Runtime) NetworkEndpoints(nameOrID string) ([]define.NetworkEndpoint, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	network, err := r.network.NetworkInspect(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrs, err := r.GetAllContainers()
+	if err != nil {
+		return nil, err
+	}
+
+	// containers joining the network namespace of another container are
+	// not connected themselves, list them with the owner of the namespace
+	shared := make(map[string][]string)
+	for _, ctr := range ctrs {
+		if ctr.config.NetNsCtr != "" {
+			shared[ctr.config.NetNsCtr] = append(shared[ctr.config.NetNsCtr], ctr.ID())
+		}
+	}
+
+	endpoints := make([]define.NetworkEndpoint, 0)
+	for _, ctr := range ctrs {
+		endpoint, err := ctr.networkEndpoint(network.Name)
+		if err != nil {
+			// the container may have been removed in the meantime
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return nil, fmt.Errorf("inspecting network endpoint of container %s: %w", ctr.ID(), err)
+		}
+		if endpoint == nil {
+			continue
+		}
+		endpoint.SharedWith = shared[ctr.ID()]
+		endpoints = append(endpoints, *endpoint)
+	}
+	return endpoints, nil
+}
+
+// networkEndpoint returns the endpoint of the container on the given network
+// or nil if the container is not connected to it.
+func (c *Container) networkEndpoint(netName string) (*define.NetworkEndpoint, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.config.NetNsCtr != "" {
+		return nil, nil
+	}
+	networks, err := c.networks()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := networks[netName]; !ok {
+		return nil, nil
+	}
+
+	endpoint := &define.NetworkEndpoint{
+		ContainerID:   c.ID(),
+		ContainerName: c.Name(),
+		State:         c.state.State.String(),
+	}
+	if c.config.Pod != "" {
+		pod, err := c.runtime.state.Pod(c.config.Pod)
+		if err != nil {
+			return nil, err
+		}
+		endpoint.PodID = pod.ID()
+		endpoint.PodName = pod.Name()
+	}
+
+	settings, err := c.getContainerNetworkInfo()
+	if err != nil {
+		return nil, err
+	}
+	if netSettings, ok := settings.Networks[netName]; ok {
+		endpoint.Aliases = netSettings.Aliases
+		endpoint.MacAddress = netSettings.MacAddress
+		endpoint.IPAddresses = inspectIPAddresses(&netSettings.InspectBasicNetworkConfig)
+	}
+
+	status, ok := c.getNetworkStatus()[netName]
+	if !ok {
+		return endpoint, nil
+	}
+	for name := range status.Interfaces {
+		endpoint.InterfaceName = name
+		break
+	}
+
+	if endpoint.InterfaceName != "" && (c.state.State == define.ContainerStateRunning || c.state.State == define.ContainerStatePaused) {
+		stats, err := getContainerNetIO(c)
+		if err != nil {
+			return nil, err
+		}
+		if ifStats, ok := stats[endpoint.InterfaceName]; ok {
+			endpoint.Statistics = &ifStats
+		}
+	}
+	return endpoint, nil
+}
+
+// inspectIPAddresses returns all addresses of the inspect network config in
+// CIDR notation.
+func inspectIPAddresses(config *define.InspectBasicNetworkConfig) []string {
+	var addrs []string
+	if config.IPAddress != "" {
+		addrs = append(addrs, fmt.Sprintf("%s/%d", config.IPAddress, config.IPPrefixLen))
+	}
+	for _, addr := range config.SecondaryIPAddresses {
+		addrs = append(addrs, fmt.Sprintf("%s/%d", addr.Addr, addr.PrefixLength))
+	}
+	if config.GlobalIPv6Address != "" {
+		addrs = append(addrs, fmt.Sprintf("%s/%d", config.GlobalIPv6Address, config.GlobalIPv6PrefixLen))
+	}
+	for _, addr := range config.SecondaryIPv6Addresses {
+		addrs = append(addrs, fmt.Sprintf("%s/%d", addr.Addr, addr.PrefixLength))
+	}
+	return addrs
+}
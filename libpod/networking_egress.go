@@ -0,0 +1,158 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// egressOptionPrefix is the prefix of the egress rules in the network options
+// of a container.
+const egressOptionPrefix = "egress="
+
+// containerEgressRules returns the egress rules of the container for the given
+// network, these are the rules of the network itself followed by the rules
+// given for the container.
+func (c *Container) containerEgressRules(network *types.Network) ([]define.EgressRule, error) {
+	rules, err := define.ParseEgressRules(network.Labels[define.NetworkEgressLabel])
+	if err != nil {
+		return nil, fmt.Errorf("label %s of network %s: %w", define.NetworkEgressLabel, network.Name, err)
+	}
+	for _, opt := range c.config.NetworkOptions[network.Name] {
+		value, ok := strings.CutPrefix(opt, egressOptionPrefix)
+		if !ok {
+			continue
+		}
+		rule, err := define.ParseEgressRule(value)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// hasEgressOptions returns true if egress rules were given for the container.
+func (c *Container) hasEgressOptions() bool {
+	for _, opts := range c.config.NetworkOptions {
+		if slices.ContainsFunc(opts, func(opt string) bool { return strings.HasPrefix(opt, egressOptionPrefix) }) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasEgressRules returns true if the container has egress rules for any of the
+// given networks.
+func (c *Container) hasEgressRules(networks []string) (bool, error) {
+	for _, name := range networks {
+		network, err := c.runtime.network.NetworkInspect(name)
+		if err != nil {
+			return false, err
+		}
+		rules, err := c.containerEgressRules(&network)
+		if err != nil {
+			return false, err
+		}
+		if len(rules) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// inspectEgressRules returns the egress rules of the container on the given
+// network for inspect.
+func (c *Container) inspectEgressRules(netName string) []string {
+	network, err := c.runtime.network.NetworkInspect(netName)
+	if err != nil {
+		return nil
+	}
+	rules, err := c.containerEgressRules(&network)
+	if err != nil {
+		return nil
+	}
+	result := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, rule.String())
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// normalizeEgressOptions converts the network IDs used as keys of the network
+// options with egress rules to network names and makes sure the container is
+// connected to these networks.
+func (r *Runtime) normalizeEgressOptions(options map[string][]string, networks map[string]types.PerNetworkOptions) (map[string][]string, error) {
+	normalized := make(map[string][]string, len(options))
+	for nameOrID, opts := range options {
+		if !slices.ContainsFunc(opts, func(opt string) bool { return strings.HasPrefix(opt, egressOptionPrefix) }) {
+			normalized[nameOrID] = opts
+			continue
+		}
+		netName, _, err := r.normalizeNetworkName(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		_, connected := networks[netName]
+		if len(networks) == 0 {
+			// no networks given means the default network is used
+			connected = netName == r.config.Network.DefaultNetwork
+		}
+		if !connected {
+			return nil, fmt.Errorf("cannot set egress rules, container is not connected to network %s: %w", netName, define.ErrInvalidArg)
+		}
+		normalized[netName] = append(normalized[netName], opts...)
+	}
+	return normalized, nil
+}
+
+// moveEgressUpdateOptions moves the changes of the egress option of a network
+// to its NetworkEgressLabel label, see define.MoveEgressOption.
+func moveEgressUpdateOptions(options *types.NetworkUpdateOptions) error {
+	options.AddOptions = maps.Clone(options.AddOptions)
+	labels, err := define.MoveEgressOption(options.AddOptions, maps.Clone(options.AddLabels))
+	if err != nil {
+		return err
+	}
+	options.AddLabels = labels
+	if i := slices.Index(options.RemoveOptions, define.NetworkEgressOption); i >= 0 {
+		options.RemoveOptions = slices.Delete(slices.Clone(options.RemoveOptions), i, i+1)
+		options.RemoveLabels = append(slices.Clone(options.RemoveLabels), define.NetworkEgressLabel)
+	}
+	return nil
+}
+
+// updateEgressFirewall updates the egress rules of a running container after
+// it was disconnected from the network removedNet, status is the remaining
+// network status.
+func (c *Container) updateEgressFirewall(removedNet string, status map[string]types.StatusBlock) error {
+	hadRules, err := c.hasEgressRules([]string{removedNet})
+	if err != nil || !hadRules {
+		return err
+	}
+	hasRules, err := c.hasEgressRules(maps.Keys(status))
+	if err != nil {
+		return err
+	}
+	if hasRules {
+		return c.runtime.setupEgressFirewall(c, c.state.NetNS, status)
+	}
+	return c.runtime.removeEgressFirewall(c.state.NetNS)
+}
+
+// mergeNetworkStatus returns a copy of status with the entries of added.
+func mergeNetworkStatus(status, added map[string]types.StatusBlock) map[string]types.StatusBlock {
+	merged := make(map[string]types.StatusBlock, len(status)+len(added))
+	maps.Copy(merged, status)
+	maps.Copy(merged, added)
+	return merged
+}
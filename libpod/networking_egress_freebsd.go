@@ -0,0 +1,32 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
+)
+
+// setupEgressFirewall is not supported on FreeBSD, an error is returned when
+// the container has egress rules for the given networks.
+func (r *Runtime) setupEgressFirewall(ctr *Container, ctrNS string, status map[string]types.StatusBlock) error {
+	networks := make([]string, 0, len(status))
+	for name := range status {
+		networks = append(networks, name)
+	}
+	hasRules, err := ctr.hasEgressRules(networks)
+	if err != nil {
+		return err
+	}
+	if hasRules {
+		return fmt.Errorf("egress rules: %w", define.ErrOSNotSupported)
+	}
+	return nil
+}
+
+// removeEgressFirewall is a no-op on FreeBSD.
+func (r *Runtime) removeEgressFirewall(ctrNS string) error {
+	return nil
+}
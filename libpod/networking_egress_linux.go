@@ -0,0 +1,211 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/sirupsen/logrus"
+)
+
+// egressTable is the nftables table with the egress rules in the network
+// namespace of a container.
+const egressTable = "inet podman-egress"
+
+// egressDestination is a resolved egress rule.
+type egressDestination struct {
+	// address is an IP address or a subnet in CIDR notation.
+	address string
+	ipv6    bool
+	// startPort and endPort are the allowed port range, all ports are
+	// allowed when startPort is 0.
+	startPort uint16
+	endPort   uint16
+}
+
+// egressInterface holds the allowed destinations of a container interface.
+type egressInterface struct {
+	name string
+	// dnsServers are always allowed on port 53 so name resolution keeps
+	// working.
+	dnsServers   []net.IP
+	destinations []egressDestination
+}
+
+// setupEgressFirewall installs the egress rules of the container for the
+// interfaces of the given networks in the network namespace ctrNS. Existing
+// rules are replaced. Nothing is done when no interface has egress rules.
+func (r *Runtime) setupEgressFirewall(ctr *Container, ctrNS string, status map[string]types.StatusBlock) error {
+	interfaces, err := ctr.egressInterfaces(status)
+	if err != nil {
+		return err
+	}
+	if len(interfaces) == 0 {
+		return nil
+	}
+	logrus.Debugf("Setting up egress rules in network namespace %s of container %s", ctrNS, ctr.ID())
+	return runNftInNetNS(ctrNS, egressRuleset(interfaces))
+}
+
+// removeEgressFirewall removes the egress rules from the network namespace
+// ctrNS.
+func (r *Runtime) removeEgressFirewall(ctrNS string) error {
+	return runNftInNetNS(ctrNS, fmt.Sprintf("table %s\ndelete table %s\n", egressTable, egressTable))
+}
+
+// egressInterfaces returns the interfaces of the given networks with egress
+// rules, DNS names in the rules are resolved.
+func (c *Container) egressInterfaces(status map[string]types.StatusBlock) ([]egressInterface, error) {
+	netNames := make([]string, 0, len(status))
+	for name := range status {
+		netNames = append(netNames, name)
+	}
+	sort.Strings(netNames)
+
+	var interfaces []egressInterface
+	for _, netName := range netNames {
+		network, err := c.runtime.network.NetworkInspect(netName)
+		if err != nil {
+			return nil, err
+		}
+		rules, err := c.containerEgressRules(&network)
+		if err != nil {
+			return nil, err
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		destinations, err := resolveEgressRules(rules)
+		if err != nil {
+			return nil, err
+		}
+
+		ifNames := make([]string, 0, len(status[netName].Interfaces))
+		for name := range status[netName].Interfaces {
+			ifNames = append(ifNames, name)
+		}
+		sort.Strings(ifNames)
+		for _, ifName := range ifNames {
+			iface := egressInterface{
+				name:         ifName,
+				destinations: destinations,
+			}
+			if network.DNSEnabled {
+				for _, subnet := range status[netName].Interfaces[ifName].Subnets {
+					if subnet.Gateway != nil {
+						iface.dnsServers = append(iface.dnsServers, subnet.Gateway)
+					}
+				}
+			}
+			interfaces = append(interfaces, iface)
+		}
+	}
+	return interfaces, nil
+}
+
+// resolveEgressRules converts the rules to destinations, DNS names are
+// resolved to all their addresses.
+func resolveEgressRules(rules []define.EgressRule) ([]egressDestination, error) {
+	destinations := make([]egressDestination, 0, len(rules))
+	for _, rule := range rules {
+		var addresses []string
+		switch {
+		case strings.Contains(rule.Destination, "/"):
+			addresses = []string{rule.Destination}
+		case net.ParseIP(rule.Destination) != nil:
+			addresses = []string{rule.Destination}
+		default:
+			ips, err := net.LookupIP(rule.Destination)
+			if err != nil {
+				return nil, fmt.Errorf("resolving egress destination %s: %w", rule.Destination, err)
+			}
+			for _, ip := range ips {
+				addresses = append(addresses, ip.String())
+			}
+		}
+		for _, address := range addresses {
+			destinations = append(destinations, egressDestination{
+				address:   address,
+				ipv6:      strings.Contains(address, ":"),
+				startPort: rule.StartPort,
+				endPort:   rule.EndPort,
+			})
+		}
+	}
+	return destinations, nil
+}
+
+// egressRuleset returns the nftables ruleset which only allows traffic to the
+// destinations of each interface. Replies to incoming connections are always
+// allowed, all other traffic leaving an interface is rejected.
+func egressRuleset(interfaces []egressInterface) string {
+	var b strings.Builder
+	// create the table first so the delete never fails, this replaces the
+	// existing rules atomically
+	fmt.Fprintf(&b, "table %s\ndelete table %s\ntable %s {\n", egressTable, egressTable, egressTable)
+	b.WriteString("\tchain output {\n\t\ttype filter hook output priority 0; policy accept;\n")
+	b.WriteString("\t\tct state established,related accept\n")
+	for i, iface := range interfaces {
+		fmt.Fprintf(&b, "\t\toifname %q jump egress%d\n", iface.name, i)
+	}
+	b.WriteString("\t}\n")
+	for i, iface := range interfaces {
+		fmt.Fprintf(&b, "\tchain egress%d {\n", i)
+		b.WriteString("\t\ticmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit } accept\n")
+		for _, dns := range iface.dnsServers {
+			family := "ip"
+			if dns.To4() == nil {
+				family = "ip6"
+			}
+			for _, proto := range []string{"udp", "tcp"} {
+				fmt.Fprintf(&b, "\t\t%s daddr %s %s dport 53 accept\n", family, dns, proto)
+			}
+		}
+		for _, dest := range iface.destinations {
+			family := "ip"
+			if dest.ipv6 {
+				family = "ip6"
+			}
+			if dest.startPort == 0 {
+				fmt.Fprintf(&b, "\t\t%s daddr %s accept\n", family, dest.address)
+				continue
+			}
+			ports := fmt.Sprintf("%d", dest.startPort)
+			if dest.endPort != dest.startPort {
+				ports = fmt.Sprintf("%d-%d", dest.startPort, dest.endPort)
+			}
+			for _, proto := range []string{"tcp", "udp"} {
+				fmt.Fprintf(&b, "\t\t%s daddr %s %s dport %s accept\n", family, dest.address, proto, ports)
+			}
+		}
+		b.WriteString("\t\treject with icmpx type admin-prohibited\n\t}\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// runNftInNetNS loads the nftables ruleset in the network namespace nsPath.
+func runNftInNetNS(nsPath, ruleset string) error {
+	nft, err := exec.LookPath("nft")
+	if err != nil {
+		return fmt.Errorf("nft is required for egress rules: %w", err)
+	}
+	return ns.WithNetNSPath(nsPath, func(_ ns.NetNS) error {
+		cmd := exec.Command(nft, "-f", "-")
+		cmd.Stdin = strings.NewReader(ruleset)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("loading egress rules with nft: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return nil
+	})
+}
@@ -3,17 +3,21 @@
 package libpod
 
 import (
+	"context"
 	"crypto/rand"
 	jdec "encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"os/exec"
 	"path/filepath"
+	"time"
 
 	"github.com/containers/buildah/pkg/jail"
 	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/netcap"
 	"github.com/containers/storage/pkg/lockfile"
 	"github.com/sirupsen/logrus"
 )
@@ -120,6 +124,18 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS string) (status map[strin
 	if err != nil {
 		return nil, err
 	}
+	if err := r.setupNetworkRates(ctr, ctrNS, netStatus); err != nil {
+		if err := r.teardownNetworkBackend(ctrNS, netOpts); err != nil {
+			logrus.Warnf("failed to teardown network after failed setup: %v", err)
+		}
+		return nil, err
+	}
+	if err := r.setupEgressFirewall(ctr, ctrNS, netStatus); err != nil {
+		if err := r.teardownNetworkBackend(ctrNS, netOpts); err != nil {
+			logrus.Warnf("failed to teardown network after failed setup: %v", err)
+		}
+		return nil, err
+	}
 
 	return netStatus, err
 }
@@ -265,6 +281,10 @@ func (c *Container) reloadRootlessRLKPortMapping() error {
 	return errors.New("unsupported (*Container).reloadRootlessRLKPortMapping")
 }
 
+func (c *Container) updateRootlessRLKPortMapping() error {
+	return errors.New("unsupported (*Container).updateRootlessRLKPortMapping")
+}
+
 func (c *Container) setupRootlessNetwork() error {
 	return nil
 }
@@ -272,3 +292,13 @@ func (c *Container) setupRootlessNetwork() error {
 func getPastaIP(state *ContainerState) (net.IP, error) {
 	return nil, fmt.Errorf("pasta networking is Linux only")
 }
+
+// WaitForPort is not supported on FreeBSD.
+func (c *Container) WaitForPort(ctx context.Context, interval time.Duration, port uint16) error {
+	return fmt.Errorf("waiting for a port: %w", define.ErrNotImplemented)
+}
+
+// NetworkCapture is not supported on FreeBSD.
+func (c *Container) NetworkCapture(ctx context.Context, options netcap.Options, w io.Writer) (uint64, error) {
+	return 0, fmt.Errorf("capturing packets: %w", define.ErrNotImplemented)
+}
@@ -0,0 +1,187 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/common/libnetwork/etchosts"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/storage/pkg/lockfile"
+	"github.com/sirupsen/logrus"
+)
+
+// hostsGroupNames returns the names the container is known by in the hosts
+// files of the other members of its hosts group.
+func (c *Container) hostsGroupNames() []string {
+	names := []string{c.Hostname(), c.config.Name}
+	if c.IsInfra() {
+		// the pod name is what users expect to resolve for pods
+		pod, err := c.runtime.state.Pod(c.config.Pod)
+		if err != nil {
+			logrus.Debugf("Looking up pod of infra container %s: %v", c.ID(), err)
+		} else if pod.Name() != c.Hostname() {
+			names = append(names, pod.Name())
+		}
+	}
+	return names
+}
+
+// hostsGroupEntries returns the entries of the container in the hosts file
+// of viewer. Containers on bridge networks are reached with their IP
+// addresses, containers using the host network, pasta or slirp4netns only
+// through the ports they publish on the host.
+func (c *Container) hostsGroupEntries(viewer *Container) etchosts.HostEntries {
+	names := c.hostsGroupNames()
+	switch {
+	case c.config.NetMode.IsBridge():
+		return etchosts.GetNetworkHostEntries(c.state.NetworkStatus, names...)
+	case c.hasNetNone():
+		// not reachable from other network namespaces
+		return nil
+	default:
+		ip := etchosts.GetHostContainersInternalIP(c.runtime.config, viewer.state.NetworkStatus, c.runtime.network)
+		if ip == "" {
+			return nil
+		}
+		return etchosts.HostEntries{{IP: ip, Names: names}}
+	}
+}
+
+// hostsGroupMembers returns the other containers of the hosts group of the
+// container which have a network and a hosts file.
+// The members are not locked, this could deadlock with a member joining the
+// group at the same time. Their state is read from the database and all edits
+// of their hosts files are serialized by the lock of the group, so it must be
+// held by the caller.
+func (c *Container) hostsGroupMembers() ([]*Container, error) {
+	ctrs, err := c.runtime.state.AllContainers(true)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]*Container, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		if ctr.ID() == c.ID() || ctr.config.HostsGroup != c.config.HostsGroup {
+			continue
+		}
+		if ctr.state.NetNS == "" && ctr.state.State != define.ContainerStateRunning &&
+			ctr.state.State != define.ContainerStatePaused {
+			continue
+		}
+		if _, ok := ctr.state.BindMounts[config.DefaultHostsFile]; !ok {
+			continue
+		}
+		members = append(members, ctr)
+	}
+	return members, nil
+}
+
+// hostsGroupLock returns the lock of the hosts group of the container. The
+// hosts files themselves cannot be locked, etchosts closes them which
+// releases the lock, and one lock per group does not grow the lockfile cache
+// with every container.
+func (c *Container) hostsGroupLock() (*lockfile.LockFile, error) {
+	return lockfile.GetLockFile(filepath.Join(c.runtime.config.Engine.TmpDir, "hosts-group-"+c.config.HostsGroup+".lck"))
+}
+
+// hostsFileLock returns the lock which must be held to edit the hosts file of
+// the container, the lock of its hosts group if it is part of one.
+func (c *Container) hostsFileLock(hostsFile string) (*lockfile.LockFile, error) {
+	if c.config.HostsGroup != "" {
+		return c.hostsGroupLock()
+	}
+	return lockfile.GetLockFile(hostsFile)
+}
+
+// editHostsFile calls edit if the hosts file still exists.
+func editHostsFile(hostsFile string, edit func(string) error) error {
+	if _, err := os.Stat(hostsFile); err != nil {
+		return err
+	}
+	return edit(hostsFile)
+}
+
+// joinHostsGroup adds the entries of the other members of the hosts group to
+// the hosts file of the container and the entries of the container to the
+// hosts files of the other members.
+// The container must be locked and its network must be set up.
+func (c *Container) joinHostsGroup() error {
+	if c.config.HostsGroup == "" {
+		return nil
+	}
+	hostsFile, ok := c.state.BindMounts[config.DefaultHostsFile]
+	if !ok {
+		return nil
+	}
+	lock, err := c.hostsGroupLock()
+	if err != nil {
+		return fmt.Errorf("locking hosts group %s: %w", c.config.HostsGroup, err)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	members, err := c.hostsGroupMembers()
+	if err != nil {
+		return fmt.Errorf("looking up members of hosts group %s: %w", c.config.HostsGroup, err)
+	}
+
+	var entries etchosts.HostEntries
+	for _, member := range members {
+		entries = append(entries, member.hostsGroupEntries(c)...)
+
+		memberFile := member.state.BindMounts[config.DefaultHostsFile]
+		err := editHostsFile(memberFile, func(file string) error {
+			return etchosts.Add(file, c.hostsGroupEntries(member))
+		})
+		// the member may have been stopped in the meantime
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			logrus.Errorf("Adding hosts entries of container %s to container %s: %v", c.ID(), member.ID(), err)
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return editHostsFile(hostsFile, func(file string) error {
+		return etchosts.Add(file, entries)
+	})
+}
+
+// leaveHostsGroup removes the entries of the container from the hosts files
+// of the other members of its hosts group. It must be called before the
+// network status of the container is cleared.
+// Errors are not fatal, they are only logged so that the cleanup continues.
+func (c *Container) leaveHostsGroup() {
+	if c.config.HostsGroup == "" {
+		return
+	}
+	lock, err := c.hostsGroupLock()
+	if err != nil {
+		logrus.Errorf("Locking hosts group %s: %v", c.config.HostsGroup, err)
+		return
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	members, err := c.hostsGroupMembers()
+	if err != nil {
+		logrus.Errorf("Looking up members of hosts group %s: %v", c.config.HostsGroup, err)
+		return
+	}
+	for _, member := range members {
+		entries := c.hostsGroupEntries(member)
+		if len(entries) == 0 {
+			continue
+		}
+		memberFile := member.state.BindMounts[config.DefaultHostsFile]
+		err := editHostsFile(memberFile, func(file string) error {
+			return etchosts.Remove(file, entries)
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			logrus.Errorf("Removing hosts entries of container %s from container %s: %v", c.ID(), member.ID(), err)
+		}
+	}
+}
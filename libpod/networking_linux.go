@@ -3,17 +3,22 @@
 package libpod
 
 import (
+	"context"
 	"crypto/rand"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/containers/common/libnetwork/types"
 	netUtil "github.com/containers/common/libnetwork/util"
 	"github.com/containers/common/pkg/netns"
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/netcap"
 	"github.com/containers/podman/v5/pkg/rootless"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
@@ -64,6 +69,15 @@ func (r *Runtime) configureNetNS(ctr *Container, ctrNS string) (status map[strin
 		}
 	}()
 
+	if len(ctr.config.NetworkRates) > 0 {
+		if err := r.setupNetworkRates(ctr, ctrNS, netStatus); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.setupEgressFirewall(ctr, ctrNS, netStatus); err != nil {
+		return nil, err
+	}
+
 	// set up rootless port forwarder when rootless with ports and the network status is empty,
 	// if this is called from network reload the network status will not be empty and we should
 	// not set up port because they are still active
@@ -320,3 +334,141 @@ func getPastaIP(state *ContainerState) (net.IP, error) {
 	})
 	return net.ParseIP(ip), err
 }
+
+// NetworkCapture captures packets in the network namespace of the container
+// and writes them to w in the pcapng format until ctx is cancelled or the
+// packet count of the options is reached. It returns the number of captured
+// packets.
+func (c *Container) NetworkCapture(ctx context.Context, options netcap.Options, w io.Writer) (uint64, error) {
+	netNSPath, err := c.captureNetNSPath()
+	if err != nil {
+		return 0, err
+	}
+	// do not hold the container lock while capturing, the packet socket
+	// keeps the network namespace alive
+	return netcap.Capture(ctx, netNSPath, options, w)
+}
+
+// captureNetNSPath returns the path of the network namespace used by the
+// container.
+func (c *Container) captureNetNSPath() (string, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return "", err
+		}
+	}
+
+	if !c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) {
+		return "", fmt.Errorf("container %s must be running to capture packets: %w", c.ID(), define.ErrCtrStateInvalid)
+	}
+
+	netNSPath, err := c.netNSPath()
+	if err != nil {
+		return "", err
+	}
+	if netNSPath == "" {
+		return "", fmt.Errorf("container %s uses the host network, capture packets on the host instead: %w", c.ID(), define.ErrNetworkModeInvalid)
+	}
+	return netNSPath, nil
+}
+
+// netNSPath returns the path of the network namespace of the running
+// container, or an empty string if it uses the host network.  Must be called
+// with the container locked.
+func (c *Container) netNSPath() (string, error) {
+	netNSPath, _, err := getContainerNetNS(c)
+	if err != nil {
+		return "", err
+	}
+	if netNSPath == "" {
+		path, set := c.joinedNetworkNSPath()
+		if !set {
+			return "", nil
+		}
+		netNSPath = path
+		if netNSPath == "" {
+			// network none, the namespace was created by the OCI runtime
+			netNSPath = fmt.Sprintf("/proc/%d/ns/net", c.state.PID)
+		}
+	}
+	return netNSPath, nil
+}
+
+// WaitForPort blocks until a TCP connection to the given port on the loopback
+// interface of the container's network namespace succeeds, checking every
+// interval.  It fails if the container exits before.
+func (c *Container) WaitForPort(ctx context.Context, interval time.Duration, port uint16) error {
+	if !c.valid {
+		return define.ErrCtrRemoved
+	}
+	for {
+		netNSPath, running, err := c.portNetNSPath(port)
+		if err != nil {
+			return err
+		}
+		if running {
+			reachable, err := portReachable(netNSPath, port)
+			if err != nil || reachable {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return define.ErrCanceled
+		case <-time.After(interval):
+		}
+	}
+}
+
+// portNetNSPath returns the path of the network namespace WaitForPort must
+// connect in, and whether the container is running yet.
+func (c *Container) portNetNSPath(port uint16) (string, bool, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return "", false, err
+		}
+	}
+
+	switch c.state.State {
+	case define.ContainerStateRunning:
+	case define.ContainerStateExited, define.ContainerStateStopped, define.ContainerStateRemoving:
+		return "", false, fmt.Errorf("container %s exited before port %d became reachable: %w", c.ID(), port, define.ErrCtrStateInvalid)
+	default:
+		// not started yet, or paused
+		return "", false, nil
+	}
+	netNSPath, err := c.netNSPath()
+	return netNSPath, true, err
+}
+
+// portReachable reports whether a TCP connection to the port on the loopback
+// interface of the network namespace succeeds, an empty path meaning the
+// host network namespace.
+func portReachable(netNSPath string, port uint16) (bool, error) {
+	reachable := false
+	dial := func() {
+		for _, host := range []string{"127.0.0.1", "::1"} {
+			conn, err := net.DialTimeout("tcp", net.JoinHostPort(host, strconv.Itoa(int(port))), time.Second)
+			if err == nil {
+				conn.Close()
+				reachable = true
+				return
+			}
+		}
+	}
+	if netNSPath == "" {
+		dial()
+		return reachable, nil
+	}
+	err := ns.WithNetNSPath(netNSPath, func(_ ns.NetNS) error {
+		dial()
+		return nil
+	})
+	return reachable, err
+}
@@ -5,12 +5,16 @@ package libpod
 import (
 	"fmt"
 	"net"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/containers/common/libnetwork/netavark"
 	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v5/libpod/define"
 )
 
@@ -529,3 +533,147 @@ func Benchmark_ocicniPortsToNetTypesPorts1m(b *testing.B) {
 	b.ResetTimer()
 	benchmarkOCICNIPortsToNetTypesPorts(b, ports)
 }
+
+// testNetworkUpdate updates a network of the given network backend and makes
+// sure that the network keeps its ID.
+func testNetworkUpdate(t *testing.T, backend types.ContainerNetwork) {
+	subnet1, _ := types.ParseCIDR("10.189.0.0/24")
+	subnet2, _ := types.ParseCIDR("10.190.0.0/24")
+	subnet3, _ := types.ParseCIDR("10.191.0.0/24")
+	subnet6, _ := types.ParseCIDR("fd00:189::/64")
+	network, err := backend.NetworkCreate(types.Network{
+		Name:    "net1",
+		Driver:  types.BridgeNetworkDriver,
+		Subnets: []types.Subnet{{Subnet: subnet1, Gateway: net.ParseIP("10.189.0.1")}, {Subnet: subnet6}},
+		Labels:  map[string]string{"a": "1", "b": "2"},
+		Options: map[string]string{types.IsolateOption: "true"},
+	}, nil)
+	require.NoError(t, err)
+	_, err = backend.NetworkCreate(types.Network{
+		Name:    "net2",
+		Driver:  types.BridgeNetworkDriver,
+		Subnets: []types.Subnet{{Subnet: subnet3}},
+	}, nil)
+	require.NoError(t, err)
+
+	internal := true
+	err = backend.NetworkUpdate("net1", types.NetworkUpdateOptions{
+		AddSubnets:    []types.Subnet{{Subnet: subnet2}},
+		RemoveSubnets: []types.IPNet{subnet6},
+		Gateways:      []net.IP{net.ParseIP("10.189.0.254"), net.ParseIP("10.190.0.1")},
+		LeaseRanges:   []types.LeaseRange{{StartIP: net.ParseIP("10.190.0.100"), EndIP: net.ParseIP("10.190.0.200")}},
+		Internal:      &internal,
+		AddLabels:     map[string]string{"c": "3"},
+		RemoveLabels:  []string{"a"},
+		AddOptions:    map[string]string{types.MTUOption: "1400"},
+		RemoveOptions: []string{types.IsolateOption},
+	})
+	require.NoError(t, err)
+	newNetwork, err := backend.NetworkInspect("net1")
+	require.NoError(t, err)
+	assert.Equal(t, network.ID, newNetwork.ID)
+	// The backend normalizes the addresses.
+	assert.Equal(t, []types.Subnet{
+		{Subnet: subnet1, Gateway: net.ParseIP("10.189.0.254").To4()},
+		{Subnet: subnet2, Gateway: net.ParseIP("10.190.0.1").To4(), LeaseRange: &types.LeaseRange{StartIP: net.ParseIP("10.190.0.100").To4(), EndIP: net.ParseIP("10.190.0.200").To4()}},
+	}, newNetwork.Subnets)
+	assert.False(t, newNetwork.IPv6Enabled)
+	assert.True(t, newNetwork.Internal)
+	assert.Equal(t, map[string]string{"b": "2", "c": "3"}, newNetwork.Labels)
+	assert.Equal(t, map[string]string{types.MTUOption: "1400"}, newNetwork.Options)
+
+	err = backend.NetworkUpdate("net1", types.NetworkUpdateOptions{RemoveSubnets: []types.IPNet{subnet6}})
+	assert.ErrorContains(t, err, "subnet fd00:189::/64 is not used by network net1")
+
+	err = backend.NetworkUpdate("net1", types.NetworkUpdateOptions{Gateways: []net.IP{net.ParseIP("10.91.0.1")}})
+	assert.ErrorContains(t, err, "gateway 10.91.0.1 is not part of any subnet of network net1")
+
+	err = backend.NetworkUpdate("net1", types.NetworkUpdateOptions{RemoveSubnets: []types.IPNet{subnet1, subnet2}})
+	assert.ErrorContains(t, err, "network net1 must have at least one subnet")
+
+	err = backend.NetworkUpdate("net1", types.NetworkUpdateOptions{AddSubnets: []types.Subnet{{Subnet: subnet3}}})
+	assert.ErrorContains(t, err, "subnet 10.191.0.0/24 is already used on the host or by another config")
+
+	err = backend.NetworkUpdate("net1", types.NetworkUpdateOptions{AddOptions: map[string]string{types.MTUOption: "abc"}})
+	assert.ErrorContains(t, err, "invalid options for network net1")
+
+	err = backend.NetworkUpdate("podman", types.NetworkUpdateOptions{AddLabels: map[string]string{"a": "1"}})
+	assert.ErrorContains(t, err, "default network podman cannot be modified")
+
+	// The failed updates do not change the network.
+	network, err = backend.NetworkInspect(newNetwork.ID)
+	require.NoError(t, err)
+	assert.Equal(t, newNetwork, network)
+}
+
+func Test_netavarkNetworkUpdate(t *testing.T) {
+	dir := t.TempDir()
+	backend, err := netavark.NewNetworkInterface(&netavark.InitConfig{
+		NetworkConfigDir: filepath.Join(dir, "networks"),
+		NetworkRunDir:    filepath.Join(dir, "run"),
+		Config:           &config.Config{},
+	})
+	require.NoError(t, err)
+	testNetworkUpdate(t, backend)
+}
+
+func Test_resolveEgressRules(t *testing.T) {
+	rules := []define.EgressRule{
+		{Destination: "10.0.0.0/8"},
+		{Destination: "192.168.1.10", StartPort: 443, EndPort: 443},
+		{Destination: "fd00::1", StartPort: 8000, EndPort: 8100},
+	}
+	got, err := resolveEgressRules(rules)
+	assert.NoError(t, err)
+	assert.Equal(t, []egressDestination{
+		{address: "10.0.0.0/8"},
+		{address: "192.168.1.10", startPort: 443, endPort: 443},
+		{address: "fd00::1", ipv6: true, startPort: 8000, endPort: 8100},
+	}, got)
+}
+
+func Test_egressRuleset(t *testing.T) {
+	interfaces := []egressInterface{
+		{
+			name:       "eth0",
+			dnsServers: []net.IP{net.ParseIP("10.89.0.1")},
+			destinations: []egressDestination{
+				{address: "10.0.0.0/8"},
+				{address: "192.168.1.10", startPort: 443, endPort: 443},
+			},
+		},
+		{
+			name: "eth1",
+			destinations: []egressDestination{
+				{address: "fd00::1", ipv6: true, startPort: 8000, endPort: 8100},
+			},
+		},
+	}
+	want := `table inet podman-egress
+delete table inet podman-egress
+table inet podman-egress {
+	chain output {
+		type filter hook output priority 0; policy accept;
+		ct state established,related accept
+		oifname "eth0" jump egress0
+		oifname "eth1" jump egress1
+	}
+	chain egress0 {
+		icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit } accept
+		ip daddr 10.89.0.1 udp dport 53 accept
+		ip daddr 10.89.0.1 tcp dport 53 accept
+		ip daddr 10.0.0.0/8 accept
+		ip daddr 192.168.1.10 tcp dport 443 accept
+		ip daddr 192.168.1.10 udp dport 443 accept
+		reject with icmpx type admin-prohibited
+	}
+	chain egress1 {
+		icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit } accept
+		ip6 daddr fd00::1 tcp dport 8000-8100 accept
+		ip6 daddr fd00::1 udp dport 8000-8100 accept
+		reject with icmpx type admin-prohibited
+	}
+}
+`
+	assert.Equal(t, want, egressRuleset(interfaces))
+}
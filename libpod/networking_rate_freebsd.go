@@ -0,0 +1,19 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
+)
+
+// setupNetworkRates is not supported on FreeBSD, an error is returned when
+// the container has network rates.
+func (r *Runtime) setupNetworkRates(ctr *Container, ctrNS string, status map[string]types.StatusBlock) error {
+	if len(ctr.config.NetworkRates) > 0 {
+		return fmt.Errorf("network rates: %w", define.ErrOSNotSupported)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// networkRateMinBurst is the smallest burst size in bytes, it must be
+	// larger than the MTU of the interface for traffic to pass at all.
+	networkRateMinBurst = 32 * 1024
+	// networkRateLatency is the maximum time in microseconds a packet may
+	// wait in the egress queue before it is dropped.
+	networkRateLatency = 25 * 1000
+)
+
+// setupNetworkRates applies the bandwidth limits of the container to the
+// interfaces of the given networks in the network namespace ctrNS.
+// Interfaces without limits have any existing limit removed.
+func (r *Runtime) setupNetworkRates(ctr *Container, ctrNS string, status map[string]types.StatusBlock) error {
+	return ns.WithNetNSPath(ctrNS, func(_ ns.NetNS) error {
+		for netName, netStatus := range status {
+			rate := ctr.networkRate(netName)
+			for ifName := range netStatus.Interfaces {
+				link, err := netlink.LinkByName(ifName)
+				if err != nil {
+					return fmt.Errorf("get interface %s: %w", ifName, err)
+				}
+				if err := setEgressRate(link, rate.Egress); err != nil {
+					return fmt.Errorf("set egress rate of interface %s: %w", ifName, err)
+				}
+				if err := setIngressRate(link, rate.Ingress); err != nil {
+					return fmt.Errorf("set ingress rate of interface %s: %w", ifName, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// networkRateBurst returns the burst size in bytes for a rate in bytes per
+// second, it allows 10ms of traffic at full rate.
+func networkRateBurst(rate uint64) uint32 {
+	burst := rate / 100
+	if burst < networkRateMinBurst {
+		return networkRateMinBurst
+	}
+	if burst > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(burst)
+}
+
+// findQdisc returns the qdisc of the given type attached to parent.
+func findQdisc(link netlink.Link, qdiscType string, parent uint32) (netlink.Qdisc, error) {
+	qdiscs, err := netlink.QdiscList(link)
+	if err != nil {
+		return nil, err
+	}
+	for _, qdisc := range qdiscs {
+		if qdisc.Type() == qdiscType && qdisc.Attrs().Parent == parent {
+			return qdisc, nil
+		}
+	}
+	return nil, nil
+}
+
+// setEgressRate shapes the traffic sent by the container with a token bucket
+// filter as root qdisc, a rate of 0 removes it.
+func setEgressRate(link netlink.Link, rate uint64) error {
+	if rate == 0 {
+		qdisc, err := findQdisc(link, "tbf", netlink.HANDLE_ROOT)
+		if err != nil || qdisc == nil {
+			return err
+		}
+		return netlink.QdiscDel(qdisc)
+	}
+	byteRate := rate / 8
+	burst := networkRateBurst(byteRate)
+	limit := byteRate*networkRateLatency/1000000 + uint64(burst)
+	if limit > math.MaxUint32 {
+		limit = math.MaxUint32
+	}
+	return netlink.QdiscReplace(&netlink.Tbf{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(1, 0),
+			Parent:    netlink.HANDLE_ROOT,
+		},
+		Rate:   byteRate,
+		Limit:  uint32(limit),
+		Buffer: netlink.Xmittime(byteRate, burst),
+	})
+}
+
+// setIngressRate polices the traffic received by the container, packets
+// exceeding the rate are dropped. A rate of 0 removes the ingress qdisc.
+func setIngressRate(link netlink.Link, rate uint64) error {
+	if rate == 0 {
+		qdisc, err := findQdisc(link, "ingress", netlink.HANDLE_INGRESS)
+		if err != nil || qdisc == nil {
+			return err
+		}
+		return netlink.QdiscDel(qdisc)
+	}
+	byteRate := rate / 8
+	if byteRate > math.MaxUint32 {
+		return fmt.Errorf("ingress rate %s is too large: %w", define.FormatBitRate(rate), define.ErrInvalidArg)
+	}
+	ingress := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if err := netlink.QdiscReplace(ingress); err != nil {
+		return err
+	}
+	police := netlink.NewPoliceAction()
+	police.Rate = uint32(byteRate)
+	police.Burst = networkRateBurst(byteRate)
+	police.ExceedAction = netlink.TC_POLICE_SHOT
+	return netlink.FilterReplace(&netlink.MatchAll{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    ingress.Handle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []netlink.Action{police},
+	})
+}
@@ -8,9 +8,11 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/containers/common/libnetwork/slirp4netns"
 	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/common/pkg/rootlessport"
 	"github.com/containers/podman/v5/pkg/errorhandling"
 	"github.com/sirupsen/logrus"
 )
@@ -79,14 +81,37 @@ func (c *Container) reloadRootlessRLKPortMapping() error {
 	}
 	childIP := slirp4netns.GetRootlessPortChildIP(nil, c.state.NetworkStatus)
 	logrus.Debugf("reloading rootless ports for container %s, childIP is %s", c.config.ID, childIP)
+	return c.sendRootlessRLKPortRequest(childIP)
+}
+
+// updateRootlessRLKPortMapping replaces the ports exposed by the rootlessport
+// process with the port mappings of the container.
+// This should only be called by UpdatePortMappings and only as rootless.
+func (c *Container) updateRootlessRLKPortMapping() error {
+	childIP := slirp4netns.GetRootlessPortChildIP(nil, c.state.NetworkStatus)
+	logrus.Debugf("updating rootless ports for container %s, childIP is %s", c.config.ID, childIP)
+	err := c.sendRootlessRLKPortRequest(rootlessport.Config{
+		Mappings: c.convertPortMappings(),
+		ChildIP:  childIP,
+	})
+	// Older rootlessport processes only accept the child IP and fail to
+	// decode the configuration, without changing the exposed ports.
+	if err != nil && strings.Contains(err.Error(), "cannot unmarshal object into Go value of type string") {
+		return fmt.Errorf("container %s: %w", c.ID(), errRootlessPortUpdateUnsupported)
+	}
+	return err
+}
 
+// sendRootlessRLKPortRequest sends a reload request to the rootlessport
+// process of the container.
+func (c *Container) sendRootlessRLKPortRequest(request any) error {
 	conn, err := openUnixSocket(filepath.Join(c.runtime.config.Engine.TmpDir, "rp", c.config.ID))
 	if err != nil {
 		return fmt.Errorf("could not reload rootless port mappings, port forwarding may no longer work correctly: %w", err)
 	}
 	defer conn.Close()
 	enc := json.NewEncoder(conn)
-	err = enc.Encode(childIP)
+	err = enc.Encode(request)
 	if err != nil {
 		return fmt.Errorf("port reloading failed: %w", err)
 	}
@@ -93,6 +93,14 @@ type OCIRuntime interface { //nolint:interfacebloat
 	// does not attach to it. Returns the PID of the exec session and an
 	// error (if starting the exec session failed)
 	ExecContainerDetached(ctr *Container, sessionID string, options *ExecOptions, stdin bool) (int, error)
+	// ExecAttach attaches to a running exec session. Only the Streams,
+	// DetachKeys and AttachReady fields of params are used. The exec
+	// session keeps running when the attach session ends.
+	ExecAttach(ctr *Container, sessionID string, params *AttachOptions) error
+	// ExecHTTPAttach attaches to a running exec session over a hijacked
+	// HTTP session. It maintains the same invariants as HTTPAttach,
+	// isTerminal must be whether the exec session has a terminal.
+	ExecHTTPAttach(ctr *Container, sessionID string, r *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, isTerminal bool, cancel <-chan bool, hijackDone chan<- bool) error
 	// ExecAttachResize resizes the terminal of a running exec session. Only
 	// allowed with sessions that were created with a TTY.
 	ExecAttachResize(ctr *Container, sessionID string, newSize resize.TerminalSize) error
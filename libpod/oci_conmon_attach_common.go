@@ -195,6 +195,46 @@ func (c *Container) attachToExec(streams *define.AttachStreams, keys *string, se
 	return readStdio(conn, streams, receiveStdoutError, stdinDone)
 }
 
+// ExecAttach attaches to the console socket of a running exec session.
+// Conmon keeps the socket open until the exec session exits, so the session
+// can be attached to any number of times.
+func (r *ConmonOCIRuntime) ExecAttach(c *Container, sessionID string, params *AttachOptions) error {
+	if !params.Streams.AttachOutput && !params.Streams.AttachError && !params.Streams.AttachInput {
+		return fmt.Errorf("must provide at least one stream to attach to: %w", define.ErrInvalidArg)
+	}
+
+	keys := config.DefaultDetachKeys
+	if params.DetachKeys != nil {
+		keys = *params.DetachKeys
+	}
+	detachKeys, err := processDetachKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	logrus.Debugf("Attaching to container %s exec session %s", c.ID(), sessionID)
+
+	sockPath, err := r.ExecAttachSocketPath(c, sessionID)
+	if err != nil {
+		return err
+	}
+	conn, err := openUnixSocket(sockPath)
+	if err != nil {
+		return fmt.Errorf("failed to connect to exec session's attach socket: %v: %w", sockPath, err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Errorf("Unable to close socket: %q", err)
+		}
+	}()
+
+	receiveStdoutError, stdinDone := setupStdioChannels(params.Streams, conn, detachKeys)
+	if params.AttachReady != nil {
+		params.AttachReady <- true
+	}
+	return readStdio(conn, params.Streams, receiveStdoutError, stdinDone)
+}
+
 func processDetachKeys(keys string) ([]byte, error) {
 	// Check the validity of the provided keys first
 	if len(keys) == 0 {
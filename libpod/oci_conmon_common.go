@@ -710,6 +710,13 @@ func (r *ConmonOCIRuntime) HTTPAttach(ctr *Container, req *http.Request, w http.
 
 	logrus.Debugf("Forwarding attach output for container %s", ctr.ID())
 
+	return forwardHTTPAttach(conn, httpBuf, ctr.ID(), isTerminal, attachStdin, attachStdout, attachStderr, isDetach, cancel)
+}
+
+// forwardHTTPAttach copies the standard streams between an attach socket of
+// conmon and a hijacked HTTP connection until the output is closed, STDIN hits
+// an error or cancel fires.
+func forwardHTTPAttach(conn *net.UnixConn, httpBuf *bufio.ReadWriter, id string, isTerminal, attachStdin, attachStdout, attachStderr bool, isDetach []byte, cancel <-chan bool) error {
 	stdoutChan := make(chan error)
 	stdinChan := make(chan error)
 
@@ -723,13 +730,13 @@ func (r *ConmonOCIRuntime) HTTPAttach(ctr *Container, req *http.Request, w http.
 			// Everything does over STDOUT.
 			// Therefore, if not attaching STDOUT - we'll never copy
 			// anything from here.
-			logrus.Debugf("Performing terminal HTTP attach for container %s", ctr.ID())
+			logrus.Debugf("Performing terminal HTTP attach for container %s", id)
 			if attachStdout {
-				err = httpAttachTerminalCopy(conn, httpBuf, ctr.ID())
+				err = httpAttachTerminalCopy(conn, httpBuf, id)
 			}
 		} else {
-			logrus.Debugf("Performing non-terminal HTTP attach for container %s", ctr.ID())
-			err = httpAttachNonTerminalCopy(conn, httpBuf, ctr.ID(), attachStdin, attachStdout, attachStderr)
+			logrus.Debugf("Performing non-terminal HTTP attach for container %s", id)
+			err = httpAttachNonTerminalCopy(conn, httpBuf, id, attachStdin, attachStdout, attachStderr)
 		}
 		stdoutChan <- err
 		logrus.Debugf("STDOUT/ERR copy completed")
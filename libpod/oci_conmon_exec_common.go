@@ -196,6 +196,66 @@ func (r *ConmonOCIRuntime) ExecContainerDetached(ctr *Container, sessionID strin
 	return pid, err
 }
 
+// ExecHTTPAttach attaches to the console socket of a running exec session and
+// forwards it over a hijacked HTTP connection.
+func (r *ConmonOCIRuntime) ExecHTTPAttach(ctr *Container, sessionID string, req *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, isTerminal bool, cancel <-chan bool, hijackDone chan<- bool) (deferredErr error) {
+	if streams == nil || (!streams.Stdin && !streams.Stdout && !streams.Stderr) {
+		return fmt.Errorf("must specify at least one stream to attach to: %w", define.ErrInvalidArg)
+	}
+
+	detachString := ctr.runtime.config.Engine.DetachKeys
+	if detachKeys != nil {
+		detachString = *detachKeys
+	}
+	isDetach, err := processDetachKeys(detachString)
+	if err != nil {
+		return err
+	}
+
+	sockPath, err := r.ExecAttachSocketPath(ctr, sessionID)
+	if err != nil {
+		return err
+	}
+	conn, err := openUnixSocket(sockPath)
+	if err != nil {
+		return fmt.Errorf("failed to connect to exec session's attach socket: %v: %w", sockPath, err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Errorf("Unable to close container %s exec session %s attach socket: %q", ctr.ID(), sessionID, err)
+		}
+	}()
+
+	logrus.Debugf("Going to hijack container %s exec session %s attach connection", ctr.ID(), sessionID)
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return fmt.Errorf("unable to hijack connection")
+	}
+
+	httpCon, httpBuf, err := hijacker.Hijack()
+	if err != nil {
+		return fmt.Errorf("hijacking connection: %w", err)
+	}
+
+	hijackDone <- true
+
+	writeHijackHeader(req, httpBuf, isTerminal)
+
+	// Force a flush after the header is written.
+	if err := httpBuf.Flush(); err != nil {
+		return fmt.Errorf("flushing HTTP hijack header: %w", err)
+	}
+
+	defer func() {
+		hijackWriteErrorAndClose(deferredErr, ctr.ID(), isTerminal, httpCon, httpBuf)
+	}()
+
+	logrus.Debugf("Forwarding attach output for container %s exec session %s", ctr.ID(), sessionID)
+
+	return forwardHTTPAttach(conn, httpBuf, ctr.ID(), isTerminal, streams.Stdin, streams.Stdout, streams.Stderr, isDetach, cancel)
+}
+
 // ExecAttachResize resizes the TTY of the given exec session.
 func (r *ConmonOCIRuntime) ExecAttachResize(ctr *Container, sessionID string, newSize resize.TerminalSize) error {
 	controlFile, err := openControlFile(ctr, ctr.execBundlePath(sessionID))
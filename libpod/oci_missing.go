@@ -150,6 +150,16 @@ func (r *MissingRuntime) ExecContainerDetached(ctr *Container, sessionID string,
 	return -1, r.printError()
 }
 
+// ExecAttach is not available as the runtime is missing
+func (r *MissingRuntime) ExecAttach(ctr *Container, sessionID string, params *AttachOptions) error {
+	return r.printError()
+}
+
+// ExecHTTPAttach is not available as the runtime is missing
+func (r *MissingRuntime) ExecHTTPAttach(ctr *Container, sessionID string, req *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, isTerminal bool, cancel <-chan bool, hijackDone chan<- bool) error {
+	return r.printError()
+}
+
 // ExecAttachResize is not available as the runtime is missing.
 func (r *MissingRuntime) ExecAttachResize(ctr *Container, sessionID string, newSize resize.TerminalSize) error {
 	return r.printError()
@@ -28,6 +28,7 @@ import (
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opencontainers/runtime-tools/generate"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 var umaskRegex = regexp.Delayed(`^[0-7]{1,4}$`)
@@ -1054,6 +1055,35 @@ func WithDependencyCtrs(ctrs []*Container) CtrCreateOption {
 	}
 }
 
+// WithDependencyConditions sets the conditions, indexed by the ID of the
+// dependency container, which must be met before the container is started,
+// and the time to wait for them.  The containers must have been added as
+// dependencies with WithDependencyCtrs.
+func WithDependencyConditions(conditions map[string]string, timeout time.Duration) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		for id, condition := range conditions {
+			if err := define.ValidDependencyCondition(condition); err != nil {
+				return err
+			}
+			if !slices.Contains(ctr.config.Dependencies, id) {
+				return fmt.Errorf("container %s is not a dependency of the container: %w", id, define.ErrInvalidArg)
+			}
+		}
+		if timeout < 0 {
+			return fmt.Errorf("dependency timeout must not be negative: %w", define.ErrInvalidArg)
+		}
+
+		ctr.config.DependencyConditions = conditions
+		ctr.config.DependencyTimeout = timeout
+
+		return nil
+	}
+}
+
 // WithNetNS indicates that the container should be given a new network
 // namespace with a minimal configuration.
 // An optional array of port mappings can be provided.
@@ -1092,6 +1122,39 @@ func WithNetworkOptions(options map[string][]string) CtrCreateOption {
 	}
 }
 
+// WithNetworkRates sets the bandwidth limits of the container. The map is
+// keyed by the network name, the empty key sets the limit of all networks.
+func WithNetworkRates(rates map[string]define.NetworkRate) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		ctr.config.NetworkRates = rates
+
+		return nil
+	}
+}
+
+// WithHostsGroup adds the container to a group of containers which keep the
+// entries of each other in their /etc/hosts files.
+func WithHostsGroup(group string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		// the name is used for the lock file of the group
+		if !define.NameRegex.MatchString(group) {
+			return define.RegexError
+		}
+
+		ctr.config.HostsGroup = group
+
+		return nil
+	}
+}
+
 // WithLogDriver sets the log driver for the container
 func WithLogDriver(driver string) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1383,6 +1446,21 @@ func WithUseImageHosts() CtrCreateOption {
 	}
 }
 
+// WithPodCritical marks the container as critical for its pod: when it exits
+// or turns unhealthy, the whole pod is restarted according to the pod's
+// RestartPodPolicy.  The container must be part of a pod.
+func WithPodCritical() CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		ctr.config.PodCritical = true
+
+		return nil
+	}
+}
+
 // WithRestartPolicy sets the container's restart policy. Valid values are
 // "no", "on-failure", and "always". The empty string is allowed, and will be
 // equivalent to "no".
@@ -1418,6 +1496,31 @@ func WithRestartRetries(tries uint) CtrCreateOption {
 	}
 }
 
+// WithRestartBackoff delays the restarts by the restart policy exponentially.
+func WithRestartBackoff(backoff define.RestartBackoff) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		if backoff.Initial <= 0 {
+			return fmt.Errorf("the initial restart delay must be positive: %w", define.ErrInvalidArg)
+		}
+		if backoff.Max == 0 {
+			backoff.Max = define.DefaultRestartBackoffMax
+		}
+		if backoff.Reset == 0 {
+			backoff.Reset = define.DefaultRestartBackoffReset
+		}
+		if backoff.Max < backoff.Initial {
+			return fmt.Errorf("the maximum restart delay %s must not be less than the initial delay %s: %w", backoff.Max, backoff.Initial, define.ErrInvalidArg)
+		}
+		ctr.config.RestartBackoff = &backoff
+
+		return nil
+	}
+}
+
 // WithNamedVolumes adds the given named volumes to the container.
 func WithNamedVolumes(volumes []*ContainerNamedVolume) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1482,6 +1585,27 @@ func WithImageVolumes(volumes []*ContainerImageVolume) CtrCreateOption {
 	}
 }
 
+// WithRootfsVolume bind-mounts the root filesystem of the given container at
+// dest in the container.  The given container is added as a dependency.
+func WithRootfsVolume(srcCtr *Container, dest string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		if err := checkDependencyContainer(srcCtr, ctr); err != nil {
+			return err
+		}
+
+		ctr.config.RootfsVolumes = append(ctr.config.RootfsVolumes, &ContainerRootfsVolume{
+			Source: srcCtr.ID(),
+			Dest:   dest,
+		})
+
+		return nil
+	}
+}
+
 // WithHealthCheck adds the healthcheck to the container config
 func WithHealthCheck(healthCheck *manifest.Schema2HealthConfig) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1504,6 +1628,18 @@ func WithHealthCheckOnFailureAction(action define.HealthCheckOnFailureAction) Ct
 	}
 }
 
+// WithHealthCheckCheckpointRetention sets the number of checkpoint archives
+// kept when the container's on-failure action is checkpoint.
+func WithHealthCheckCheckpointRetention(retention uint) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.HealthCheckCheckpointRetention = retention
+		return nil
+	}
+}
+
 // WithPreserveFDs forwards from the process running Libpod into the container
 // the given number of extra FDs (starting after the standard streams) to the created container
 func WithPreserveFDs(fd uint) CtrCreateOption {
@@ -2011,6 +2147,32 @@ func WithPodRestartPolicy(policy string) PodCreateOption {
 	}
 }
 
+// WithRestartPod sets the policy to restart the whole pod, infra container
+// included, when a critical container of the pod exits or turns unhealthy.
+// retries limits the number of restarts with the "on-failure" policy; 0
+// means no limit.
+func WithRestartPod(policy string, retries uint) PodCreateOption {
+	return func(pod *Pod) error {
+		if pod.valid {
+			return define.ErrPodFinalized
+		}
+
+		switch policy {
+		case define.RestartPolicyNone, define.RestartPolicyNo, define.RestartPolicyOnFailure, define.RestartPolicyAlways, define.RestartPolicyUnlessStopped:
+		default:
+			return fmt.Errorf("%q is not a valid pod restart policy: %w", policy, define.ErrInvalidArg)
+		}
+		if retries > 0 && policy != define.RestartPolicyOnFailure {
+			return fmt.Errorf("the maximum number of pod restarts can only be set with the %q policy: %w", define.RestartPolicyOnFailure, define.ErrInvalidArg)
+		}
+
+		pod.config.RestartPodPolicy = policy
+		pod.config.RestartPodRetries = retries
+
+		return nil
+	}
+}
+
 // WithPodRestartRetries sets the number of retries to use when restarting a
 // container with the "on-failure" restart policy.
 // 0 is an allowed value, and indicates infinite retries.
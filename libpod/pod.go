@@ -89,6 +89,15 @@ type PodConfig struct {
 	// The max number of retries for a pod based on restart policy
 	RestartRetries *uint `json:"RestartRetries,omitempty"`
 
+	// RestartPodPolicy is the policy to restart the whole pod, infra
+	// container included, when a critical container of the pod exits or
+	// turns unhealthy.
+	RestartPodPolicy string `json:"RestartPodPolicy,omitempty"`
+
+	// RestartPodRetries is the maximum number of restarts of the pod with
+	// the "on-failure" RestartPodPolicy. 0 means no limit.
+	RestartPodRetries uint `json:"RestartPodRetries,omitempty"`
+
 	// ID of the pod's lock
 	LockID uint32 `json:"lockID"`
 
@@ -103,6 +112,9 @@ type podState struct {
 	// InfraContainerID is the container that holds pod namespace information
 	// Most often an infra container
 	InfraContainerID string
+	// RestartCount is how many times the pod was restarted by its
+	// RestartPodPolicy since it was last started by the user.
+	RestartCount uint `json:"restartCount,omitempty"`
 }
 
 // ID retrieves the pod's ID
@@ -366,6 +378,24 @@ func (p *Pod) CgroupPath() (string, error) {
 	return p.state.CgroupPath, nil
 }
 
+// RestartPodPolicy returns the policy to restart the whole pod when a
+// critical container exits or turns unhealthy, and the maximum number of
+// restarts.
+func (p *Pod) RestartPodPolicy() (string, uint) {
+	return p.config.RestartPodPolicy, p.config.RestartPodRetries
+}
+
+// RestartCount returns how many times the pod was restarted by its
+// RestartPodPolicy.
+func (p *Pod) RestartCount() (uint, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.updatePod(); err != nil {
+		return 0, err
+	}
+	return p.state.RestartCount, nil
+}
+
 // HasContainer checks if a container is present in the pod
 func (p *Pod) HasContainer(id string) (bool, error) {
 	if !p.valid {
@@ -27,14 +27,10 @@ func (p *Pod) startInitContainers(ctx context.Context) error {
 		if err := initCon.Start(ctx, true); err != nil {
 			return err
 		}
-		// Check that the init container waited correctly and the exit
-		// code is good
-		rc, err := initCon.Wait(ctx)
-		if err != nil {
-			return err
-		}
-		if rc != 0 {
-			return fmt.Errorf("init container %s exited with code %d", initCon.ID(), rc)
+		// Init containers must complete successfully, like dependencies
+		// with the completed-successfully condition
+		if err := initCon.waitForCondition(ctx, define.DependencyConditionCompletedSuccessfully); err != nil {
+			return fmt.Errorf("init container %s: %w", initCon.ID(), err)
 		}
 		// If the container is a once init container, we need to remove it
 		// after it runs
@@ -79,6 +75,22 @@ func (p *Pod) Start(ctx context.Context) (map[string]error, error) {
 		return nil, define.ErrPodRemoved
 	}
 
+	// Starting the pod resets the restarts by its restart policy.
+	if err := p.updatePod(); err != nil {
+		return nil, err
+	}
+	if p.state.RestartCount != 0 {
+		p.state.RestartCount = 0
+		if err := p.save(); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.start(ctx)
+}
+
+// start is the unlocked version of Start.
+func (p *Pod) start(ctx context.Context) (map[string]error, error) {
 	if err := p.maybeStartServiceContainer(ctx); err != nil {
 		return nil, err
 	}
@@ -505,6 +517,50 @@ func (p *Pod) Restart(ctx context.Context) (map[string]error, error) {
 	return nil, nil
 }
 
+// restartAfterCriticalExit restarts the whole pod, infra container
+// included, after its critical container ctrID exited or turned unhealthy,
+// unless the pod reached the maximum number of restarts of its restart
+// policy.
+func (p *Pod) restartAfterCriticalExit(ctx context.Context, ctrID string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.valid {
+		return define.ErrPodRemoved
+	}
+	if err := p.updatePod(); err != nil {
+		return err
+	}
+
+	if p.config.RestartPodPolicy == define.RestartPolicyOnFailure && p.config.RestartPodRetries > 0 &&
+		p.state.RestartCount >= p.config.RestartPodRetries {
+		logrus.Infof("Not restarting pod %s, it reached the maximum of %d restarts", p.ID(), p.config.RestartPodRetries)
+		return nil
+	}
+	p.state.RestartCount++
+	if err := p.save(); err != nil {
+		return err
+	}
+	logrus.Infof("Restarting pod %s after exit of critical container %s", p.ID(), ctrID)
+
+	ctrErrors, err := p.stopWithTimeout(ctx, true, -1)
+	if err != nil {
+		for id, ctrErr := range ctrErrors {
+			logrus.Errorf("Stopping container %s: %v", id, ctrErr)
+		}
+		return err
+	}
+	ctrErrors, err = p.start(ctx)
+	if err != nil {
+		for id, ctrErr := range ctrErrors {
+			logrus.Errorf("Starting container %s: %v", id, ctrErr)
+		}
+		return err
+	}
+	p.newPodEvent(events.Restart)
+	return nil
+}
+
 // Kill sends a signal to all running containers within a pod.
 // Signals will only be sent to running containers. Containers that are not
 // running will be ignored. All signals are sent independently, and sending will
@@ -628,9 +684,10 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 			containerStatus = containerState.String()
 		}
 		ctrs = append(ctrs, define.InspectPodContainerInfo{
-			ID:    c.ID(),
-			Name:  c.Name(),
-			State: containerStatus,
+			ID:       c.ID(),
+			Name:     c.Name(),
+			State:    containerStatus,
+			Critical: c.config.PodCritical,
 		})
 		// Do not add init containers fdr status
 		if len(c.config.InitContainerType) < 1 {
@@ -756,6 +813,8 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 		BlkioDeviceWriteBps: p.BlkiThrottleWriteBps(),
 		CPUShares:           p.CPUShares(),
 		RestartPolicy:       p.config.RestartPolicy,
+		RestartPodPolicy:    p.config.RestartPodPolicy,
+		RestartCount:        p.state.RestartCount,
 		LockNumber:          p.lock.ID(),
 	}
 
@@ -299,12 +299,7 @@ func getDBState(runtime *Runtime) (State, error) {
 		return nil, err
 	}
 
-	// get default boltdb path
-	baseDir := runtime.config.Engine.StaticDir
-	if runtime.storageConfig.TransientStore {
-		baseDir = runtime.config.Engine.TmpDir
-	}
-	boltDBPath := filepath.Join(baseDir, "bolt_state.db")
+	boltDBPath := boltStatePath(runtime)
 
 	switch backend {
 	case config.DBBackendDefault:
@@ -331,6 +326,15 @@ func getDBState(runtime *Runtime) (State, error) {
 	}
 }
 
+// boltStatePath returns the path of the BoltDB database.
+func boltStatePath(runtime *Runtime) string {
+	baseDir := runtime.config.Engine.StaticDir
+	if runtime.storageConfig.TransientStore {
+		baseDir = runtime.config.Engine.TmpDir
+	}
+	return filepath.Join(baseDir, "bolt_state.db")
+}
+
 // Make a new runtime based on the given configuration
 // Sets up containers/storage, state store, OCI runtime
 func makeRuntime(ctx context.Context, runtime *Runtime) (retErr error) {
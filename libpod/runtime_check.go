@@ -0,0 +1,348 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	nettypes "github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/idtools"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+// systemCheck collects the problems found by SystemCheck.
+type systemCheck struct {
+	options define.SystemCheckOptions
+	report  define.SystemCheckReport
+}
+
+// add records a problem.  repair is called to fix it when repairs are
+// requested; lossy repairs are only done with RepairLossy.  A nil repair
+// function means the problem can not be repaired.
+func (s *systemCheck) add(objType, id, problem string, lossy bool, repair func() error) {
+	p := define.SystemCheckProblem{
+		Type:    objType,
+		ID:      id,
+		Problem: problem,
+	}
+	if repair != nil && (s.options.RepairLossy || (s.options.Repair && !lossy)) {
+		if err := repair(); err != nil {
+			logrus.Errorf("Repairing %s %s: %v", objType, id, err)
+		} else {
+			p.Repaired = true
+		}
+	}
+	if !p.Repaired {
+		s.report.Errors = true
+	}
+	s.report.Problems = append(s.report.Problems, p)
+}
+
+// SystemCheck cross-validates the state database, containers/storage, volume
+// mount points, the lock allocation and the network configuration, and
+// optionally repairs the problems found.
+func (r *Runtime) SystemCheck(ctx context.Context, options define.SystemCheckOptions) (define.SystemCheckReport, error) {
+	if !r.valid {
+		return define.SystemCheckReport{}, define.ErrRuntimeStopped
+	}
+
+	check := &systemCheck{
+		options: options,
+		report:  define.SystemCheckReport{Problems: []define.SystemCheckProblem{}},
+	}
+	for _, step := range []func(context.Context, *systemCheck) error{
+		r.checkStorage,
+		r.checkContainerStorage,
+		r.checkVolumes,
+		r.checkLocks,
+		r.checkNetworks,
+	} {
+		if err := step(ctx, check); err != nil {
+			return check.report, err
+		}
+	}
+	return check.report, nil
+}
+
+// checkStorage runs the consistency checks of containers/storage.
+func (r *Runtime) checkStorage(ctx context.Context, check *systemCheck) error {
+	storageOptions := storage.CheckEverything()
+	if check.options.Quick {
+		storageOptions = storage.CheckMost()
+	}
+	storageOptions.LayerUnreferencedMaximumAge = check.options.UnreferencedLayerMaximumAge
+
+	storageReport, err := r.store.Check(storageOptions)
+	if err != nil {
+		return fmt.Errorf("checking storage: %w", err)
+	}
+
+	// containers/storage repairs everything in one go, so collect the
+	// problems first.
+	var problems []define.SystemCheckProblem
+	addErrors := func(objType string, objects map[string][]error) {
+		for id, errs := range objects {
+			for _, err := range errs {
+				problems = append(problems, define.SystemCheckProblem{Type: objType, ID: id, Problem: err.Error()})
+			}
+		}
+	}
+	addErrors(define.CheckTypeLayer, storageReport.Layers)
+	addErrors(define.CheckTypeLayer, storageReport.ROLayers)
+	addErrors(define.CheckTypeImage, storageReport.Images)
+	addErrors(define.CheckTypeImage, storageReport.ROImages)
+	addErrors(define.CheckTypeStorageContainer, storageReport.Containers)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	repaired := false
+	if check.options.Repair || check.options.RepairLossy {
+		// Containers known to libpod are removed from the state as
+		// well, so the state does not point to missing storage.
+		var removeCtrs []*Container
+		if check.options.RepairLossy {
+			for id := range storageReport.Containers {
+				ctr, err := r.state.Container(id)
+				if err == nil {
+					removeCtrs = append(removeCtrs, ctr)
+				}
+			}
+		}
+		repairOptions := &storage.RepairOptions{RemoveContainers: check.options.RepairLossy}
+		if errs := r.store.Repair(storageReport, repairOptions); len(errs) > 0 {
+			for _, err := range errs {
+				logrus.Errorf("Repairing storage: %v", err)
+			}
+		} else {
+			repaired = true
+		}
+		for _, ctr := range removeCtrs {
+			if err := r.RemoveContainer(ctx, ctr, true, false, nil); err != nil && !errors.Is(err, define.ErrNoSuchCtr) {
+				logrus.Errorf("Removing container %s with damaged storage: %v", ctr.ID(), err)
+			}
+		}
+	}
+
+	for _, p := range problems {
+		// Damaged containers are only removed by lossy repairs.
+		p.Repaired = repaired && (p.Type != define.CheckTypeStorageContainer || check.options.RepairLossy)
+		if !p.Repaired {
+			check.report.Errors = true
+		}
+		check.report.Problems = append(check.report.Problems, p)
+	}
+	return nil
+}
+
+// checkContainerStorage compares the containers in the state with the
+// containers in containers/storage.
+func (r *Runtime) checkContainerStorage(ctx context.Context, check *systemCheck) error {
+	ctrs, err := r.state.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		// Containers with a user-supplied rootfs have no storage.
+		if ctr.config.Rootfs != "" {
+			continue
+		}
+		if _, err := r.store.Container(ctr.ID()); err != nil {
+			if !errors.Is(err, storage.ErrContainerUnknown) {
+				return fmt.Errorf("looking up storage of container %s: %w", ctr.ID(), err)
+			}
+			check.add(define.CheckTypeContainer, ctr.ID(), "storage container is missing", true, func() error {
+				return r.RemoveContainer(ctx, ctr, true, false, nil)
+			})
+		}
+	}
+
+	// Storage containers used by image volumes are not dangling.
+	vols, err := r.state.AllVolumes()
+	if err != nil {
+		return err
+	}
+	volumeStorage := make(map[string]bool)
+	for _, vol := range vols {
+		if vol.config.StorageID != "" {
+			volumeStorage[vol.config.StorageID] = true
+		}
+	}
+
+	storageCtrs, err := r.StorageContainers()
+	if err != nil {
+		return err
+	}
+	for _, storageCtr := range storageCtrs {
+		if volumeStorage[storageCtr.ID] {
+			continue
+		}
+		isBuildah, err := r.IsBuildahContainer(storageCtr.ID)
+		if err != nil {
+			return fmt.Errorf("checking if storage container %s is a build container: %w", storageCtr.ID, err)
+		}
+		if isBuildah {
+			continue
+		}
+		// The container may be owned by another tool using the same
+		// storage, such as CRI-O, so only remove it with lossy repairs.
+		id := storageCtr.ID
+		check.add(define.CheckTypeStorageContainer, id, "storage container is not known to libpod", true, func() error {
+			return r.RemoveStorageContainer(id, true)
+		})
+	}
+	return nil
+}
+
+// checkVolumes makes sure that the mount points of local volumes exist.
+func (r *Runtime) checkVolumes(ctx context.Context, check *systemCheck) error {
+	vols, err := r.state.AllVolumes()
+	if err != nil {
+		return err
+	}
+	for _, vol := range vols {
+		if vol.UsesVolumeDriver() || vol.config.Driver == define.VolumeDriverImage || vol.config.MountPoint == "" {
+			continue
+		}
+		if _, err := os.Stat(vol.config.MountPoint); err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("checking mount point of volume %s: %w", vol.Name(), err)
+			}
+			check.add(define.CheckTypeVolume, vol.Name(), fmt.Sprintf("mount point %s is missing", vol.config.MountPoint), false, func() error {
+				return recreateVolumeMountPoint(vol)
+			})
+		}
+	}
+	return nil
+}
+
+// recreateVolumeMountPoint re-creates the missing mount point of a local
+// volume, the same way as it is created when the volume is created.
+func recreateVolumeMountPoint(vol *Volume) error {
+	volPathRoot := filepath.Dir(vol.config.MountPoint)
+	if err := os.MkdirAll(volPathRoot, 0o700); err != nil {
+		return err
+	}
+	if err := idtools.SafeChown(volPathRoot, vol.config.UID, vol.config.GID); err != nil {
+		return err
+	}
+	if err := os.Mkdir(vol.config.MountPoint, 0o755); err != nil {
+		return err
+	}
+	if err := idtools.SafeChown(vol.config.MountPoint, vol.config.UID, vol.config.GID); err != nil {
+		return err
+	}
+	return LabelVolumePath(vol.config.MountPoint, vol.config.MountLabel)
+}
+
+// checkLocks looks for locks shared by several objects and for allocated
+// locks not used by any object.
+func (r *Runtime) checkLocks(ctx context.Context, check *systemCheck) error {
+	conflicts, _, err := r.LockConflicts()
+	if err != nil {
+		return err
+	}
+	lockNums := make([]uint32, 0, len(conflicts))
+	for lockNum := range conflicts {
+		lockNums = append(lockNums, lockNum)
+	}
+	slices.Sort(lockNums)
+	for _, lockNum := range lockNums {
+		check.add(define.CheckTypeLock, strconv.FormatUint(uint64(lockNum), 10),
+			fmt.Sprintf("lock is shared by %s, run podman system renumber", strings.Join(conflicts[lockNum], ", ")), false, nil)
+	}
+
+	free, err := r.lockManager.AvailableLocks()
+	if err != nil {
+		if errors.Is(err, define.ErrNotImplemented) {
+			return nil
+		}
+		return err
+	}
+	if free == nil {
+		return nil
+	}
+	used, err := r.usedLocks()
+	if err != nil {
+		return err
+	}
+	allocated := r.config.Engine.NumLocks - *free
+	if allocated > used {
+		check.add(define.CheckTypeLock, "", fmt.Sprintf("%d locks are allocated but not used by any container, pod or volume, run podman system renumber", allocated-used), false, nil)
+	}
+	return nil
+}
+
+// usedLocks returns the number of distinct locks used by containers, pods
+// and volumes.
+func (r *Runtime) usedLocks() (uint32, error) {
+	locks := make(map[uint32]bool)
+	ctrs, err := r.state.AllContainers(false)
+	if err != nil {
+		return 0, err
+	}
+	for _, ctr := range ctrs {
+		locks[ctr.config.LockID] = true
+	}
+	pods, err := r.state.AllPods()
+	if err != nil {
+		return 0, err
+	}
+	for _, pod := range pods {
+		locks[pod.config.LockID] = true
+	}
+	vols, err := r.state.AllVolumes()
+	if err != nil {
+		return 0, err
+	}
+	for _, vol := range vols {
+		locks[vol.config.LockID] = true
+	}
+	return uint32(len(locks)), nil
+}
+
+// checkNetworks makes sure that all networks containers are connected to
+// exist.
+func (r *Runtime) checkNetworks(ctx context.Context, check *systemCheck) error {
+	ctrs, err := r.state.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	missing := make(map[string][]string)
+	for _, ctr := range ctrs {
+		networks, err := ctr.networks()
+		if err != nil {
+			return fmt.Errorf("retrieving networks of container %s: %w", ctr.ID(), err)
+		}
+		for name := range networks {
+			if _, ok := missing[name]; !ok {
+				if _, err := r.network.NetworkInspect(name); err == nil {
+					continue
+				} else if !errors.Is(err, nettypes.ErrNoSuchNetwork) {
+					return fmt.Errorf("inspecting network %s: %w", name, err)
+				}
+			}
+			missing[name] = append(missing[name], ctr.ID())
+		}
+	}
+	names := make([]string, 0, len(missing))
+	for name := range missing {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		check.add(define.CheckTypeNetwork, name,
+			fmt.Sprintf("network does not exist but containers %s are connected to it, re-create the network", strings.Join(missing[name], ", ")), false, nil)
+	}
+	return nil
+}
@@ -87,13 +87,24 @@ func (r *Runtime) RestoreContainer(ctx context.Context, rSpec *spec.Spec, config
 		return nil, define.ErrRuntimeStopped
 	}
 
-	ctr, err := r.initContainerVariables(rSpec, config)
+	ctr, err := r.initRestoredContainer(rSpec, config)
 	if err != nil {
-		return nil, fmt.Errorf("initializing container variables: %w", err)
+		return nil, err
 	}
 	// For an imported checkpoint no one has ever set the StartedTime. Set it now.
 	ctr.state.StartedTime = time.Now()
 
+	return r.setupContainer(ctx, ctr)
+}
+
+// initRestoredContainer initializes a container from an existing
+// configuration, for instance one of an imported checkpoint.
+func (r *Runtime) initRestoredContainer(rSpec *spec.Spec, config *ContainerConfig) (*Container, error) {
+	ctr, err := r.initContainerVariables(rSpec, config)
+	if err != nil {
+		return nil, fmt.Errorf("initializing container variables: %w", err)
+	}
+
 	// If the path to ConmonPidFile starts with the default value (RunRoot), then
 	// the user has not specified '--conmon-pidfile' during run or create (probably).
 	// In that case reset ConmonPidFile to be set to the default value later.
@@ -108,7 +119,7 @@ func (r *Runtime) RestoreContainer(ctx context.Context, rSpec *spec.Spec, config
 		ctr.config.PidFile = ""
 	}
 
-	return r.setupContainer(ctx, ctr)
+	return ctr, nil
 }
 
 // RenameContainer renames the given container.
@@ -298,10 +309,29 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 		ctr.config.Networks = normalizeNetworks
 	}
 
+	// egress rules and network rates must use the normalized names as well
+	if ctr.config.NetMode.IsBridge() && ctr.hasEgressOptions() {
+		options, err := r.normalizeEgressOptions(ctr.config.NetworkOptions, ctr.config.Networks)
+		if err != nil {
+			return nil, err
+		}
+		ctr.config.NetworkOptions = options
+	}
+	if len(ctr.config.NetworkRates) > 0 {
+		rates, err := r.normalizeNetworkRates(ctr.config.NetworkRates, ctr.config.Networks)
+		if err != nil {
+			return nil, err
+		}
+		ctr.config.NetworkRates = rates
+	}
+
 	// Validate the container
 	if err := ctr.validate(); err != nil {
 		return nil, err
 	}
+	if err := ctr.validateNetAdminCapability(); err != nil {
+		return nil, err
+	}
 	if ctr.config.IsInfra {
 		ctr.config.StopTimeout = 10
 	}
@@ -1334,6 +1364,65 @@ func (r *Runtime) GetExecSessionContainer(id string) (*Container, error) {
 	return r.state.Container(ctrID)
 }
 
+// LookupExecSession looks up an exec session by its name, full ID or a unique
+// partial ID. It returns the container of the exec session and the full ID of
+// the session.
+func (r *Runtime) LookupExecSession(nameOrID string) (*Container, string, error) {
+	if !r.valid {
+		return nil, "", define.ErrRuntimeStopped
+	}
+
+	if ctr, err := r.GetExecSessionContainer(nameOrID); err == nil {
+		return ctr, nameOrID, nil
+	} else if !errors.Is(err, define.ErrNoSuchExecSession) {
+		return nil, "", err
+	}
+
+	ctr, sessionID, err := r.lookupExecSessionName(nameOrID)
+	if !errors.Is(err, define.ErrNoSuchExecSession) {
+		return ctr, sessionID, err
+	}
+
+	ctrs, err := r.state.AllContainers(true)
+	if err != nil {
+		return nil, "", err
+	}
+	var matches int
+	for _, c := range ctrs {
+		for id := range c.state.ExecSessions {
+			if strings.HasPrefix(id, nameOrID) {
+				ctr, sessionID = c, id
+				matches++
+			}
+		}
+	}
+	switch matches {
+	case 0:
+		return nil, "", fmt.Errorf("no exec session with name or ID %s found: %w", nameOrID, define.ErrNoSuchExecSession)
+	case 1:
+		return ctr, sessionID, nil
+	default:
+		return nil, "", fmt.Errorf("more than one exec session matches ID %s: %w", nameOrID, define.ErrInvalidArg)
+	}
+}
+
+// lookupExecSessionName returns the exec session with the given name which
+// has not stopped yet.
+func (r *Runtime) lookupExecSessionName(name string) (*Container, string, error) {
+	ctrs, err := r.state.AllContainers(true)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, ctr := range ctrs {
+		for id, session := range ctr.state.ExecSessions {
+			if session.Config != nil && session.Config.Name == name && session.State != define.ExecStateStopped {
+				return ctr, id, nil
+			}
+		}
+	}
+	return nil, "", fmt.Errorf("no exec session with name %s found: %w", name, define.ErrNoSuchExecSession)
+}
+
 // PruneContainers removes stopped and exited containers from localstorage.  A set of optional filters
 // can be provided to be more granular.
 func (r *Runtime) PruneContainers(filterFuncs []ContainerFilter) ([]*reports.PruneReport, error) {
@@ -0,0 +1,118 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/containers/common/pkg/hooks"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/rootless"
+)
+
+// ociHookExtensionStages are the hook stages run by Podman itself rather
+// than the OCI runtime.
+var ociHookExtensionStages = []string{"precreate", "poststop"}
+
+// HooksDirs returns the directories the OCI hooks are read from, in
+// increasing order of precedence.
+func (r *Runtime) HooksDirs() []string {
+	if dirs := r.config.Engine.HooksDir.Get(); len(dirs) > 0 {
+		return dirs
+	}
+	if rootless.IsRootless() {
+		return nil
+	}
+	return []string{hooks.DefaultDir, hooks.OverrideDir}
+}
+
+// OCIHooks returns the OCI hooks of the hooks directories, sorted by name.  A
+// hook overrides the hooks with the same name in the directories of lower
+// precedence.  Hooks which cannot be read are returned with their error set.
+func (r *Runtime) OCIHooks() ([]*define.OCIHook, error) {
+	byName := make(map[string]*define.OCIHook)
+	for _, dir := range r.HooksDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("reading hooks directory: %w", err)
+		}
+		for _, entry := range entries {
+			if !strings.HasSuffix(entry.Name(), ".json") {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			ociHook := ReadOCIHook(path)
+			if ociHook == nil {
+				// dangling symlink, ignored like when starting
+				// containers
+				continue
+			}
+			byName[entry.Name()] = ociHook
+		}
+	}
+
+	ociHooks := make([]*define.OCIHook, 0, len(byName))
+	for _, ociHook := range byName {
+		ociHooks = append(ociHooks, ociHook)
+	}
+	// same order as the hooks are run in
+	sort.Slice(ociHooks, func(i, j int) bool {
+		return strings.ToLower(ociHooks[i].Name) < strings.ToLower(ociHooks[j].Name)
+	})
+	return ociHooks, nil
+}
+
+// ReadOCIHook reads and validates the OCI hook configuration file at path.
+// It returns nil if the file does not exist.
+func ReadOCIHook(path string) *define.OCIHook {
+	ociHook := &define.OCIHook{
+		Name: filepath.Base(path),
+		Path: path,
+	}
+	hook, err := hooks.Read(path, ociHookExtensionStages)
+	if err != nil {
+		var pathErr *fs.PathError
+		if errors.As(err, &pathErr) && pathErr.Path == path && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		ociHook.Error = err.Error()
+	}
+	if hook != nil {
+		ociHook.Stages = hook.Stages
+		ociHook.Hook = &hook.Hook
+		ociHook.When = &hook.When
+	}
+	return ociHook
+}
+
+// OCIHooks returns the OCI hooks the container uses, according to the
+// current content of the hooks directories.
+func (c *Container) OCIHooks() ([]*define.OCIHook, error) {
+	ociHooks, err := c.runtime.OCIHooks()
+	if err != nil {
+		return nil, err
+	}
+	matched := make([]*define.OCIHook, 0, len(ociHooks))
+	for _, ociHook := range ociHooks {
+		if ociHook.Error != "" {
+			continue
+		}
+		match, err := ociHook.When.Match(c.config.Spec, c.config.Spec.Annotations, len(c.config.UserVolumes) > 0)
+		if err != nil {
+			return nil, fmt.Errorf("matching hook %q: %w", ociHook.Name, err)
+		}
+		if match {
+			matched = append(matched, ociHook)
+		}
+	}
+	return matched, nil
+}
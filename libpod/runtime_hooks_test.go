@@ -0,0 +1,37 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOCIHook(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	err := os.WriteFile(valid, []byte(`{"version": "1.0.0", "hook": {"path": "/bin/true"}, "when": {"commands": ["^/bin/sh$"]}, "stages": ["prestart", "poststop"]}`), 0o644)
+	require.NoError(t, err)
+	ociHook := ReadOCIHook(valid)
+	require.NotNil(t, ociHook)
+	assert.Equal(t, "valid.json", ociHook.Name)
+	assert.Equal(t, valid, ociHook.Path)
+	assert.Empty(t, ociHook.Error)
+	assert.Equal(t, []string{"prestart", "poststop"}, ociHook.Stages)
+	assert.Equal(t, "/bin/true", ociHook.Hook.Path)
+	assert.Equal(t, []string{"^/bin/sh$"}, ociHook.When.Commands)
+
+	invalid := filepath.Join(dir, "invalid.json")
+	err = os.WriteFile(invalid, []byte(`{"version": "1.0.0", "hook": {"path": "/bin/true"}, "when": {"always": true}, "stages": ["prestop"]}`), 0o644)
+	require.NoError(t, err)
+	ociHook = ReadOCIHook(invalid)
+	require.NotNil(t, ociHook)
+	assert.Contains(t, ociHook.Error, `unknown stage "prestop"`)
+
+	assert.Nil(t, ReadOCIHook(filepath.Join(dir, "missing.json")))
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	buildahDefine "github.com/containers/buildah/define"
 	"github.com/containers/buildah/imagebuildah"
@@ -16,6 +17,7 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/libpod/events"
 	"github.com/containers/podman/v5/pkg/util"
+	"github.com/containers/storage"
 	"github.com/sirupsen/logrus"
 )
 
@@ -105,6 +107,55 @@ func (r *Runtime) IsExternalContainerCallback(_ context.Context) libimage.IsExte
 	}
 }
 
+// imageLastUsedKey is the key of the big data item in containers/storage
+// recording when an image was last used to create a container.
+const imageLastUsedKey = "podman-last-used"
+
+// markImageUsed records the current time as the last time the image has been
+// used to create a container.  Failures are not fatal as the image may live in
+// a read-only additional image store.
+func (r *Runtime) markImageUsed(imageID string) {
+	data, err := time.Now().MarshalText()
+	if err == nil {
+		err = r.store.SetImageBigData(imageID, imageLastUsedKey, data, nil)
+	}
+	if err != nil {
+		logrus.Debugf("Recording last use of image %s: %v", imageID, err)
+	}
+}
+
+// ImageLastUsed returns the time the specified image was last used to create
+// a container.  The zero time is returned if it has never been recorded.
+func (r *Runtime) ImageLastUsed(imageID string) (time.Time, error) {
+	var lastUsed time.Time
+	data, err := r.store.ImageBigData(imageID, imageLastUsedKey)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return lastUsed, nil
+		}
+		return lastUsed, fmt.Errorf("reading last use of image %s: %w", imageID, err)
+	}
+	if err := lastUsed.UnmarshalText(data); err != nil {
+		return lastUsed, fmt.Errorf("parsing last use of image %s: %w", imageID, err)
+	}
+	return lastUsed, nil
+}
+
+// ImageLayers returns the storage layers of the specified image, starting at
+// its top layer and ending with its base layer.
+func (r *Runtime) ImageLayers(img *libimage.Image) ([]*storage.Layer, error) {
+	var layers []*storage.Layer
+	for layerID := img.TopLayer(); layerID != ""; {
+		layer, err := r.store.Layer(layerID)
+		if err != nil {
+			return nil, fmt.Errorf("looking up layer %s of image %s: %w", layerID, img.ID(), err)
+		}
+		layers = append(layers, layer)
+		layerID = layer.Parent
+	}
+	return layers, nil
+}
+
 // newBuildEvent creates a new event based on completion of a built image
 func (r *Runtime) newImageBuildCompleteEvent(idOrName string) {
 	e := events.NewEvent(events.Build)
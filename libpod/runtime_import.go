@@ -0,0 +1,143 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/libpod/events"
+	"github.com/sirupsen/logrus"
+)
+
+// ImportPod re-creates a pod from an existing configuration, for instance one
+// exported by `podman system export`.  The pod keeps its ID and name.  The
+// infra container of the pod must be imported via ImportContainers.
+func (r *Runtime) ImportPod(ctx context.Context, config *PodConfig) (_ *Pod, deferredErr error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	pod := newPod(r)
+	if err := JSONDeepCopy(config, pod.config); err != nil {
+		return nil, fmt.Errorf("copying pod config for import: %w", err)
+	}
+	if pod.config.Labels == nil {
+		pod.config.Labels = make(map[string]string)
+	}
+	if r.config.Engine.Namespace != "" {
+		pod.config.Namespace = r.config.Engine.Namespace
+	}
+
+	lock, err := r.lockManager.AllocateLock()
+	if err != nil {
+		return nil, fmt.Errorf("allocating lock for imported pod: %w", err)
+	}
+	pod.lock = lock
+	pod.config.LockID = pod.lock.ID()
+
+	defer func() {
+		if deferredErr != nil {
+			if err := pod.lock.Free(); err != nil {
+				logrus.Errorf("Freeing pod lock after failed import: %v", err)
+			}
+		}
+	}()
+
+	pod.valid = true
+
+	if _, err := r.platformMakePod(pod, &pod.config.ResourceLimits); err != nil {
+		return nil, err
+	}
+
+	if err := r.state.AddPod(pod); err != nil {
+		return nil, fmt.Errorf("adding pod to state: %w", err)
+	}
+
+	// The create event of pods with an infra container is written once the
+	// infra container has been added.
+	if !pod.config.HasInfra {
+		pod.newPodEvent(events.Create)
+	}
+	return pod, nil
+}
+
+// ImportContainers re-creates containers from existing configurations, for
+// instance ones exported by `podman system export`.  The containers keep their
+// IDs and names and are not started.  They are created in the order of their
+// dependencies which must either be part of configs or exist already.  Infra
+// containers are added to their pods which must have been imported before.
+func (r *Runtime) ImportContainers(ctx context.Context, configs []*ContainerConfig) ([]*Container, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	var imported []*Container
+	pending := configs
+	for len(pending) > 0 {
+		var next []*ContainerConfig
+		for _, config := range pending {
+			ready, err := r.importDependenciesReady(config)
+			if err != nil {
+				return imported, err
+			}
+			if !ready {
+				next = append(next, config)
+				continue
+			}
+			ctr, err := r.importContainer(ctx, config)
+			if err != nil {
+				return imported, fmt.Errorf("importing container %s: %w", config.Name, err)
+			}
+			imported = append(imported, ctr)
+		}
+		if len(next) == len(pending) {
+			names := make([]string, 0, len(next))
+			for _, config := range next {
+				names = append(names, config.Name)
+			}
+			return imported, fmt.Errorf("importing containers %s: dependencies are missing: %w", strings.Join(names, ", "), define.ErrNoSuchCtr)
+		}
+		pending = next
+	}
+	return imported, nil
+}
+
+// importDependenciesReady returns true if all dependencies of the container
+// described by config exist.
+func (r *Runtime) importDependenciesReady(config *ContainerConfig) (bool, error) {
+	ctr := &Container{config: config}
+	for _, dep := range ctr.Dependencies() {
+		exists, err := r.state.HasContainer(dep)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (r *Runtime) importContainer(ctx context.Context, config *ContainerConfig) (*Container, error) {
+	ctr, err := r.initRestoredContainer(config.Spec, config)
+	if err != nil {
+		return nil, err
+	}
+	ctr, err = r.setupContainer(ctx, ctr)
+	if err != nil {
+		return nil, err
+	}
+	if ctr.config.IsInfra {
+		pod, err := r.state.Pod(ctr.config.Pod)
+		if err != nil {
+			return nil, fmt.Errorf("looking up pod of infra container %s: %w", ctr.ID(), err)
+		}
+		if _, err := r.AddInfra(ctx, pod, ctr); err != nil {
+			return nil, err
+		}
+	}
+	return ctr, nil
+}
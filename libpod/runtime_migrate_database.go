@@ -0,0 +1,493 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/rootless"
+	"github.com/containers/storage/pkg/homedir"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// dbBackendDropIn is the containers.conf drop-in file written by
+	// MigrateDatabase to switch the database backend.
+	dbBackendDropIn = "99-podman-database-backend.conf"
+	// boltStateMigratedSuffix is appended to the BoltDB database file once
+	// it has been migrated, so it is not picked up again.
+	boltStateMigratedSuffix = ".migrated"
+	// sqliteRolledBackSuffix is appended to the SQLite database file when
+	// a migration is rolled back.
+	sqliteRolledBackSuffix = ".rolled-back"
+)
+
+// dbMigrationStats counts the objects copied by a database migration.
+type dbMigrationStats struct {
+	containers   int
+	pods         int
+	volumes      int
+	execSessions int
+	exitCodes    int
+}
+
+// MigrateDatabase copies all containers, pods, volumes, exec sessions and
+// exit codes from the BoltDB database into a new SQLite database, verifies
+// the copy and switches the database backend in containers.conf.
+// The BoltDB database is kept as backup so the migration can be undone with
+// RollbackDatabaseMigration.
+// If dryRun is set, the copy is verified and discarded.
+func (r *Runtime) MigrateDatabase(backend string, dryRun bool) (retErr error) {
+	target, err := config.ParseDBBackend(backend)
+	if err != nil {
+		return err
+	}
+	if target != config.DBBackendSQLite {
+		return fmt.Errorf("migrating to database backend %q is not supported, only %q is: %w", backend, config.DBBackendSQLite.String(), define.ErrInvalidArg)
+	}
+
+	// Acquire the alive lock and hold it.
+	// Ensures that we don't let other Podman commands run while we are
+	// copying the DB.
+	aliveLock, err := r.getRuntimeAliveLock()
+	if err != nil {
+		return fmt.Errorf("retrieving alive lock: %w", err)
+	}
+	aliveLock.Lock()
+	defer aliveLock.Unlock()
+
+	if !r.valid {
+		return define.ErrRuntimeStopped
+	}
+
+	if _, ok := r.state.(*BoltState); !ok {
+		return fmt.Errorf("the database backend is already %q, nothing to migrate: %w", r.config.Engine.DBBackend, define.ErrInvalidArg)
+	}
+
+	sqliteDir := sqliteStateDir(r)
+	sqlitePath := filepath.Join(sqliteDir, sqliteDBFile)
+	if _, err := os.Stat(sqlitePath); err == nil {
+		return fmt.Errorf("SQLite database %s already exists, remove it before migrating: %w", sqlitePath, define.ErrInvalidArg)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	// Build the new database in a staging directory, so nothing changes
+	// until it has been verified.
+	if err := os.MkdirAll(sqliteDir, 0o700); err != nil {
+		return err
+	}
+	stagingDir, err := os.MkdirTemp(sqliteDir, "db-migration")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(stagingDir); err != nil {
+			logrus.Errorf("Removing database migration directory %s: %v", stagingDir, err)
+		}
+	}()
+
+	newState, err := newSqliteState(r, stagingDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if newState.valid {
+			if err := newState.Close(); err != nil {
+				logrus.Errorf("Closing SQLite database: %v", err)
+			}
+		}
+	}()
+	if err := newState.ValidateDBConfig(r); err != nil {
+		return err
+	}
+
+	stats, err := r.copyStateToSQLite(newState)
+	if err != nil {
+		return fmt.Errorf("copying database: %w", err)
+	}
+	if err := r.verifyMigratedState(newState); err != nil {
+		return fmt.Errorf("verifying migrated database: %w", err)
+	}
+
+	fmt.Printf("Copied %d containers, %d pods, %d volumes, %d exec sessions and %d exit codes\n",
+		stats.containers, stats.pods, stats.volumes, stats.execSessions, stats.exitCodes)
+	if dryRun {
+		fmt.Println("Dry run: the migrated database has been verified and discarded")
+		return nil
+	}
+
+	if err := newState.Close(); err != nil {
+		return fmt.Errorf("closing SQLite database: %w", err)
+	}
+
+	dropIn, err := dbBackendDropInPath()
+	if err != nil {
+		return err
+	}
+	boltPath := boltStatePath(r)
+
+	// Roll back all steps done so far on failure.
+	var undo []func() error
+	defer func() {
+		if retErr == nil {
+			return
+		}
+		for i := len(undo) - 1; i >= 0; i-- {
+			if err := undo[i](); err != nil {
+				logrus.Errorf("Rolling back database migration: %v", err)
+			}
+		}
+	}()
+
+	if err := os.Rename(filepath.Join(stagingDir, sqliteDBFile), sqlitePath); err != nil {
+		return fmt.Errorf("moving SQLite database into place: %w", err)
+	}
+	undo = append(undo, func() error { return os.Remove(sqlitePath) })
+
+	if err := os.Rename(boltPath, boltPath+boltStateMigratedSuffix); err != nil {
+		return fmt.Errorf("moving BoltDB database aside: %w", err)
+	}
+	undo = append(undo, func() error { return os.Rename(boltPath+boltStateMigratedSuffix, boltPath) })
+
+	if err := writeDBBackendDropIn(dropIn, config.DBBackendSQLite); err != nil {
+		return err
+	}
+	fmt.Printf("Database backend switched to %q in %s\n", config.DBBackendSQLite.String(), dropIn)
+	if path := os.Getenv("CONTAINERS_CONF"); path != "" {
+		logrus.Warnf("CONTAINERS_CONF is set, drop-in files are ignored: set database_backend = %q in the [engine] table of %s", config.DBBackendSQLite.String(), path)
+	}
+
+	running, err := r.GetRunningContainers()
+	if err != nil {
+		return err
+	}
+	for _, ctr := range running {
+		logrus.Warnf("Container %s is running, restart it to use the new database backend for its cleanup", ctr.ID())
+	}
+
+	return nil
+}
+
+// RollbackDatabaseMigration undoes MigrateDatabase: the BoltDB database is
+// restored and the SQLite database is moved aside. Changes done after the
+// migration are lost.
+func (r *Runtime) RollbackDatabaseMigration() error {
+	aliveLock, err := r.getRuntimeAliveLock()
+	if err != nil {
+		return fmt.Errorf("retrieving alive lock: %w", err)
+	}
+	aliveLock.Lock()
+	defer aliveLock.Unlock()
+
+	if !r.valid {
+		return define.ErrRuntimeStopped
+	}
+
+	boltPath := boltStatePath(r)
+	if _, err := os.Stat(boltPath + boltStateMigratedSuffix); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("no migrated BoltDB database found at %s, nothing to roll back: %w", boltPath+boltStateMigratedSuffix, define.ErrInvalidArg)
+		}
+		return err
+	}
+	if _, err := os.Stat(boltPath); err == nil {
+		return fmt.Errorf("BoltDB database %s already exists, refusing to overwrite it: %w", boltPath, define.ErrInvalidArg)
+	}
+
+	sqlitePath := filepath.Join(sqliteStateDir(r), sqliteDBFile)
+	if err := os.Rename(sqlitePath, sqlitePath+sqliteRolledBackSuffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("moving SQLite database aside: %w", err)
+	}
+	if err := os.Rename(boltPath+boltStateMigratedSuffix, boltPath); err != nil {
+		return fmt.Errorf("restoring BoltDB database: %w", err)
+	}
+
+	dropIn, err := dbBackendDropInPath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(dropIn); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	fmt.Printf("Restored BoltDB database %s, the SQLite database has been moved to %s\n", boltPath, sqlitePath+sqliteRolledBackSuffix)
+	return nil
+}
+
+// copyStateToSQLite copies the content of the current state into dest.
+func (r *Runtime) copyStateToSQLite(dest *SQLiteState) (*dbMigrationStats, error) {
+	stats := new(dbMigrationStats)
+
+	vols, err := r.state.AllVolumes()
+	if err != nil {
+		return nil, err
+	}
+	for _, vol := range vols {
+		if err := dest.AddVolume(vol); err != nil {
+			return nil, fmt.Errorf("adding volume %s: %w", vol.Name(), err)
+		}
+		stats.volumes++
+	}
+
+	pods, err := r.state.AllPods()
+	if err != nil {
+		return nil, err
+	}
+	podsByID := make(map[string]*Pod, len(pods))
+	for _, pod := range pods {
+		if err := r.state.UpdatePod(pod); err != nil {
+			return nil, fmt.Errorf("retrieving state of pod %s: %w", pod.ID(), err)
+		}
+		if err := dest.AddPod(pod); err != nil {
+			return nil, fmt.Errorf("adding pod %s: %w", pod.ID(), err)
+		}
+		podsByID[pod.ID()] = pod
+		stats.pods++
+	}
+
+	ctrs, err := r.migratedContainers()
+	if err != nil {
+		return nil, err
+	}
+	// Containers must be added after their dependencies.
+	added := make(map[string]bool, len(ctrs))
+	for len(added) < len(ctrs) {
+		progress := false
+		for _, ctr := range ctrs {
+			if added[ctr.ID()] {
+				continue
+			}
+			if slices.ContainsFunc(ctr.Dependencies(), func(dep string) bool { return !added[dep] }) {
+				continue
+			}
+			if ctr.config.Pod != "" {
+				pod, ok := podsByID[ctr.config.Pod]
+				if !ok {
+					return nil, fmt.Errorf("pod %s of container %s not found: %w", ctr.config.Pod, ctr.ID(), define.ErrNoSuchPod)
+				}
+				err = dest.AddContainerToPod(pod, ctr)
+			} else {
+				err = dest.AddContainer(ctr)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("adding container %s: %w", ctr.ID(), err)
+			}
+			added[ctr.ID()] = true
+			progress = true
+			stats.containers++
+		}
+		if !progress {
+			return nil, fmt.Errorf("containers have unresolvable dependencies: %w", define.ErrNoSuchCtr)
+		}
+	}
+
+	for _, ctr := range ctrs {
+		sessions, err := r.state.GetContainerExecSessions(ctr)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving exec sessions of container %s: %w", ctr.ID(), err)
+		}
+		for _, id := range sessions {
+			if err := dest.AddExecSession(ctr, &ExecSession{Id: id}); err != nil {
+				return nil, fmt.Errorf("adding exec session %s of container %s: %w", id, ctr.ID(), err)
+			}
+			stats.execSessions++
+		}
+
+		exitCode, err := r.state.GetContainerExitCode(ctr.ID())
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchExitCode) {
+				continue
+			}
+			return nil, fmt.Errorf("retrieving exit code of container %s: %w", ctr.ID(), err)
+		}
+		if err := dest.AddContainerExitCode(ctr.ID(), exitCode); err != nil {
+			return nil, fmt.Errorf("adding exit code of container %s: %w", ctr.ID(), err)
+		}
+		stats.exitCodes++
+	}
+
+	return stats, nil
+}
+
+// migratedContainers returns all containers of the current state with their
+// networks in the configuration, as the SQLite state stores them there.
+func (r *Runtime) migratedContainers() ([]*Container, error) {
+	ctrs, err := r.state.AllContainers(true)
+	if err != nil {
+		return nil, err
+	}
+	migrated := make([]*Container, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		networks, err := r.state.GetNetworks(ctr)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving networks of container %s: %w", ctr.ID(), err)
+		}
+		ctrConfig := new(ContainerConfig)
+		if err := JSONDeepCopy(ctr.config, ctrConfig); err != nil {
+			return nil, err
+		}
+		ctrConfig.Networks = networks
+
+		migrated = append(migrated, &Container{
+			config:  ctrConfig,
+			state:   ctr.state,
+			runtime: r,
+			valid:   true,
+		})
+	}
+	return migrated, nil
+}
+
+// verifyMigratedState compares the content of dest with the current state.
+func (r *Runtime) verifyMigratedState(dest *SQLiteState) error {
+	srcCtrs, err := r.migratedContainers()
+	if err != nil {
+		return err
+	}
+	destCtrs, err := dest.AllContainers(true)
+	if err != nil {
+		return err
+	}
+	if len(srcCtrs) != len(destCtrs) {
+		return fmt.Errorf("found %d containers, expected %d", len(destCtrs), len(srcCtrs))
+	}
+	destCtrsByID := make(map[string]*Container, len(destCtrs))
+	for _, ctr := range destCtrs {
+		destCtrsByID[ctr.ID()] = ctr
+	}
+	for _, ctr := range srcCtrs {
+		destCtr, ok := destCtrsByID[ctr.ID()]
+		if !ok {
+			return fmt.Errorf("container %s is missing", ctr.ID())
+		}
+		if err := compareJSON(ctr.config, destCtr.config); err != nil {
+			return fmt.Errorf("config of container %s: %w", ctr.ID(), err)
+		}
+		if err := compareJSON(ctr.state, destCtr.state); err != nil {
+			return fmt.Errorf("state of container %s: %w", ctr.ID(), err)
+		}
+		srcSessions, err := r.state.GetContainerExecSessions(ctr)
+		if err != nil {
+			return err
+		}
+		destSessions, err := dest.GetContainerExecSessions(destCtr)
+		if err != nil {
+			return err
+		}
+		slices.Sort(srcSessions)
+		slices.Sort(destSessions)
+		if !slices.Equal(srcSessions, destSessions) {
+			return fmt.Errorf("exec sessions of container %s differ", ctr.ID())
+		}
+	}
+
+	srcPods, err := r.state.AllPods()
+	if err != nil {
+		return err
+	}
+	destPods, err := dest.AllPods()
+	if err != nil {
+		return err
+	}
+	if len(srcPods) != len(destPods) {
+		return fmt.Errorf("found %d pods, expected %d", len(destPods), len(srcPods))
+	}
+	destPodsByID := make(map[string]*Pod, len(destPods))
+	for _, pod := range destPods {
+		destPodsByID[pod.ID()] = pod
+	}
+	for _, pod := range srcPods {
+		destPod, ok := destPodsByID[pod.ID()]
+		if !ok {
+			return fmt.Errorf("pod %s is missing", pod.ID())
+		}
+		if err := compareJSON(pod.config, destPod.config); err != nil {
+			return fmt.Errorf("config of pod %s: %w", pod.ID(), err)
+		}
+	}
+
+	srcVols, err := r.state.AllVolumes()
+	if err != nil {
+		return err
+	}
+	destVols, err := dest.AllVolumes()
+	if err != nil {
+		return err
+	}
+	if len(srcVols) != len(destVols) {
+		return fmt.Errorf("found %d volumes, expected %d", len(destVols), len(srcVols))
+	}
+	destVolsByName := make(map[string]*Volume, len(destVols))
+	for _, vol := range destVols {
+		destVolsByName[vol.Name()] = vol
+	}
+	for _, vol := range srcVols {
+		destVol, ok := destVolsByName[vol.Name()]
+		if !ok {
+			return fmt.Errorf("volume %s is missing", vol.Name())
+		}
+		if err := compareJSON(vol.config, destVol.config); err != nil {
+			return fmt.Errorf("config of volume %s: %w", vol.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// compareJSON returns an error if the JSON encodings of a and b differ.
+func compareJSON(a, b any) error {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	var aValue, bValue any
+	if err := json.Unmarshal(aJSON, &aValue); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(bJSON, &bValue); err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(aValue, bValue) {
+		return errors.New("copy does not match the original")
+	}
+	return nil
+}
+
+// dbBackendDropInPath returns the path of the containers.conf drop-in file
+// setting the database backend.
+func dbBackendDropInPath() (string, error) {
+	if !rootless.IsRootless() {
+		return filepath.Join(config.OverrideContainersConfig+".d", dbBackendDropIn), nil
+	}
+	configHome, err := homedir.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configHome, "containers", "containers.conf.d", dbBackendDropIn), nil
+}
+
+// writeDBBackendDropIn writes a containers.conf drop-in file at path which
+// sets the database backend.
+func writeDBBackendDropIn(path string, backend config.DBBackend) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	content := fmt.Sprintf("# Written by podman system migrate --database %[1]s\n[engine]\ndatabase_backend = %[1]q\n", backend.String())
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
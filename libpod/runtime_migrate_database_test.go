@@ -0,0 +1,78 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v5/libpod/lock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyStateToSQLite(t *testing.T) {
+	tmpDir := t.TempDir()
+	// Allow the creation of a new BoltDB database.
+	t.Setenv("CI_DESIRED_DATABASE", "boltdb")
+
+	lockManager, err := lock.NewInMemoryManager(16)
+	require.NoError(t, err)
+
+	runtime := new(Runtime)
+	runtime.config = new(config.Config)
+	runtime.config.Engine.TmpDir = tmpDir
+	runtime.lockManager = lockManager
+
+	boltState, err := NewBoltState(filepath.Join(tmpDir, "bolt_state.db"), runtime)
+	require.NoError(t, err)
+	defer boltState.Close()
+	runtime.state = boltState
+
+	pod, err := getTestPodN("4", lockManager)
+	require.NoError(t, err)
+	require.NoError(t, boltState.AddPod(pod))
+
+	ctr1, err := getTestCtr1(lockManager)
+	require.NoError(t, err)
+	require.NoError(t, boltState.AddContainer(ctr1))
+	require.NoError(t, boltState.AddExecSession(ctr1, &ExecSession{Id: "exec1"}))
+	require.NoError(t, boltState.AddContainerExitCode(ctr1.ID(), 5))
+
+	// ctr2 sorts before ctr3 but depends on it, so the copy must reorder
+	// them.
+	ctr3, err := getTestCtrN("3", lockManager)
+	require.NoError(t, err)
+	ctr3.config.Pod = pod.ID()
+	require.NoError(t, boltState.AddContainerToPod(pod, ctr3))
+
+	ctr2, err := getTestCtr2(lockManager)
+	require.NoError(t, err)
+	ctr2.config.Pod = pod.ID()
+	ctr2.config.NetNsCtr = ctr3.ID()
+	require.NoError(t, boltState.AddContainerToPod(pod, ctr2))
+
+	sqliteState, err := newSqliteState(runtime, filepath.Join(tmpDir, "sqlite"))
+	require.NoError(t, err)
+	defer sqliteState.Close()
+
+	stats, err := runtime.copyStateToSQLite(sqliteState)
+	require.NoError(t, err)
+	assert.Equal(t, &dbMigrationStats{containers: 3, pods: 1, execSessions: 1, exitCodes: 1}, stats)
+
+	require.NoError(t, runtime.verifyMigratedState(sqliteState))
+
+	exitCode, err := sqliteState.GetContainerExitCode(ctr1.ID())
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), exitCode)
+
+	podCtrs, err := sqliteState.PodContainersByID(pod)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{ctr2.ID(), ctr3.ID()}, podCtrs)
+
+	// A change in the copy must be detected.
+	ctr1.config.Labels["changed"] = "true"
+	require.NoError(t, boltState.RewriteContainerConfig(ctr1, ctr1.config))
+	assert.Error(t, runtime.verifyMigratedState(sqliteState))
+}
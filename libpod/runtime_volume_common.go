@@ -394,6 +394,11 @@ func (r *Runtime) removeVolume(ctx context.Context, v *Volume, force bool, timeo
 				Timeout: timeout,
 			}
 			if _, _, err := r.removeContainer(ctx, ctr, opts); err != nil {
+				// The container may be removed along with another
+				// of its volumes at the same time.
+				if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+					continue
+				}
 				return fmt.Errorf("removing container %s that depends on volume %s: %w", ctr.ID(), v.Name(), err)
 			}
 		}
@@ -44,8 +44,11 @@ const (
 	// Timeout is in ms, so set it to 100s to have enough time to retry the operations.
 	sqliteOptionBusyTimeout = "&_busy_timeout=100000"
 
+	// Name of the database file.
+	sqliteDBFile = "db.sql"
+
 	// Assembled sqlite options used when opening the database.
-	sqliteOptions = "db.sql?" +
+	sqliteOptions = sqliteDBFile + "?" +
 		sqliteOptionLocation +
 		sqliteOptionSynchronous +
 		sqliteOptionForeignKeys +
@@ -54,16 +57,29 @@ const (
 )
 
 // NewSqliteState creates a new SQLite-backed state database.
-func NewSqliteState(runtime *Runtime) (_ State, defErr error) {
+func NewSqliteState(runtime *Runtime) (State, error) {
 	logrus.Info("Using sqlite as database backend")
-	state := new(SQLiteState)
+	state, err := newSqliteState(runtime, sqliteStateDir(runtime))
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
 
+// sqliteStateDir returns the directory holding the SQLite database.
+func sqliteStateDir(runtime *Runtime) string {
 	basePath := runtime.storageConfig.GraphRoot
 	if runtime.storageConfig.TransientStore {
 		basePath = runtime.storageConfig.RunRoot
 	} else if !runtime.storageSet.StaticDirSet {
 		basePath = runtime.config.Engine.StaticDir
 	}
+	return basePath
+}
+
+// newSqliteState opens or creates the SQLite database in basePath.
+func newSqliteState(runtime *Runtime, basePath string) (_ *SQLiteState, defErr error) {
+	state := new(SQLiteState)
 
 	// c/storage is set up *after* the DB - so even though we use the c/s
 	// root (or, for transient, runroot) dir, we need to make the dir
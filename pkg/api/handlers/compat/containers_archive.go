@@ -36,7 +36,11 @@ func Archive(w http.ResponseWriter, r *http.Request) {
 
 func handleHeadAndGet(w http.ResponseWriter, r *http.Request, decoder *schema.Decoder, runtime *libpod.Runtime) {
 	query := struct {
-		Path string `schema:"path"`
+		Path       string   `schema:"path"`
+		Exclude    []string `schema:"exclude"`
+		Include    []string `schema:"include"`
+		NoChown    bool     `schema:"noChown"`
+		NoContents bool     `schema:"noContents"`
 	}{}
 
 	err := decoder.Decode(&query, r.URL.Query())
@@ -83,7 +87,19 @@ func handleHeadAndGet(w http.ResponseWriter, r *http.Request, decoder *schema.De
 		return
 	}
 
-	copyFunc, err := containerEngine.ContainerCopyToArchive(r.Context(), containerName, query.Path, w)
+	// Validate the patterns before anything is copied.
+	if _, err := copy.NewArchiveFilter(query.Include, query.Exclude); err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	copyFunc, err := containerEngine.ContainerCopyToArchive(r.Context(), containerName, query.Path, w,
+		entities.CopyToArchiveOptions{
+			Excludes:   query.Exclude,
+			Includes:   query.Include,
+			NoChown:    query.NoChown,
+			NoContents: query.NoContents,
+		})
 	if err != nil {
 		utils.Error(w, http.StatusInternalServerError, err)
 		return
@@ -97,10 +113,14 @@ func handleHeadAndGet(w http.ResponseWriter, r *http.Request, decoder *schema.De
 
 func handlePut(w http.ResponseWriter, r *http.Request, decoder *schema.Decoder, runtime *libpod.Runtime) {
 	query := struct {
-		Path                 string `schema:"path"`
-		Chown                bool   `schema:"copyUIDGID"`
-		Rename               string `schema:"rename"`
-		NoOverwriteDirNonDir bool   `schema:"noOverwriteDirNonDir"`
+		Path                 string   `schema:"path"`
+		Chown                bool     `schema:"copyUIDGID"`
+		Rename               string   `schema:"rename"`
+		NoOverwriteDirNonDir bool     `schema:"noOverwriteDirNonDir"`
+		NoOverwriteDir       bool     `schema:"noOverwriteDir"`
+		StripXattrs          bool     `schema:"stripXattrs"`
+		StripSpecialBits     bool     `schema:"stripSpecialBits"`
+		Remove               []string `schema:"remove"`
 	}{
 		Chown: utils.IsLibpodRequest(r), // backward compatibility
 	}
@@ -126,7 +146,11 @@ func handlePut(w http.ResponseWriter, r *http.Request, decoder *schema.Decoder,
 		entities.CopyOptions{
 			Chown:                query.Chown,
 			NoOverwriteDirNonDir: query.NoOverwriteDirNonDir,
+			NoOverwriteDir:       query.NoOverwriteDir,
+			StripXattrs:          query.StripXattrs,
+			StripSpecialBits:     query.StripSpecialBits,
 			Rename:               rename,
+			Remove:               query.Remove,
 		})
 	if err != nil {
 		switch {
@@ -138,6 +162,8 @@ func handlePut(w http.ResponseWriter, r *http.Request, decoder *schema.Decoder,
 			// Not the best test but need to break this out for compatibility
 			// See vendor/github.com/containers/buildah/copier/copier.go:1585
 			utils.Error(w, http.StatusBadRequest, err)
+		case errors.Is(err, define.ErrInvalidArg):
+			utils.Error(w, http.StatusBadRequest, err)
 		default:
 			utils.Error(w, http.StatusInternalServerError, err)
 		}
@@ -59,6 +59,7 @@ func ExecCreateHandler(w http.ResponseWriter, r *http.Request) {
 	libpodConfig.WorkDir = input.WorkingDir
 	libpodConfig.Privileged = input.Privileged
 	libpodConfig.User = input.User
+	libpodConfig.Name = input.Name
 
 	if input.Tty {
 		util.ExecAddTERM(ctr.Env(), libpodConfig.Environment)
@@ -85,6 +86,10 @@ func ExecCreateHandler(w http.ResponseWriter, r *http.Request) {
 
 	sessID, err := ctr.ExecCreate(libpodConfig)
 	if err != nil {
+		if errors.Is(err, define.ErrExecSessionExists) {
+			utils.Error(w, http.StatusConflict, err)
+			return
+		}
 		if errors.Is(err, define.ErrCtrStateInvalid) {
 			// Check if the container is paused. If so, return a 409
 			state, err := ctr.State()
@@ -108,8 +113,8 @@ func ExecCreateHandler(w http.ResponseWriter, r *http.Request) {
 func ExecInspectHandler(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 
-	sessionID := mux.Vars(r)["id"]
-	sessionCtr, err := runtime.GetExecSessionContainer(sessionID)
+	// the session can also be given by its name
+	sessionCtr, sessionID, err := runtime.LookupExecSession(mux.Vars(r)["id"])
 	if err != nil {
 		utils.Error(w, http.StatusNotFound, err)
 		return
@@ -210,6 +215,73 @@ func ExecStartHandler(w http.ResponseWriter, r *http.Request) {
 	logrus.Debugf("Attach for container %s exec session %s completed successfully", sessionCtr.ID(), sessionID)
 }
 
+// ExecAttachHandler attaches to a running exec session given by its ID or name.
+func ExecAttachHandler(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := utils.GetDecoder(r)
+
+	query := struct {
+		DetachKeys string `schema:"detachKeys"`
+		Stdin      bool   `schema:"stdin"`
+		Stdout     bool   `schema:"stdout"`
+		Stderr     bool   `schema:"stderr"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	// Detach keys: explicitly set to "" is very different from unset
+	var detachKeys *string
+	if _, found := r.URL.Query()["detachKeys"]; found {
+		detachKeys = &query.DetachKeys
+	}
+
+	var streams *libpod.HTTPAttachStreams
+	_, stdin := r.URL.Query()["stdin"]
+	_, stdout := r.URL.Query()["stdout"]
+	_, stderr := r.URL.Query()["stderr"]
+	if stdin || stdout || stderr {
+		streams = &libpod.HTTPAttachStreams{Stdin: query.Stdin, Stdout: query.Stdout, Stderr: query.Stderr}
+		if !streams.Stdin && !streams.Stdout && !streams.Stderr {
+			utils.Error(w, http.StatusBadRequest, errors.New("at least one of stdin, stdout, stderr must be true"))
+			return
+		}
+	}
+
+	sessionCtr, sessionID, err := runtime.LookupExecSession(mux.Vars(r)["id"])
+	if err != nil {
+		utils.Error(w, http.StatusNotFound, err)
+		return
+	}
+
+	logErr := func(e error) {
+		logrus.Error(fmt.Errorf("attaching to container %s exec session %s: %w", sessionCtr.ID(), sessionID, e))
+	}
+
+	hijackChan := make(chan bool, 1)
+	err = sessionCtr.ExecHTTPAttach(sessionID, r, w, streams, detachKeys, nil, hijackChan)
+
+	if <-hijackChan {
+		// If connection was Hijacked, we have to signal it's being closed
+		t := r.Context().Value(api.IdleTrackerKey).(*idle.Tracker)
+		defer t.Close()
+
+		if err != nil {
+			// Cannot report error to client as a 500 as the Upgrade set status to 101
+			logErr(err)
+		}
+	} else {
+		if errors.Is(err, define.ErrCtrStateInvalid) || errors.Is(err, define.ErrExecSessionStateInvalid) {
+			utils.Error(w, http.StatusConflict, err)
+		} else {
+			utils.InternalServerError(w, err)
+		}
+		logErr(err)
+	}
+	logrus.Debugf("Attach for container %s exec session %s completed", sessionCtr.ID(), sessionID)
+}
+
 // ExecRemoveHandler removes a exec session.
 func ExecRemoveHandler(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
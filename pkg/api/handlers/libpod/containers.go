@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strings"
@@ -16,6 +17,8 @@ import (
 	api "github.com/containers/podman/v5/pkg/api/types"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi"
+	"github.com/containers/podman/v5/pkg/netcap"
+	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/gorilla/schema"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -123,7 +126,8 @@ func ListContainers(w http.ResponseWriter, r *http.Request) {
 func GetContainer(w http.ResponseWriter, r *http.Request) {
 	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	query := struct {
-		Size bool `schema:"size"`
+		Size  bool `schema:"size"`
+		Hooks bool `schema:"hooks"`
 	}{
 		// override any golang type defaults
 	}
@@ -144,6 +148,12 @@ func GetContainer(w http.ResponseWriter, r *http.Request) {
 		utils.InternalServerError(w, err)
 		return
 	}
+	if query.Hooks {
+		if data.OCIHooks, err = container.OCIHooks(); err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+	}
 	utils.WriteResponse(w, http.StatusOK, data)
 }
 
@@ -408,17 +418,96 @@ func UpdateContainer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		NetworkRates []string `schema:"networkRate"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	rates, err := specgenutil.ParseNetworkRates(query.NetworkRates)
+	if err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
 	options := &handlers.UpdateEntities{Resources: &specs.LinuxResources{}}
 	if err := json.NewDecoder(r.Body).Decode(&options.Resources); err != nil {
-		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("decode(): %w", err))
+		// the resources can be omitted when only the network rates change
+		if !errors.Is(err, io.EOF) || len(rates) == 0 {
+			utils.Error(w, http.StatusInternalServerError, fmt.Errorf("decode(): %w", err))
+			return
+		}
+	} else {
+		if err := ctr.Update(options.Resources); err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+	}
+	if len(rates) > 0 {
+		if err := ctr.UpdateNetworkRates(rates); err != nil {
+			if errors.Is(err, define.ErrInvalidArg) || errors.Is(err, define.ErrNetworkModeInvalid) {
+				utils.Error(w, http.StatusBadRequest, err)
+				return
+			}
+			utils.InternalServerError(w, err)
+			return
+		}
+	}
+	utils.WriteResponse(w, http.StatusCreated, ctr.ID())
+}
+
+// AddContainerPorts publishes additional ports of a container.
+func AddContainerPorts(w http.ResponseWriter, r *http.Request) {
+	updateContainerPorts(w, r, true)
+}
+
+// RemoveContainerPorts unpublishes ports of a container.
+func RemoveContainerPorts(w http.ResponseWriter, r *http.Request) {
+	updateContainerPorts(w, r, false)
+}
+
+func updateContainerPorts(w http.ResponseWriter, r *http.Request, add bool) {
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	// Now use the ABI implementation to prevent us from having duplicate
+	// code.
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+
+	name := utils.GetName(r)
+	query := struct {
+		Ports []string `schema:"ports"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
 		return
 	}
-	err = ctr.Update(options.Resources)
+	if len(query.Ports) == 0 {
+		utils.Error(w, http.StatusBadRequest, errors.New("at least one port must be given"))
+		return
+	}
+
+	var (
+		report *entities.ContainerPortReport
+		err    error
+	)
+	if add {
+		report, err = containerEngine.ContainerPortAdd(r.Context(), name, query.Ports)
+	} else {
+		report, err = containerEngine.ContainerPortRm(r.Context(), name, query.Ports)
+	}
 	if err != nil {
+		if errors.Is(err, define.ErrNoSuchCtr) {
+			utils.ContainerNotFound(w, name, err)
+			return
+		}
 		utils.InternalServerError(w, err)
 		return
 	}
-	utils.WriteResponse(w, http.StatusCreated, ctr.ID())
+	utils.WriteResponse(w, http.StatusOK, report)
 }
 
 func ShouldRestart(w http.ResponseWriter, r *http.Request) {
@@ -443,3 +532,68 @@ func ShouldRestart(w http.ResponseWriter, r *http.Request) {
 		utils.ContainerNotFound(w, name, define.ErrNoSuchCtr)
 	}
 }
+
+// flushWriter sends every write to the client immediately.
+type flushWriter struct {
+	w       http.ResponseWriter
+	written bool
+}
+
+func (f *flushWriter) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	f.written = true
+	if flusher, ok := f.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
+
+// NetCaptureContainer streams the packets captured in the network namespace
+// of a container in the pcapng format.
+func NetCaptureContainer(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Interface string `schema:"interface"`
+		Filter    string `schema:"filter"`
+		Snaplen   uint32 `schema:"snaplen"`
+		Count     uint64 `schema:"count"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if _, err := netcap.Compile(query.Filter); err != nil {
+		utils.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	name := utils.GetName(r)
+	ctr, err := runtime.LookupContainer(name)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+
+	options := netcap.Options{
+		Interface: query.Interface,
+		Filter:    query.Filter,
+		Snaplen:   query.Snaplen,
+		Count:     query.Count,
+	}
+	w.Header().Set("Content-Type", "application/x-pcapng")
+	output := &flushWriter{w: w}
+	if _, err := ctr.NetworkCapture(r.Context(), options, output); err != nil {
+		if output.written {
+			// the status was sent already, the client sees a truncated stream
+			logrus.Errorf("Capturing packets of container %s: %v", ctr.ID(), err)
+			return
+		}
+		switch {
+		case errors.Is(err, define.ErrCtrStateInvalid), errors.Is(err, define.ErrNetworkModeInvalid):
+			utils.Error(w, http.StatusConflict, err)
+		default:
+			utils.InternalServerError(w, err)
+		}
+	}
+}
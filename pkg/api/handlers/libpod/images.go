@@ -118,6 +118,7 @@ func PruneImages(w http.ResponseWriter, r *http.Request) {
 	query := struct {
 		All      bool `schema:"all"`
 		External bool `schema:"external"`
+		Policy   bool `schema:"policy"`
 	}{
 		// override any golang type defaults
 	}
@@ -158,6 +159,7 @@ func PruneImages(w http.ResponseWriter, r *http.Request) {
 		All:      query.All,
 		External: query.External,
 		Filter:   libpodFilters,
+		Policy:   query.Policy,
 	}
 	imagePruneReports, err := imageEngine.Prune(r.Context(), pruneOptions)
 	if err != nil {
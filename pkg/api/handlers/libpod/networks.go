@@ -149,8 +149,31 @@ func InspectNetwork(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 	ic := abi.ContainerEngine{Libpod: runtime}
 
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Live bool `schema:"live"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
 	name := utils.GetName(r)
-	options := entities.InspectOptions{}
+	options := entities.InspectOptions{Live: query.Live}
+	if query.Live {
+		reports, errs, err := ic.NetworkInspectLive(r.Context(), []string{name}, options)
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		// If the network cannot be found, we return a 404.
+		if len(errs) > 0 {
+			utils.Error(w, http.StatusNotFound, define.ErrNoSuchNetwork)
+			return
+		}
+		utils.WriteResponse(w, http.StatusOK, reports[0])
+		return
+	}
 	reports, errs, err := ic.NetworkInspect(r.Context(), []string{name}, options)
 	// If the network cannot be found, we return a 404.
 	if len(errs) > 0 {
@@ -54,9 +54,23 @@ func SystemPrune(w http.ResponseWriter, r *http.Request) {
 }
 
 func DiskUsage(w http.ResponseWriter, r *http.Request) {
-	// Options are only used by the CLI
-	options := entities.SystemDfOptions{}
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	query := struct {
+		Analyze bool `schema:"analyze"`
+	}{}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	// Format and Verbose are only used by the CLI
+	options := entities.SystemDfOptions{
+		Analyze: query.Analyze,
+	}
 	ic := abi.ContainerEngine{Libpod: runtime}
 	response, err := ic.SystemDf(r.Context(), options)
 	if err != nil {
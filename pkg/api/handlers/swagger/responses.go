@@ -314,6 +314,13 @@ type containerCreateResponse struct {
 	Body entities.ContainerCreateResponse
 }
 
+// Container port mappings
+// swagger:response
+type containerPortsResponse struct {
+	// in:body
+	Body entities.ContainerPortReport
+}
+
 type containerUpdateResponse struct {
 	// in:body
 	ID string
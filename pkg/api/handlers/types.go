@@ -161,6 +161,9 @@ type HistoryResponse struct {
 
 type ExecCreateConfig struct {
 	docker.ExecConfig
+	// Name is the name of the exec session, which can be used to attach
+	// to it. It is a libpod extension to the Docker API.
+	Name string `json:"Name,omitempty"`
 }
 
 type ExecStartConfig struct {
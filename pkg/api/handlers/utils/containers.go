@@ -30,6 +30,8 @@ type waitQueryDocker struct {
 type waitQueryLibpod struct {
 	Interval   string   `schema:"interval"`
 	Conditions []string `schema:"condition"`
+	UntilLog   string   `schema:"untilLog"`
+	UntilPort  uint16   `schema:"untilPort"`
 }
 
 func WaitContainerDocker(w http.ResponseWriter, r *http.Request) {
@@ -123,6 +125,8 @@ func WaitContainerLibpod(w http.ResponseWriter, r *http.Request) {
 	opts := entities.WaitOptions{
 		Conditions: query.Conditions,
 		Interval:   interval,
+		UntilLog:   query.UntilLog,
+		UntilPort:  query.UntilPort,
 	}
 	name := GetName(r)
 	reports, err := containerEngine.ContainerWait(r.Context(), []string{name}, opts)
@@ -132,11 +136,16 @@ func WaitContainerLibpod(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		InternalServerError(w, err)
+		return
 	}
 	if len(reports) != 1 {
 		Error(w, http.StatusInternalServerError, fmt.Errorf("the ContainerWait() function returned unexpected count of reports: %d", len(reports)))
 		return
 	}
+	if reports[0].Error != nil {
+		InternalServerError(w, reports[0].Error)
+		return
+	}
 
 	WriteResponse(w, http.StatusOK, strconv.Itoa(int(reports[0].ExitCode)))
 }
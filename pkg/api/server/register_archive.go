@@ -115,6 +115,28 @@ func (s *APIServer) registerArchiveHandlers(r *mux.Router) error {
 	//     type: boolean
 	//     description: pause the container while copying (defaults to true)
 	//     default: true
+	//   - in: query
+	//     name: noOverwriteDirNonDir
+	//     type: boolean
+	//     description: do not replace an existing directory with a non-directory and vice versa
+	//   - in: query
+	//     name: noOverwriteDir
+	//     type: boolean
+	//     description: keep the ownership, permissions and timestamps of existing directories
+	//   - in: query
+	//     name: stripXattrs
+	//     type: boolean
+	//     description: do not set the extended attributes of the copied files
+	//   - in: query
+	//     name: stripSpecialBits
+	//     type: boolean
+	//     description: drop the setuid, setgid and sticky bits of the copied files
+	//   - in: query
+	//     name: remove
+	//     type: array
+	//     items:
+	//       type: string
+	//     description: paths relative to path to remove, including their contents, before the archive is extracted
 	//   - in: body
 	//     name: request
 	//     description: tarfile of files to copy into the container
@@ -155,6 +177,32 @@ func (s *APIServer) registerArchiveHandlers(r *mux.Router) error {
 	//     name: rename
 	//     type: string
 	//     description: JSON encoded map[string]string to translate paths
+	//   - in: query
+	//     name: exclude
+	//     type: array
+	//     items:
+	//       type: string
+	//     description: |
+	//       Patterns of paths to leave out of the archive, using the syntax of .containerignore files.
+	//       A pattern matches the path of a file starting at any of its components.
+	//   - in: query
+	//     name: include
+	//     type: array
+	//     items:
+	//       type: string
+	//     description: |
+	//       Patterns of paths to put into the archive. If set, only matching paths and their parent directories are copied.
+	//       Excludes take precedence over includes.
+	//   - in: query
+	//     name: noChown
+	//     type: boolean
+	//     description: keep the user and group IDs of the files instead of changing them to the ones of the container user on the host
+	//   - in: query
+	//     name: noContents
+	//     type: boolean
+	//     description: |
+	//       leave out the contents of regular files, which are sent empty with their size in the PODMAN.size PAX record.
+	//       Useful to compare the files in the container with other files without transferring them.
 	//  responses:
 	//    200:
 	//      description: no error
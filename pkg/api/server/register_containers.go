@@ -74,16 +74,21 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//        A JSON encoded value of the filters (a `map[string][]string`) to process on the containers list. Available filters:
 	//        - `ancestor`=(`<image-name>[:<tag>]`, `<image id>`, or `<image@digest>`)
 	//        - `before`=(`<container id>` or `<container name>`)
+	//        - `command=<glob>` containers whose command matches `<glob>`
 	//        - `expose`=(`<port>[/<proto>]` or `<startport-endport>/[<proto>]`)
-	//        - `exited=<int>` containers with exit code of `<int>`
+	//        - `exited=<int>` containers with exit code of `<int>`, `<int>` may be prefixed by `<`, `<=`, `>` or `>=`
 	//        - `health`=(`starting`, `healthy`, `unhealthy` or `none`)
 	//        - `id=<ID>` a container's ID
+	//        - `image-digest=<digest>` containers created from an image with digest `<digest>`
 	//        - `is-task`=(`true` or `false`)
 	//        - `label`=(`key` or `"key=value"`) of a container label
 	//        - `name=<name>` a container's name
 	//        - `network`=(`<network id>` or `<network name>`)
+	//        - `oom-killed`=(`true` or `false`)
 	//        - `publish`=(`<port>[/<proto>]` or `<startport-endport>/[<proto>]`)
+	//        - `restart-count=<int>` containers restarted `<int>` times, `<int>` may be prefixed by `<`, `<=`, `>` or `>=`
 	//        - `since`=(`<container id>` or `<container name>`)
+	//        - `started-since=<timestamp>` containers started after this timestamp
 	//        - `status`=(`created`, `restarting`, `running`, `removing`, `paused`, `exited` or `dead`)
 	//        - `volume`=(`<volume name>` or `<mount point destination>`)
 	// produces:
@@ -112,6 +117,7 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//      Filters to process on the prune list, encoded as JSON (a `map[string][]string`).  Available filters:
 	//       - `until=<timestamp>` Prune containers created before this timestamp. The `<timestamp>` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
 	//       - `label` (`label=<key>`, `label=<key>=<value>`, `label!=<key>`, or `label!=<key>=<value>`) Prune containers with (or without, in case `label!=...` is used) the specified labels.
+	//       - `exited`, `restart-count`, `oom-killed`, `started-since`, `command`, `image-digest`, `publish` and `expose` as for listing containers.
 	// produces:
 	// - application/json
 	// responses:
@@ -749,17 +755,22 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//        A JSON encoded value of the filters (a `map[string][]string`) to process on the containers list. Available filters:
 	//        - `ancestor`=(`<image-name>[:<tag>]`, `<image id>`, or `<image@digest>`)
 	//        - `before`=(`<container id>` or `<container name>`)
+	//        - `command=<glob>` containers whose command matches `<glob>`
 	//        - `expose`=(`<port>[/<proto>]` or `<startport-endport>/[<proto>]`)
-	//        - `exited=<int>` containers with exit code of `<int>`
+	//        - `exited=<int>` containers with exit code of `<int>`, `<int>` may be prefixed by `<`, `<=`, `>` or `>=`
 	//        - `health`=(`starting`, `healthy`, `unhealthy` or `none`)
 	//        - `id=<ID>` a container's ID
+	//        - `image-digest=<digest>` containers created from an image with digest `<digest>`
 	//        - `is-task`=(`true` or `false`)
 	//        - `label`=(`key` or `"key=value"`) of a container label
 	//        - `name=<name>` a container's name
 	//        - `network`=(`<network id>` or `<network name>`)
+	//        - `oom-killed`=(`true` or `false`)
 	//        - `pod`=(`<pod id>` or `<pod name>`)
 	//        - `publish`=(`<port>[/<proto>]` or `<startport-endport>/[<proto>]`)
+	//        - `restart-count=<int>` containers restarted `<int>` times, `<int>` may be prefixed by `<`, `<=`, `>` or `>=`
 	//        - `since`=(`<container id>` or `<container name>`)
+	//        - `started-since=<timestamp>` containers started after this timestamp
 	//        - `status`=(`created`, `restarting`, `running`, `removing`, `paused`, `exited` or `dead`)
 	//        - `volume`=(`<volume name>` or `<mount point destination>`)
 	// produces:
@@ -786,6 +797,7 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//      Filters to process on the prune list, encoded as JSON (a `map[string][]string`).  Available filters:
 	//       - `until=<timestamp>` Prune containers created before this timestamp. The `<timestamp>` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
 	//       - `label` (`label=<key>`, `label=<key>=<value>`, `label!=<key>`, or `label!=<key>=<value>`) Prune containers with (or without, in case `label!=...` is used) the specified labels.
+	//       - `exited`, `restart-count`, `oom-killed`, `started-since`, `command`, `image-digest`, `publish` and `expose` as for listing containers.
 	// produces:
 	// - application/json
 	// responses:
@@ -877,6 +889,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    name: size
 	//    type: boolean
 	//    description: display filesystem usage
+	//  - in: query
+	//    name: hooks
+	//    type: boolean
+	//    description: include the OCI hooks the container uses
 	// produces:
 	// - application/json
 	// responses:
@@ -1252,12 +1268,20 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    type: string
 	//    default: "250ms"
 	//    description: Time Interval to wait before polling for completion.
+	//  - in: query
+	//    name: untilLog
+	//    type: string
+	//    description: Wait until the container logs a line matching this regular expression. The conditions are then only waited for if given.
+	//  - in: query
+	//    name: untilPort
+	//    type: integer
+	//    description: Wait until this TCP port accepts connections on the loopback interface of the container's network namespace. The conditions are then only waited for if given.
 	// produces:
 	// - application/json
 	// - text/plain
 	// responses:
 	//   200:
-	//     description: Status code
+	//     description: Status code, -1 if the container did not exit
 	//     schema:
 	//       type: integer
 	//       format: int32
@@ -1521,6 +1545,51 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/export"), s.APIHandler(compat.ExportContainer)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/containers/{name}/netcap libpod ContainerNetCaptureLibpod
+	// ---
+	// tags:
+	//   - containers
+	// summary: Capture packets of a container
+	// description: |
+	//   Capture the packets in the network namespace of a running container. The packets are streamed in the pcapng
+	//   format until the client closes the connection or the given number of packets was captured.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: interface
+	//    type: string
+	//    description: interface in the container to capture packets on, all interfaces if not set
+	//  - in: query
+	//    name: filter
+	//    type: string
+	//    description: only capture packets matching the pcap-filter expression
+	//  - in: query
+	//    name: snaplen
+	//    type: integer
+	//    default: 262144
+	//    description: maximum number of bytes captured of each packet
+	//  - in: query
+	//    name: count
+	//    type: integer
+	//    description: stop after capturing the number of packets, 0 means no limit
+	// produces:
+	// - application/x-pcapng
+	// responses:
+	//   200:
+	//     description: pcapng stream is returned in body
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   409:
+	//     $ref: "#/responses/conflictError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/netcap"), s.APIHandler(libpod.NetCaptureContainer)).Methods(http.MethodGet)
 	// swagger:operation POST /libpod/containers/{name}/checkpoint libpod ContainerCheckpointLibpod
 	// ---
 	// tags:
@@ -1748,13 +1817,22 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	// tags:
 	//   - containers
 	// summary: Update an existing containers cgroup configuration
-	// description: Update an existing containers cgroup configuration.
+	// description: Update an existing containers cgroup configuration and network bandwidth limits.
 	// parameters:
 	//  - in: path
 	//    name: name
 	//    type: string
 	//    required: true
 	//    description: Full or partial ID or full name of the container to update
+	//  - in: query
+	//    name: networkRate
+	//    type: array
+	//    items:
+	//      type: string
+	//    description: |
+	//      Change the bandwidth limits of the container in the format [network:]ingress=rate,egress=rate.
+	//      The limits replace the current limits of the network, a rate of 0 removes a limit.
+	//      The body can be omitted when only the network rates are changed.
 	//  - in: body
 	//    name: resources
 	//    description: attributes for updating the container
@@ -1771,5 +1849,67 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/update"), s.APIHandler(libpod.UpdateContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/{name}/ports/add libpod ContainerPortAddLibpod
+	// ---
+	// tags:
+	//   - containers
+	// summary: Publish ports of a container
+	// description: Publish additional ports of an existing container. The ports of a running container are changed without restarting it, which requires bridge networking and root privileges.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: ports
+	//    type: array
+	//    items:
+	//      type: string
+	//    required: true
+	//    description: Port mappings to publish, in the format of the --publish option of podman run ([[hostIP:]hostPort:]containerPort[/protocol]). A missing host port is replaced by a random free port.
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/containerPortsResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/ports/add"), s.APIHandler(libpod.AddContainerPorts)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/{name}/ports/rm libpod ContainerPortRmLibpod
+	// ---
+	// tags:
+	//   - containers
+	// summary: Unpublish ports of a container
+	// description: Unpublish ports of an existing container. The ports of a running container are changed without restarting it, which requires bridge networking and root privileges.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: ports
+	//    type: array
+	//    items:
+	//      type: string
+	//    required: true
+	//    description: Ports to unpublish, in the format of the --publish option of podman run ([[hostIP:]hostPort:]containerPort[/protocol]). Without host port, all mappings of the container port are removed.
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/containerPortsResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/ports/rm"), s.APIHandler(libpod.RemoveContainerPorts)).Methods(http.MethodPost)
 	return nil
 }
@@ -237,6 +237,10 @@ func (s *APIServer) registerExecHandlers(r *mux.Router) error {
 	//        WorkingDir:
 	//          type: string
 	//          description: The working directory for the exec process inside the container.
+	//        Name:
+	//          type: string
+	//          description: |
+	//           Name of the exec session. It must be unique among the exec sessions of running containers and can be used instead of the ID to inspect or attach to the session.
 	// produces:
 	// - application/json
 	// responses:
@@ -245,7 +249,7 @@ func (s *APIServer) registerExecHandlers(r *mux.Router) error {
 	//   404:
 	//     $ref: "#/responses/containerNotFound"
 	//   409:
-	//	   description: container is paused
+	//	   description: container is paused or an exec session with the given name already exists
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/containers/{name}/exec"), s.APIHandler(compat.ExecCreateHandler)).Methods(http.MethodPost)
@@ -324,6 +328,52 @@ func (s *APIServer) registerExecHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/exec/{id}/resize"), s.APIHandler(compat.ResizeTTY)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/exec/{id}/attach libpod ExecAttachLibpod
+	// ---
+	// tags:
+	//   - exec
+	// summary: Attach to a running exec instance
+	// description: |
+	//   Attach to an exec session which has already been started, for example one started detached.
+	//   The session is given by its ID or name. The stream format is the same as the attach endpoint.
+	// parameters:
+	//  - in: path
+	//    name: id
+	//    type: string
+	//    required: true
+	//    description: Exec instance ID or name
+	//  - in: query
+	//    name: detachKeys
+	//    type: string
+	//    description: keys to use for detaching from the exec session
+	//  - in: query
+	//    name: stdin
+	//    type: boolean
+	//    description: attach to stdin, defaults to the stdin setting of the exec session
+	//  - in: query
+	//    name: stdout
+	//    type: boolean
+	//    default: true
+	//    description: attach to stdout
+	//  - in: query
+	//    name: stderr
+	//    type: boolean
+	//    default: true
+	//    description: attach to stderr
+	// produces:
+	// - application/json
+	// responses:
+	//   101:
+	//     description: No error, connection has been hijacked for transporting streams.
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/execSessionNotFound"
+	//   409:
+	//	   description: container or exec session is not running.
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/exec/{id}/attach"), s.APIHandler(compat.ExecAttachHandler)).Methods(http.MethodPost)
 	// swagger:operation GET /libpod/exec/{id}/json libpod ExecInspectLibpod
 	// ---
 	// tags:
@@ -335,7 +385,7 @@ func (s *APIServer) registerExecHandlers(r *mux.Router) error {
 	//    name: id
 	//    type: string
 	//    required: true
-	//    description: Exec instance ID
+	//    description: Exec instance ID or name
 	// produces:
 	// - application/json
 	// responses:
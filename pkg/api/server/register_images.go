@@ -1082,6 +1082,12 @@ func (s *APIServer) registerImagesHandlers(r *mux.Router) error {
 	//    description: |
 	//      Remove images even when they are used by external containers (e.g, by build containers)
 	//  - in: query
+	//    name: policy
+	//    default: false
+	//    type: boolean
+	//    description: |
+	//      Remove the images selected by the image retention policy configured in containers.conf
+	//  - in: query
 	//    name: filters
 	//    type: string
 	//    description: |
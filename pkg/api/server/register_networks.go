@@ -241,7 +241,9 @@ func (s *APIServer) registerNetworkHandlers(r *mux.Router) error {
 	// tags:
 	//  - networks
 	// summary: Update existing podman network
-	// description: Update existing podman network
+	// description: |
+	//   Update existing podman network.
+	//   DNS servers are changed directly. For all other changes the network is re-created with a new ID, and the network of the running containers connected to it is reloaded, keeping their IP addresses.
 	// produces:
 	// - application/json
 	// parameters:
@@ -325,6 +327,11 @@ func (s *APIServer) registerNetworkHandlers(r *mux.Router) error {
 	//    type: string
 	//    required: true
 	//    description: the name of the network
+	//  - in: query
+	//    name: live
+	//    type: boolean
+	//    default: false
+	//    description: also return the containers connected to the network with their interfaces and traffic counters
 	// produces:
 	// - application/json
 	// responses:
@@ -46,6 +46,11 @@ func (s *APIServer) registerSystemHandlers(r *mux.Router) error {
 	//   - system
 	// summary: Show disk usage
 	// description: Return information about disk usage for containers, images, and volumes
+	// parameters:
+	//  - in: query
+	//    name: analyze
+	//    type: boolean
+	//    description: Compute layer sharing between images, dangling build cache and the space reclaimed by pruning
 	// produces:
 	// - application/json
 	// responses:
@@ -83,11 +83,19 @@ func CopyFromArchiveWithOptions(ctx context.Context, nameOrID string, path strin
 
 // CopyToArchive copy files from container
 func CopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer) (types.ContainerCopyFunc, error) {
+	return CopyToArchiveWithOptions(ctx, nameOrID, path, writer, nil)
+}
+
+// CopyToArchiveWithOptions copy files from container
+func CopyToArchiveWithOptions(ctx context.Context, nameOrID string, path string, writer io.Writer, options *CopyToArchiveOptions) (types.ContainerCopyFunc, error) {
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	params := url.Values{}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
 	params.Set("path", path)
 
 	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/containers/%s/archive", params, nil, nameOrID)
@@ -88,10 +88,27 @@ func Attach(ctx context.Context, nameOrID string, stdin io.Reader, stdout io.Wri
 		params.Add("stderr", "true")
 	}
 
+	return attach(ctx, conn, "/containers/%s/attach", nameOrID, false, ctnr.Config.Tty, stdin, stdout, stderr, attachReady, params, detachKeysInBytes)
+}
+
+// attach hijacks the connection of the given attach endpoint of a container
+// or exec session and copies the streams over it. Nil streams are not
+// attached.
+func attach(ctx context.Context, conn *bindings.Connection, endpoint, id string, isExec, isTerm bool, stdin io.Reader, stdout io.Writer, stderr io.Writer, attachReady chan bool, params url.Values, detachKeysInBytes []byte) error {
+	isSet := struct {
+		stdin  bool
+		stdout bool
+		stderr bool
+	}{
+		stdin:  stdin != nil,
+		stdout: stdout != nil,
+		stderr: stderr != nil,
+	}
+
 	// Unless all requirements are met, don't use "stdin" is a terminal
 	file, ok := stdin.(*os.File)
 	outFile, outOk := stdout.(*os.File)
-	needTTY := ok && outOk && terminal.IsTerminal(int(file.Fd())) && ctnr.Config.Tty
+	needTTY := ok && outOk && terminal.IsTerminal(int(file.Fd())) && isTerm
 	if needTTY {
 		state, err := setRawTerminal(file)
 		if err != nil {
@@ -127,7 +144,7 @@ func Attach(ctx context.Context, nameOrID string, stdin io.Reader, stdout io.Wri
 		IdleConnTimeout: time.Duration(0),
 	}
 	conn.Client.Transport = t
-	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/containers/%s/attach", params, headers, nameOrID)
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, endpoint, params, headers, id)
 	if err != nil {
 		return err
 	}
@@ -142,7 +159,7 @@ func Attach(ctx context.Context, nameOrID string, stdin io.Reader, stdout io.Wri
 		winCtx, winCancel := context.WithCancel(ctx)
 		defer winCancel()
 		notifyWinChange(winCtx, winChange, file, outFile)
-		attachHandleResize(ctx, winCtx, winChange, false, nameOrID, file, outFile)
+		attachHandleResize(ctx, winCtx, winChange, isExec, id, file, outFile)
 	}
 
 	// If we are attaching around a start, we need to "signal"
@@ -175,12 +192,12 @@ func Attach(ctx context.Context, nameOrID string, stdin io.Reader, stdout io.Wri
 	}
 
 	buffer := make([]byte, 1024)
-	if ctnr.Config.Tty {
+	if isTerm {
 		go func() {
 			logrus.Debugf("Copying STDOUT of container in terminal mode")
 
 			if !isSet.stdout {
-				stdoutChan <- fmt.Errorf("container %q requires stdout to be set", ctnr.ID)
+				stdoutChan <- fmt.Errorf("container %q requires stdout to be set", id)
 			}
 			// If not multiplex'ed, read from server and write to stdout
 			_, err := io.Copy(stdout, socket)
@@ -205,7 +222,7 @@ func Attach(ctx context.Context, nameOrID string, stdin io.Reader, stdout io.Wri
 			}
 		}
 	} else {
-		logrus.Debugf("Copying standard streams of container %q in non-terminal mode", ctnr.ID)
+		logrus.Debugf("Copying standard streams of container %q in non-terminal mode", id)
 		for {
 			// Read multiplexed channels and write to appropriate stream
 			fd, l, err := DemuxHeader(socket, buffer)
@@ -385,6 +402,53 @@ func setRawTerminal(file *os.File) (*terminal.State, error) {
 	return state, err
 }
 
+// ExecAttach attaches to an exec session which is already running, for
+// example one which was started detached. The session can be given by its
+// ID or name. Nil streams are not attached.
+func ExecAttach(ctx context.Context, nameOrID string, stdin io.Reader, stdout io.Writer, stderr io.Writer, options *ExecAttachOptions) error {
+	if options == nil {
+		options = new(ExecAttachOptions)
+	}
+	// Ensure golang can determine that interfaces are "really" nil
+	if stdin == nil || reflect.ValueOf(stdin).IsNil() {
+		stdin = (io.Reader)(nil)
+	}
+	if stdout == nil || reflect.ValueOf(stdout).IsNil() {
+		stdout = (io.Writer)(nil)
+	}
+	if stderr == nil || reflect.ValueOf(stderr).IsNil() {
+		stderr = (io.Writer)(nil)
+	}
+
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the name and find out whether the session has a terminal.
+	session, err := ExecInspect(ctx, nameOrID, nil)
+	if err != nil {
+		return err
+	}
+	isTerm := session.ProcessConfig != nil && session.ProcessConfig.Tty
+
+	params := url.Values{}
+	detachKeysInBytes := []byte{}
+	if options.Changed("DetachKeys") {
+		params.Add("detachKeys", options.GetDetachKeys())
+
+		detachKeysInBytes, err = term.ToBytes(options.GetDetachKeys())
+		if err != nil {
+			return fmt.Errorf("invalid detach keys: %w", err)
+		}
+	}
+	params.Add("stdin", strconv.FormatBool(stdin != nil))
+	params.Add("stdout", strconv.FormatBool(stdout != nil))
+	params.Add("stderr", strconv.FormatBool(stderr != nil))
+
+	return attach(ctx, conn, "/exec/%s/attach", session.ID, true, isTerm, stdin, stdout, stderr, nil, params, detachKeysInBytes)
+}
+
 // ExecStartAndAttach starts and attaches to a given exec session.
 func ExecStartAndAttach(ctx context.Context, sessionID string, options *ExecStartAndAttachOptions) error {
 	if options == nil {
@@ -434,6 +434,34 @@ func Export(ctx context.Context, nameOrID string, w io.Writer, options *ExportOp
 	return response.Process(nil)
 }
 
+// NetCapture captures packets in the network namespace of a container and
+// writes them to w in the pcapng format until the context is cancelled or the
+// packet count of the options is reached.
+func NetCapture(ctx context.Context, nameOrID string, w io.Writer, options *NetCaptureOptions) error {
+	if options == nil {
+		options = new(NetCaptureOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/containers/%s/netcap", params, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 == 2 {
+		_, err = io.Copy(w, response.Body)
+		return err
+	}
+	return response.Process(nil)
+}
+
 // ContainerInit takes a created container and executes all of the
 // preparations to run the container except it will not start
 // or attach to the container
@@ -0,0 +1,52 @@
+package containers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/containers/podman/v5/pkg/bindings"
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
+)
+
+// AddPorts publishes additional ports of a container.  The ports use the
+// format of the --publish option.  It returns the port mappings of the
+// container after the change.
+func AddPorts(ctx context.Context, nameOrID string, options *AddPortsOptions) (*types.ContainerPortReport, error) {
+	if options == nil {
+		options = new(AddPortsOptions)
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	return updatePorts(ctx, nameOrID, "add", params)
+}
+
+// RemovePorts unpublishes ports of a container.  It returns the port mappings
+// of the container after the change.
+func RemovePorts(ctx context.Context, nameOrID string, options *RemovePortsOptions) (*types.ContainerPortReport, error) {
+	if options == nil {
+		options = new(RemovePortsOptions)
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	return updatePorts(ctx, nameOrID, "rm", params)
+}
+
+func updatePorts(ctx context.Context, nameOrID, action string, params url.Values) (*types.ContainerPortReport, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/containers/%s/ports/%s", params, nil, nameOrID, action)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var report types.ContainerPortReport
+	return &report, response.Process(&report)
+}
@@ -176,7 +176,8 @@ type RemoveOptions struct {
 //
 //go:generate go run ../generator/generator.go InspectOptions
 type InspectOptions struct {
-	Size *bool
+	Size  *bool
+	Hooks *bool
 }
 
 // KillOptions are optional options for killing containers
@@ -240,6 +241,10 @@ type WaitOptions struct {
 	// Container status to wait on.
 	// Deprecated: use Conditions instead.
 	Condition []define.ContainerStatus
+	// Regular expression a line logged by the container must match.
+	UntilLog *string `schema:"untilLog"`
+	// TCP port which must accept connections in the container.
+	UntilPort *uint `schema:"untilPort"`
 }
 
 // StopOptions are optional options for stopping containers
@@ -255,6 +260,16 @@ type StopOptions struct {
 //go:generate go run ../generator/generator.go ExportOptions
 type ExportOptions struct{}
 
+// NetCaptureOptions are optional options for capturing packets of containers
+//
+//go:generate go run ../generator/generator.go NetCaptureOptions
+type NetCaptureOptions struct {
+	Interface *string
+	Filter    *string
+	Snaplen   *uint64
+	Count     *uint64
+}
+
 // InitOptions are optional options for initing containers
 //
 //go:generate go run ../generator/generator.go InitOptions
@@ -314,6 +329,15 @@ type ExecStartAndAttachOptions struct {
 	AttachInput *bool
 }
 
+// ExecAttachOptions are optional options for attaching to a running
+// exec session
+//
+//go:generate go run ../generator/generator.go ExecAttachOptions
+type ExecAttachOptions struct {
+	// DetachKeys are the keys to detach from the exec session
+	DetachKeys *string
+}
+
 // ExistsOptions are optional options for checking if a container exists
 //
 //go:generate go run ../generator/generator.go ExistsOptions
@@ -334,6 +358,31 @@ type CopyOptions struct {
 	// NoOverwriteDirNonDir when true prevents an existing directory or file from being overwritten
 	// by the other type.
 	NoOverwriteDirNonDir *bool
+	// NoOverwriteDir when true keeps the metadata of existing directories.
+	NoOverwriteDir *bool
+	// StripXattrs when true drops the extended attributes of the copied files.
+	StripXattrs *bool
+	// StripSpecialBits when true drops the setuid, setgid and sticky bits of the copied files.
+	StripSpecialBits *bool
+	// Remove are paths relative to the destination which are removed before the archive is extracted.
+	Remove []string
+}
+
+// CopyToArchiveOptions are options for copying from containers.
+//
+//go:generate go run ../generator/generator.go CopyToArchiveOptions
+type CopyToArchiveOptions struct {
+	// Exclude are patterns of paths which are not copied.
+	Exclude []string
+	// Include are patterns of paths which are copied. If set, only matching
+	// paths and their parent directories are copied.
+	Include []string
+	// NoChown when true keeps the user and group IDs of the files instead of
+	// changing them to the container user on the host.
+	NoChown *bool
+	// NoContents leaves out the contents of regular files.  Their size is
+	// recorded in the PODMAN.size PAX record instead.
+	NoContents *bool
 }
 
 // ExecRemoveOptions are optional options for removing an exec session
@@ -342,3 +391,19 @@ type CopyOptions struct {
 type ExecRemoveOptions struct {
 	Force *bool
 }
+
+// AddPortsOptions are options for publishing additional ports of a container
+//
+//go:generate go run ../generator/generator.go AddPortsOptions
+type AddPortsOptions struct {
+	// Ports to publish in the format of the --publish option.
+	Ports []string
+}
+
+// RemovePortsOptions are options for unpublishing ports of a container
+//
+//go:generate go run ../generator/generator.go RemovePortsOptions
+type RemovePortsOptions struct {
+	// Ports to unpublish in the `containerPort[/protocol]` format.
+	Ports []string
+}
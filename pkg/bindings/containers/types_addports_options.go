@@ -0,0 +1,33 @@
+// Code generated by go generate; DO NOT EDIT.
+package containers
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v5/pkg/bindings/internal/util"
+)
+
+// Changed returns true if named field has been set
+func (o *AddPortsOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *AddPortsOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithPorts set field Ports to given value
+func (o *AddPortsOptions) WithPorts(value []string) *AddPortsOptions {
+	o.Ports = value
+	return o
+}
+
+// GetPorts returns value of field Ports
+func (o *AddPortsOptions) GetPorts() []string {
+	if o.Ports == nil {
+		var z []string
+		return z
+	}
+	return o.Ports
+}
@@ -61,3 +61,63 @@ func (o *CopyOptions) GetNoOverwriteDirNonDir() bool {
 	}
 	return *o.NoOverwriteDirNonDir
 }
+
+// WithNoOverwriteDir set field NoOverwriteDir to given value
+func (o *CopyOptions) WithNoOverwriteDir(value bool) *CopyOptions {
+	o.NoOverwriteDir = &value
+	return o
+}
+
+// GetNoOverwriteDir returns value of field NoOverwriteDir
+func (o *CopyOptions) GetNoOverwriteDir() bool {
+	if o.NoOverwriteDir == nil {
+		var z bool
+		return z
+	}
+	return *o.NoOverwriteDir
+}
+
+// WithStripXattrs set field StripXattrs to given value
+func (o *CopyOptions) WithStripXattrs(value bool) *CopyOptions {
+	o.StripXattrs = &value
+	return o
+}
+
+// GetStripXattrs returns value of field StripXattrs
+func (o *CopyOptions) GetStripXattrs() bool {
+	if o.StripXattrs == nil {
+		var z bool
+		return z
+	}
+	return *o.StripXattrs
+}
+
+// WithStripSpecialBits set field StripSpecialBits to given value
+func (o *CopyOptions) WithStripSpecialBits(value bool) *CopyOptions {
+	o.StripSpecialBits = &value
+	return o
+}
+
+// GetStripSpecialBits returns value of field StripSpecialBits
+func (o *CopyOptions) GetStripSpecialBits() bool {
+	if o.StripSpecialBits == nil {
+		var z bool
+		return z
+	}
+	return *o.StripSpecialBits
+}
+
+// WithRemove set field Remove to given value
+func (o *CopyOptions) WithRemove(value []string) *CopyOptions {
+	o.Remove = value
+	return o
+}
+
+// GetRemove returns value of field Remove
+func (o *CopyOptions) GetRemove() []string {
+	if o.Remove == nil {
+		var z []string
+		return z
+	}
+	return o.Remove
+}
@@ -0,0 +1,78 @@
+// Code generated by go generate; DO NOT EDIT.
+package containers
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v5/pkg/bindings/internal/util"
+)
+
+// Changed returns true if named field has been set
+func (o *CopyToArchiveOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *CopyToArchiveOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithExclude set field Exclude to given value
+func (o *CopyToArchiveOptions) WithExclude(value []string) *CopyToArchiveOptions {
+	o.Exclude = value
+	return o
+}
+
+// GetExclude returns value of field Exclude
+func (o *CopyToArchiveOptions) GetExclude() []string {
+	if o.Exclude == nil {
+		var z []string
+		return z
+	}
+	return o.Exclude
+}
+
+// WithInclude set field Include to given value
+func (o *CopyToArchiveOptions) WithInclude(value []string) *CopyToArchiveOptions {
+	o.Include = value
+	return o
+}
+
+// GetInclude returns value of field Include
+func (o *CopyToArchiveOptions) GetInclude() []string {
+	if o.Include == nil {
+		var z []string
+		return z
+	}
+	return o.Include
+}
+
+// WithNoChown set field NoChown to given value
+func (o *CopyToArchiveOptions) WithNoChown(value bool) *CopyToArchiveOptions {
+	o.NoChown = &value
+	return o
+}
+
+// GetNoChown returns value of field NoChown
+func (o *CopyToArchiveOptions) GetNoChown() bool {
+	if o.NoChown == nil {
+		var z bool
+		return z
+	}
+	return *o.NoChown
+}
+
+// WithNoContents set field NoContents to given value
+func (o *CopyToArchiveOptions) WithNoContents(value bool) *CopyToArchiveOptions {
+	o.NoContents = &value
+	return o
+}
+
+// GetNoContents returns value of field NoContents
+func (o *CopyToArchiveOptions) GetNoContents() bool {
+	if o.NoContents == nil {
+		var z bool
+		return z
+	}
+	return *o.NoContents
+}
@@ -0,0 +1,33 @@
+// Code generated by go generate; DO NOT EDIT.
+package containers
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v5/pkg/bindings/internal/util"
+)
+
+// Changed returns true if named field has been set
+func (o *ExecAttachOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *ExecAttachOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithDetachKeys set field DetachKeys to given value
+func (o *ExecAttachOptions) WithDetachKeys(value string) *ExecAttachOptions {
+	o.DetachKeys = &value
+	return o
+}
+
+// GetDetachKeys returns value of field DetachKeys
+func (o *ExecAttachOptions) GetDetachKeys() string {
+	if o.DetachKeys == nil {
+		var z string
+		return z
+	}
+	return *o.DetachKeys
+}
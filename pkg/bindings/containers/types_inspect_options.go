@@ -31,3 +31,18 @@ func (o *InspectOptions) GetSize() bool {
 	}
 	return *o.Size
 }
+
+// WithHooks set field Hooks to given value
+func (o *InspectOptions) WithHooks(value bool) *InspectOptions {
+	o.Hooks = &value
+	return o
+}
+
+// GetHooks returns value of field Hooks
+func (o *InspectOptions) GetHooks() bool {
+	if o.Hooks == nil {
+		var z bool
+		return z
+	}
+	return *o.Hooks
+}
@@ -0,0 +1,78 @@
+// Code generated by go generate; DO NOT EDIT.
+package containers
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v5/pkg/bindings/internal/util"
+)
+
+// Changed returns true if named field has been set
+func (o *NetCaptureOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *NetCaptureOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithInterface set field Interface to given value
+func (o *NetCaptureOptions) WithInterface(value string) *NetCaptureOptions {
+	o.Interface = &value
+	return o
+}
+
+// GetInterface returns value of field Interface
+func (o *NetCaptureOptions) GetInterface() string {
+	if o.Interface == nil {
+		var z string
+		return z
+	}
+	return *o.Interface
+}
+
+// WithFilter set field Filter to given value
+func (o *NetCaptureOptions) WithFilter(value string) *NetCaptureOptions {
+	o.Filter = &value
+	return o
+}
+
+// GetFilter returns value of field Filter
+func (o *NetCaptureOptions) GetFilter() string {
+	if o.Filter == nil {
+		var z string
+		return z
+	}
+	return *o.Filter
+}
+
+// WithSnaplen set field Snaplen to given value
+func (o *NetCaptureOptions) WithSnaplen(value uint64) *NetCaptureOptions {
+	o.Snaplen = &value
+	return o
+}
+
+// GetSnaplen returns value of field Snaplen
+func (o *NetCaptureOptions) GetSnaplen() uint64 {
+	if o.Snaplen == nil {
+		var z uint64
+		return z
+	}
+	return *o.Snaplen
+}
+
+// WithCount set field Count to given value
+func (o *NetCaptureOptions) WithCount(value uint64) *NetCaptureOptions {
+	o.Count = &value
+	return o
+}
+
+// GetCount returns value of field Count
+func (o *NetCaptureOptions) GetCount() uint64 {
+	if o.Count == nil {
+		var z uint64
+		return z
+	}
+	return *o.Count
+}
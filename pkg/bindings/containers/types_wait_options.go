@@ -62,3 +62,33 @@ func (o *WaitOptions) GetCondition() []define.ContainerStatus {
 	}
 	return o.Condition
 }
+
+// WithUntilLog set field UntilLog to given value
+func (o *WaitOptions) WithUntilLog(value string) *WaitOptions {
+	o.UntilLog = &value
+	return o
+}
+
+// GetUntilLog returns value of field UntilLog
+func (o *WaitOptions) GetUntilLog() string {
+	if o.UntilLog == nil {
+		var z string
+		return z
+	}
+	return *o.UntilLog
+}
+
+// WithUntilPort set field UntilPort to given value
+func (o *WaitOptions) WithUntilPort(value uint) *WaitOptions {
+	o.UntilPort = &value
+	return o
+}
+
+// GetUntilPort returns value of field UntilPort
+func (o *WaitOptions) GetUntilPort() uint {
+	if o.UntilPort == nil {
+		var z uint
+		return z
+	}
+	return *o.UntilPort
+}
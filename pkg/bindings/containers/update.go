@@ -2,7 +2,9 @@ package containers
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/containers/podman/v5/pkg/bindings"
@@ -16,12 +18,26 @@ func Update(ctx context.Context, options *types.ContainerUpdateOptions) (string,
 		return "", err
 	}
 
-	resources, err := jsoniter.MarshalToString(options.Specgen.ResourceLimits)
-	if err != nil {
-		return "", err
+	var body io.Reader
+	if options.Specgen != nil {
+		resources, err := jsoniter.MarshalToString(options.Specgen.ResourceLimits)
+		if err != nil {
+			return "", err
+		}
+		body = strings.NewReader(resources)
+	}
+	params := url.Values{}
+	for netName, rate := range options.NetworkRates {
+		value := rate.String()
+		if rate.IsZero() {
+			value = "ingress=0,egress=0"
+		}
+		if netName != "" {
+			value = netName + ":" + value
+		}
+		params.Add("networkRate", value)
 	}
-	stringReader := strings.NewReader(resources)
-	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/containers/%s/update", nil, nil, options.NameOrID)
+	response, err := conn.DoRequest(ctx, body, http.MethodPost, "/containers/%s/update", params, nil, options.NameOrID)
 	if err != nil {
 		return "", err
 	}
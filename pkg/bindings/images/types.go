@@ -95,6 +95,8 @@ type PruneOptions struct {
 	External *bool
 	// Filters to apply when pruning images
 	Filters map[string][]string
+	// Prune the images selected by the image retention policy
+	Policy *bool
 }
 
 // TagOptions are optional options for tagging images
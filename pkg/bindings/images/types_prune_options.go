@@ -61,3 +61,18 @@ func (o *PruneOptions) GetFilters() map[string][]string {
 	}
 	return o.Filters
 }
+
+// WithPolicy set field Policy to given value
+func (o *PruneOptions) WithPolicy(value bool) *PruneOptions {
+	o.Policy = &value
+	return o
+}
+
+// GetPolicy returns value of field Policy
+func (o *PruneOptions) GetPolicy() bool {
+	if o.Policy == nil {
+		var z bool
+		return z
+	}
+	return *o.Policy
+}
@@ -85,6 +85,31 @@ func Inspect(ctx context.Context, nameOrID string, _ *InspectOptions) (types.Net
 	return net, response.Process(&net)
 }
 
+// InspectLive returns the configuration of a network together with the
+// containers connected to it and their traffic counters.
+func InspectLive(ctx context.Context, nameOrID string, options *InspectOptions) (*entitiesTypes.NetworkInspectLiveReport, error) {
+	if options == nil {
+		options = new(InspectOptions)
+	}
+	options = options.WithLive(true)
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/networks/%s/json", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	report := new(entitiesTypes.NetworkInspectLiveReport)
+	return report, response.Process(report)
+}
+
 // Remove deletes a defined network configuration by name.  The optional force boolean
 // will remove all containers associated with the network when set to true.  A slice
 // of NetworkRemoveReports are returned.
@@ -2,6 +2,8 @@ package network
 
 import (
 	"net"
+
+	"github.com/containers/common/libnetwork/types"
 )
 
 // CreateOptions are optional options for creating networks
@@ -38,6 +40,8 @@ type CreateOptions struct {
 //
 //go:generate go run ../generator/generator.go InspectOptions
 type InspectOptions struct {
+	// Live also reports the containers connected to the network
+	Live *bool
 }
 
 // RemoveOptions are optional options for inspecting networks
@@ -64,6 +68,24 @@ type ListOptions struct {
 type UpdateOptions struct {
 	AddDNSServers    []string `json:"adddnsservers"`
 	RemoveDNSServers []string `json:"removednsservers"`
+	// Subnets to add to the network.
+	AddSubnets []types.Subnet `json:"addsubnets,omitempty"`
+	// Subnets to remove from the network.
+	RemoveSubnets []types.IPNet `json:"removesubnets,omitempty"`
+	// New gateways, each one is set for the subnet which contains it.
+	Gateways []net.IP `json:"gateways,omitempty"`
+	// New IP ranges, each one is set for the subnet which contains it.
+	IPRanges []types.LeaseRange `json:"ipranges,omitempty"`
+	// Internal restricts external access from the network when set.
+	Internal *bool `json:"internal,omitempty"`
+	// Labels to add or overwrite.
+	AddLabels map[string]string `json:"addlabels,omitempty"`
+	// Names of the labels to remove.
+	RemoveLabels []string `json:"removelabels,omitempty"`
+	// Driver options to add or overwrite.
+	AddOptions map[string]string `json:"addoptions,omitempty"`
+	// Names of the driver options to remove.
+	RemoveOptions []string `json:"removeoptions,omitempty"`
 }
 
 // DisconnectOptions are optional options for disconnecting
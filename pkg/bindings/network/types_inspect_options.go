@@ -16,3 +16,18 @@ func (o *InspectOptions) Changed(fieldName string) bool {
 func (o *InspectOptions) ToParams() (url.Values, error) {
 	return util.ToParams(o)
 }
+
+// WithLive set field Live to given value
+func (o *InspectOptions) WithLive(value bool) *InspectOptions {
+	o.Live = &value
+	return o
+}
+
+// GetLive returns value of field Live
+func (o *InspectOptions) GetLive() bool {
+	if o.Live == nil {
+		var z bool
+		return z
+	}
+	return *o.Live
+}
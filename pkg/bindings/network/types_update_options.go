@@ -2,8 +2,10 @@
 package network
 
 import (
+	"net"
 	"net/url"
 
+	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/podman/v5/pkg/bindings/internal/util"
 )
 
@@ -46,3 +48,138 @@ func (o *UpdateOptions) GetRemoveDNSServers() []string {
 	}
 	return o.RemoveDNSServers
 }
+
+// WithAddSubnets set field AddSubnets to given value
+func (o *UpdateOptions) WithAddSubnets(value []types.Subnet) *UpdateOptions {
+	o.AddSubnets = value
+	return o
+}
+
+// GetAddSubnets returns value of field AddSubnets
+func (o *UpdateOptions) GetAddSubnets() []types.Subnet {
+	if o.AddSubnets == nil {
+		var z []types.Subnet
+		return z
+	}
+	return o.AddSubnets
+}
+
+// WithRemoveSubnets set field RemoveSubnets to given value
+func (o *UpdateOptions) WithRemoveSubnets(value []types.IPNet) *UpdateOptions {
+	o.RemoveSubnets = value
+	return o
+}
+
+// GetRemoveSubnets returns value of field RemoveSubnets
+func (o *UpdateOptions) GetRemoveSubnets() []types.IPNet {
+	if o.RemoveSubnets == nil {
+		var z []types.IPNet
+		return z
+	}
+	return o.RemoveSubnets
+}
+
+// WithGateways set field Gateways to given value
+func (o *UpdateOptions) WithGateways(value []net.IP) *UpdateOptions {
+	o.Gateways = value
+	return o
+}
+
+// GetGateways returns value of field Gateways
+func (o *UpdateOptions) GetGateways() []net.IP {
+	if o.Gateways == nil {
+		var z []net.IP
+		return z
+	}
+	return o.Gateways
+}
+
+// WithIPRanges set field IPRanges to given value
+func (o *UpdateOptions) WithIPRanges(value []types.LeaseRange) *UpdateOptions {
+	o.IPRanges = value
+	return o
+}
+
+// GetIPRanges returns value of field IPRanges
+func (o *UpdateOptions) GetIPRanges() []types.LeaseRange {
+	if o.IPRanges == nil {
+		var z []types.LeaseRange
+		return z
+	}
+	return o.IPRanges
+}
+
+// WithInternal set field Internal to given value
+func (o *UpdateOptions) WithInternal(value bool) *UpdateOptions {
+	o.Internal = &value
+	return o
+}
+
+// GetInternal returns value of field Internal
+func (o *UpdateOptions) GetInternal() bool {
+	if o.Internal == nil {
+		var z bool
+		return z
+	}
+	return *o.Internal
+}
+
+// WithAddLabels set field AddLabels to given value
+func (o *UpdateOptions) WithAddLabels(value map[string]string) *UpdateOptions {
+	o.AddLabels = value
+	return o
+}
+
+// GetAddLabels returns value of field AddLabels
+func (o *UpdateOptions) GetAddLabels() map[string]string {
+	if o.AddLabels == nil {
+		var z map[string]string
+		return z
+	}
+	return o.AddLabels
+}
+
+// WithRemoveLabels set field RemoveLabels to given value
+func (o *UpdateOptions) WithRemoveLabels(value []string) *UpdateOptions {
+	o.RemoveLabels = value
+	return o
+}
+
+// GetRemoveLabels returns value of field RemoveLabels
+func (o *UpdateOptions) GetRemoveLabels() []string {
+	if o.RemoveLabels == nil {
+		var z []string
+		return z
+	}
+	return o.RemoveLabels
+}
+
+// WithAddOptions set field AddOptions to given value
+func (o *UpdateOptions) WithAddOptions(value map[string]string) *UpdateOptions {
+	o.AddOptions = value
+	return o
+}
+
+// GetAddOptions returns value of field AddOptions
+func (o *UpdateOptions) GetAddOptions() map[string]string {
+	if o.AddOptions == nil {
+		var z map[string]string
+		return z
+	}
+	return o.AddOptions
+}
+
+// WithRemoveOptions set field RemoveOptions to given value
+func (o *UpdateOptions) WithRemoveOptions(value []string) *UpdateOptions {
+	o.RemoveOptions = value
+	return o
+}
+
+// GetRemoveOptions returns value of field RemoveOptions
+func (o *UpdateOptions) GetRemoveOptions() []string {
+	if o.RemoveOptions == nil {
+		var z []string
+		return z
+	}
+	return o.RemoveOptions
+}
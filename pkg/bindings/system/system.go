@@ -139,12 +139,15 @@ func DiskUsage(ctx context.Context, options *DiskOptions) (*types.SystemDfReport
 	if options == nil {
 		options = new(DiskOptions)
 	}
-	_ = options
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/system/df", nil, nil)
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/system/df", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +30,8 @@ type VersionOptions struct {
 //
 //go:generate go run ../generator/generator.go DiskOptions
 type DiskOptions struct {
+	// Analyze computes layer sharing and prune estimates
+	Analyze *bool
 }
 
 // InfoOptions are optional options for getting info
@@ -16,3 +16,18 @@ func (o *DiskOptions) Changed(fieldName string) bool {
 func (o *DiskOptions) ToParams() (url.Values, error) {
 	return util.ToParams(o)
 }
+
+// WithAnalyze set field Analyze to given value
+func (o *DiskOptions) WithAnalyze(value bool) *DiskOptions {
+	o.Analyze = &value
+	return o
+}
+
+// GetAnalyze returns value of field Analyze
+func (o *DiskOptions) GetAnalyze() bool {
+	if o.Analyze == nil {
+		var z bool
+		return z
+	}
+	return *o.Analyze
+}
@@ -134,6 +134,21 @@ var _ = Describe("Podman networks", func() {
 		Expect(data.Name).To(Equal(name))
 	})
 
+	It("inspect network live", func() {
+		name := "foobar"
+		net := types.Network{
+			Name: name,
+		}
+		_, err = network.Create(connText, &net)
+		Expect(err).ToNot(HaveOccurred())
+		options := new(network.InspectOptions)
+		data, err := network.InspectLive(connText, name, options)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data.Name).To(Equal(name))
+		Expect(data.Endpoints).To(BeEmpty())
+		Expect(options.GetLive()).To(BeTrue())
+	})
+
 	It("list networks", func() {
 		// create a bunch of named networks and make verify with list
 		netNames := []string{"homer", "bart", "lisa", "maggie", "marge"}
@@ -0,0 +1,222 @@
+package copy
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containers/storage/pkg/fileutils"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/sirupsen/logrus"
+)
+
+// ArchiveFilter copies a tar archive while dropping the entries which are not
+// wanted.  Patterns use the syntax of .containerignore files and match the
+// path of an entry starting at any of its components, so "*.log" matches
+// "a.log" as well as "logs/b.log".  A pattern matching a directory also
+// matches everything below it.
+type ArchiveFilter struct {
+	excludes []*fileutils.PatternMatcher
+	includes []*fileutils.PatternMatcher
+
+	// SkipDirectory, if set, is called for every directory entry which
+	// is kept.  If it returns true, the header of the directory is
+	// dropped but its contents are still copied.
+	SkipDirectory func(name string) bool
+	// Progress, if set, is called for every entry written to the
+	// destination.
+	Progress func(hdr *tar.Header)
+	// NoContents drops the contents of regular files.  Their size is
+	// recorded in the ContentSizeRecord PAX record instead.
+	NoContents bool
+}
+
+// ContentSizeRecord is the PAX record holding the size of a regular file
+// whose contents were dropped by an ArchiveFilter with NoContents set.
+const ContentSizeRecord = "PODMAN.size"
+
+// EntrySize returns the size of a regular file in an archive, taking
+// dropped contents into account.
+func EntrySize(hdr *tar.Header) int64 {
+	if size, ok := hdr.PAXRecords[ContentSizeRecord]; ok {
+		if n, err := strconv.ParseInt(size, 10, 64); err == nil {
+			return n
+		}
+	}
+	return hdr.Size
+}
+
+// NewArchiveFilter returns a filter which drops all entries matching one of
+// the exclude patterns.  If include patterns are given, it also drops all
+// entries not matching one of them, except for the parent directories of
+// entries which are kept.  Excludes take precedence over includes.
+func NewArchiveFilter(includes, excludes []string) (*ArchiveFilter, error) {
+	f := &ArchiveFilter{}
+	var err error
+	if f.includes, err = compilePatterns(includes); err != nil {
+		return nil, err
+	}
+	if f.excludes, err = compilePatterns(excludes); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func compilePatterns(patterns []string) ([]*fileutils.PatternMatcher, error) {
+	matchers := make([]*fileutils.PatternMatcher, 0, len(patterns))
+	for _, pattern := range patterns {
+		if strings.HasPrefix(pattern, "!") {
+			return nil, fmt.Errorf("invalid pattern %q: negated patterns are not supported", pattern)
+		}
+		pm, err := fileutils.NewPatternMatcher([]string{pattern})
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		// Check the syntax now rather than for the first entry.
+		if _, err := pm.IsMatch("."); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		matchers = append(matchers, pm)
+	}
+	return matchers, nil
+}
+
+// matchesAny returns true if one of the patterns matches name or a trailing
+// part of it.
+func matchesAny(matchers []*fileutils.PatternMatcher, name string) bool {
+	components := strings.Split(name, "/")
+	for i := range components {
+		candidate := strings.Join(components[i:], "/")
+		for _, pm := range matchers {
+			if matched, _ := pm.IsMatch(candidate); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isParentOf returns true if dir is a parent directory of name.
+func isParentOf(dir, name string) bool {
+	return strings.HasPrefix(name, dir+"/")
+}
+
+// Copy copies the tar archive from src to dst, filtering its entries.
+func (f *ArchiveFilter) Copy(dst io.Writer, src io.Reader) error {
+	tr := tar.NewReader(src)
+	tw := tar.NewWriter(dst)
+	filtering := len(f.includes) > 0 || len(f.excludes) > 0
+
+	// Directories which are not included themselves are only written
+	// once something below them is kept.
+	var pendingDirs []*tar.Header
+	// Hard links can only be written if their target was written.
+	written := make(map[string]bool)
+
+	writeHeader := func(hdr *tar.Header, name string) error {
+		if hdr.Typeflag == tar.TypeDir && f.SkipDirectory != nil && f.SkipDirectory(name) {
+			return nil
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if f.Progress != nil {
+			f.Progress(hdr)
+		}
+		return nil
+	}
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading tar archive: %w", err)
+		}
+		name := path.Clean(hdr.Name)
+
+		if filtering {
+			if name == "." || name == "/" || matchesAny(f.excludes, name) {
+				continue
+			}
+			// Forget pending directories we have left.
+			for len(pendingDirs) > 0 && !isParentOf(path.Clean(pendingDirs[len(pendingDirs)-1].Name), name) {
+				pendingDirs = pendingDirs[:len(pendingDirs)-1]
+			}
+			if len(f.includes) > 0 && !matchesAny(f.includes, name) {
+				if hdr.Typeflag == tar.TypeDir {
+					pendingDirs = append(pendingDirs, hdr)
+				}
+				continue
+			}
+			if hdr.Typeflag == tar.TypeLink && !written[path.Clean(hdr.Linkname)] {
+				logrus.Debugf("Skipping hard link %q as its target %q is not copied", hdr.Name, hdr.Linkname)
+				continue
+			}
+			for _, dir := range pendingDirs {
+				if err := writeHeader(dir, path.Clean(dir.Name)); err != nil {
+					return err
+				}
+			}
+			pendingDirs = pendingDirs[:0]
+			if hdr.Typeflag == tar.TypeReg {
+				written[name] = true
+			}
+		}
+
+		if f.NoContents && hdr.Typeflag == tar.TypeReg {
+			if hdr.PAXRecords == nil {
+				hdr.PAXRecords = make(map[string]string)
+			}
+			hdr.PAXRecords[ContentSizeRecord] = strconv.FormatInt(hdr.Size, 10)
+			hdr.Size = 0
+			hdr.Format = tar.FormatPAX
+		}
+		if err := writeHeader(hdr, name); err != nil {
+			return err
+		}
+		if hdr.Size == 0 {
+			continue
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// ExistingDirectory returns a function suitable for
+// ArchiveFilter.SkipDirectory, which reports whether an entry of an archive
+// extracted to dir, after applying the rename map, is an existing directory.
+// The path is resolved in the scope of root.
+func ExistingDirectory(root, dir string, rename map[string]string) func(name string) bool {
+	return func(name string) bool {
+		target, err := securejoin.SecureJoin(root, filepath.Join(dir, filepath.FromSlash(renameEntry(rename, name))))
+		if err != nil {
+			return false
+		}
+		info, err := os.Lstat(target)
+		return err == nil && info.IsDir()
+	}
+}
+
+// renameEntry maps the name of an entry with the rename map the same way
+// the copier package does.
+func renameEntry(rename map[string]string, name string) string {
+	if newName, ok := rename[name]; ok {
+		return newName
+	}
+	for dir := path.Dir(name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if newDir, ok := rename[dir]; ok {
+			return path.Join(newDir, strings.TrimPrefix(name, dir+"/"))
+		}
+	}
+	return name
+}
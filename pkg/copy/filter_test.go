@@ -0,0 +1,136 @@
+package copy
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeArchive(t *testing.T, entries ...*tar.Header) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	for _, hdr := range entries {
+		if hdr.Typeflag == tar.TypeReg {
+			hdr.Size = int64(len(hdr.Name))
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte(hdr.Name))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	return buf
+}
+
+func archiveNames(t *testing.T, r io.Reader) []string {
+	var names []string
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return names
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if hdr.Typeflag == tar.TypeReg {
+			assert.Equal(t, hdr.Name, string(content))
+		}
+		names = append(names, hdr.Name)
+	}
+}
+
+func dir(name string) *tar.Header { return &tar.Header{Name: name, Typeflag: tar.TypeDir, Mode: 0o755} }
+func file(name string) *tar.Header {
+	return &tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644}
+}
+
+func TestArchiveFilter(t *testing.T) {
+	entries := func() []*tar.Header {
+		return []*tar.Header{
+			dir("log/"),
+			file("log/app.log"),
+			file("log/app.log.gz"),
+			dir("log/nginx/"),
+			file("log/nginx/access.log"),
+			file("log/nginx/access.log.gz"),
+			dir("log/cache/"),
+			file("log/cache/data"),
+			{Name: "log/link.log", Typeflag: tar.TypeLink, Linkname: "log/app.log"},
+			{Name: "log/link.gz", Typeflag: tar.TypeLink, Linkname: "log/app.log.gz"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		includes []string
+		excludes []string
+		expected []string
+	}{
+		{
+			name:     "no patterns",
+			expected: []string{"log/", "log/app.log", "log/app.log.gz", "log/nginx/", "log/nginx/access.log", "log/nginx/access.log.gz", "log/cache/", "log/cache/data", "log/link.log", "log/link.gz"},
+		},
+		{
+			name:     "exclude matches at any level",
+			excludes: []string{"*.gz", "cache"},
+			expected: []string{"log/", "log/app.log", "log/nginx/", "log/nginx/access.log", "log/link.log"},
+		},
+		{
+			name:     "include keeps parent directories",
+			includes: []string{"nginx/*.log"},
+			expected: []string{"log/", "log/nginx/", "log/nginx/access.log"},
+		},
+		{
+			name:     "exclude wins over include",
+			includes: []string{"*.log*"},
+			excludes: []string{"*.gz"},
+			expected: []string{"log/", "log/app.log", "log/nginx/", "log/nginx/access.log", "log/link.log"},
+		},
+		{
+			name:     "include directory",
+			includes: []string{"cache"},
+			expected: []string{"log/", "log/cache/", "log/cache/data"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewArchiveFilter(tt.includes, tt.excludes)
+			require.NoError(t, err)
+			out := new(bytes.Buffer)
+			require.NoError(t, filter.Copy(out, makeArchive(t, entries()...)))
+			assert.Equal(t, tt.expected, archiveNames(t, out))
+		})
+	}
+}
+
+func TestArchiveFilterInvalidPattern(t *testing.T) {
+	_, err := NewArchiveFilter([]string{"[a-"}, nil)
+	assert.Error(t, err)
+	_, err = NewArchiveFilter(nil, []string{"!foo"})
+	assert.Error(t, err)
+}
+
+func TestArchiveFilterSkipDirectory(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "dest", "new", "sub"), 0o755))
+
+	filter, err := NewArchiveFilter(nil, nil)
+	require.NoError(t, err)
+	filter.SkipDirectory = ExistingDirectory(root, "/dest", map[string]string{"old": "new"})
+	var copied int
+	filter.Progress = func(hdr *tar.Header) { copied++ }
+
+	out := new(bytes.Buffer)
+	require.NoError(t, filter.Copy(out, makeArchive(t, dir("old/"), dir("old/sub/"), dir("old/other/"), file("old/sub/file"))))
+	assert.Equal(t, []string{"old/other/", "old/sub/file"}, archiveNames(t, out))
+	assert.Equal(t, 2, copied)
+}
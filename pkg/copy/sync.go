@@ -0,0 +1,378 @@
+package copy
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/containers/storage/pkg/fileutils"
+	securejoin "github.com/cyphar/filepath-securejoin"
+)
+
+// SyncEntry describes a file as far as needed to decide whether it has to be
+// synced.
+type SyncEntry struct {
+	// Mode holds the type and the permissions of the file.
+	Mode fs.FileMode
+	// Size is the size of a regular file.
+	Size int64
+	// ModTime is the modification time in seconds since the epoch.  Only
+	// seconds are compared as not all archive formats keep more.
+	ModTime int64
+	// Linkname is the target of a symbolic link.
+	Linkname string
+}
+
+// upToDate returns true if a file described by e does not need to be synced
+// to a file described by other.
+func (e SyncEntry) upToDate(other SyncEntry) bool {
+	if e.Mode != other.Mode {
+		return false
+	}
+	switch {
+	case e.Mode.IsDir():
+		// Directories change whenever their contents do.
+		return true
+	case e.Mode&fs.ModeSymlink != 0:
+		return e.Linkname == other.Linkname
+	}
+	return e.Size == other.Size && e.ModTime == other.ModTime
+}
+
+func syncEntryFromHeader(hdr *tar.Header) SyncEntry {
+	return SyncEntry{
+		Mode:     hdr.FileInfo().Mode(),
+		Size:     EntrySize(hdr),
+		ModTime:  hdr.ModTime.Unix(),
+		Linkname: hdr.Linkname,
+	}
+}
+
+// IsIgnored returns true if name, a slash-separated path relative to the
+// synced directory, or one of its parent directories is matched by ignore.
+func IsIgnored(ignore *fileutils.PatternMatcher, name string) bool {
+	if ignore == nil {
+		return false
+	}
+	matched, err := ignore.IsMatch(name)
+	return err == nil && matched
+}
+
+// ReadSyncEntries reads the entries of a tar archive, typically created
+// without contents, into a map indexed by their cleaned names.
+func ReadSyncEntries(r io.Reader) (map[string]SyncEntry, error) {
+	entries := make(map[string]SyncEntry)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading tar archive: %w", err)
+		}
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "/"))
+		if name == "." {
+			continue
+		}
+		entries[name] = syncEntryFromHeader(hdr)
+	}
+}
+
+// HostSyncEntries walks the directory root and returns its regular files,
+// directories and symbolic links which are not ignored, indexed by their
+// slash-separated paths relative to root.
+func HostSyncEntries(root string, ignore *fileutils.PatternMatcher) (map[string]SyncEntry, error) {
+	entries := make(map[string]SyncEntry)
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		name := filepath.ToSlash(rel)
+		if IsIgnored(ignore, name) {
+			// Exclusions may bring back files below an ignored
+			// directory, so only skip it without them.
+			if d.IsDir() && !ignore.Exclusions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		hdr, err := syncHeader(root, name)
+		if err != nil {
+			return err
+		}
+		if hdr != nil {
+			entries[name] = syncEntryFromHeader(hdr)
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// syncHeader returns the tar header of the file name below root or nil if
+// the file cannot be synced, for instance because it is a socket.
+func syncHeader(root, name string) (*tar.Header, error) {
+	p := filepath.Join(root, filepath.FromSlash(name))
+	info, err := os.Lstat(p)
+	if err != nil {
+		return nil, err
+	}
+	var link string
+	switch {
+	case info.Mode().IsRegular(), info.IsDir():
+	case info.Mode()&fs.ModeSymlink != 0:
+		if link, err = os.Readlink(p); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nil
+	}
+	hdr, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return nil, err
+	}
+	hdr.Name = name
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+	// The owner is set by the receiving side.
+	hdr.Uid, hdr.Gid, hdr.Uname, hdr.Gname = 0, 0, "", ""
+	// The tar writer rounds to seconds while the entries are compared
+	// truncated to seconds.
+	hdr.ModTime = hdr.ModTime.Truncate(time.Second)
+	hdr.AccessTime, hdr.ChangeTime = time.Time{}, time.Time{}
+	return hdr, nil
+}
+
+// SyncChanges compares the files of the source with the ones of the
+// destination.  It returns the sorted paths which are missing or outdated in
+// the destination, and the sorted paths of the destination which do not
+// exist in the source.  For removed directories, only the directory itself
+// is returned.  Paths of the destination matched by ignore are left alone.
+func SyncChanges(source, destination map[string]SyncEntry, ignore *fileutils.PatternMatcher) (changed, removed []string) {
+	for name, entry := range source {
+		if dst, ok := destination[name]; !ok || !entry.upToDate(dst) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range destination {
+		if _, ok := source[name]; !ok && !IsIgnored(ignore, name) {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(changed)
+	sort.Strings(removed)
+
+	// Sorting puts directories right before their contents.
+	topLevel := removed[:0]
+	for _, name := range removed {
+		if len(topLevel) > 0 && isParentOf(topLevel[len(topLevel)-1], name) {
+			continue
+		}
+		topLevel = append(topLevel, name)
+	}
+	return changed, topLevel
+}
+
+// WriteSyncArchive writes a tar archive of the files names, slash-separated
+// paths relative to root, to w.  Files which disappeared in the meantime or
+// which cannot be synced are left out.
+func WriteSyncArchive(w io.Writer, root string, names []string) error {
+	tw := tar.NewWriter(w)
+	for _, name := range names {
+		hdr, err := syncHeader(root, name)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		if hdr == nil {
+			continue
+		}
+		if err := writeSyncEntry(tw, root, hdr); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+func writeSyncEntry(tw *tar.Writer, root string, hdr *tar.Header) error {
+	if hdr.Typeflag != tar.TypeReg {
+		return tw.WriteHeader(hdr)
+	}
+	f, err := os.Open(filepath.Join(root, filepath.FromSlash(hdr.Name)))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(tw, f, hdr.Size); err != nil {
+		return fmt.Errorf("%s changed while syncing it: %w", hdr.Name, err)
+	}
+	return nil
+}
+
+// syncModeBits are the permission bits kept when extracting files.
+const syncModeBits = fs.ModePerm | fs.ModeSetuid | fs.ModeSetgid | fs.ModeSticky
+
+// ExtractSyncArchive extracts the regular files, directories and symbolic
+// links of the tar archive r below root.  The entry names are relative to
+// dir, a slash-separated path relative to root, and entries for which keep
+// returns false are skipped.  Files get the permissions and modification
+// times of the archive but are owned by the current user.  Existing files of
+// another type are replaced.
+func ExtractSyncArchive(r io.Reader, root, dir string, keep func(name string) bool) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading tar archive: %w", err)
+		}
+		name := path.Join(dir, path.Clean(strings.TrimPrefix(hdr.Name, "/")))
+		if name == "." || !keep(name) {
+			continue
+		}
+		if !filepath.IsLocal(filepath.FromSlash(name)) {
+			return fmt.Errorf("invalid path %q in tar archive", hdr.Name)
+		}
+		target, err := syncTarget(root, name)
+		if err != nil {
+			return err
+		}
+		if err := extractSyncEntry(tr, hdr, root, dir, target); err != nil {
+			return fmt.Errorf("extracting %s: %w", name, err)
+		}
+	}
+}
+
+// syncTarget returns the path of name below root, resolving symbolic links
+// of its parent directories within root but not name itself.
+func syncTarget(root, name string) (string, error) {
+	parent, err := securejoin.SecureJoin(root, path.Dir(name))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(parent, path.Base(name)), nil
+}
+
+func extractSyncEntry(tr *tar.Reader, hdr *tar.Header, root, dir, target string) error {
+	mode := hdr.FileInfo().Mode() & syncModeBits
+	info, err := os.Lstat(target)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	exists := err == nil
+	// Only directories are kept as they are, the other files are
+	// replaced as a whole.
+	if exists && (hdr.Typeflag != tar.TypeDir || !info.IsDir()) {
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.MkdirAll(target, 0o700); err != nil {
+			return err
+		}
+		return os.Chmod(target, mode)
+	case tar.TypeSymlink:
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		return os.Symlink(hdr.Linkname, target)
+	case tar.TypeLink:
+		source, err := syncTarget(root, path.Join(dir, path.Clean(strings.TrimPrefix(hdr.Linkname, "/"))))
+		if err != nil {
+			return err
+		}
+		// The target may have been skipped.
+		if err := os.Link(source, target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	case tar.TypeReg:
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		// Write to a temporary file so that the file is never seen
+		// half written.
+		f, err := os.CreateTemp(filepath.Dir(target), ".sync-")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(f.Name())
+		_, err = io.Copy(f, tr)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+		if err := os.Chmod(f.Name(), mode); err != nil {
+			return err
+		}
+		if err := os.Chtimes(f.Name(), hdr.ModTime, hdr.ModTime); err != nil {
+			return err
+		}
+		return os.Rename(f.Name(), target)
+	}
+	// Devices, FIFOs and the like cannot be synced.
+	return nil
+}
+
+// CreateSyncSymlink creates the symbolic link name, a slash-separated path
+// relative to root, pointing to linkname.  An existing file is replaced.
+func CreateSyncSymlink(root, name, linkname string) error {
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return fmt.Errorf("invalid path %q for symbolic link", name)
+	}
+	target, err := syncTarget(root, name)
+	if err != nil {
+		return err
+	}
+	hdr := &tar.Header{Typeflag: tar.TypeSymlink, Name: name, Linkname: linkname, Mode: 0o777}
+	return extractSyncEntry(nil, hdr, root, ".", target)
+}
+
+// RemoveSyncPaths removes the files and directories names, slash-separated
+// paths relative to root, including their contents.
+func RemoveSyncPaths(root string, names []string) error {
+	for _, name := range names {
+		if !filepath.IsLocal(filepath.FromSlash(name)) {
+			return fmt.Errorf("invalid path %q to remove", name)
+		}
+		target, err := syncTarget(root, name)
+		if err != nil {
+			return err
+		}
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
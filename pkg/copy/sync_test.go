@@ -0,0 +1,118 @@
+package copy
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/containers/storage/pkg/fileutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSync(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "src", "pkg"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "node_modules", "dep"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "src", "main.go"), []byte("package main"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "src", "pkg", "lib.go"), []byte("package pkg"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "node_modules", "dep", "index.js"), []byte("{}"), 0o644))
+	require.NoError(t, os.Symlink("src/main.go", filepath.Join(src, "main")))
+
+	ignore, err := fileutils.NewPatternMatcher([]string{"node_modules"})
+	require.NoError(t, err)
+	source, err := HostSyncEntries(src, ignore)
+	require.NoError(t, err)
+
+	// Nothing exists at the destination yet.
+	changed, removed := SyncChanges(source, nil, ignore)
+	assert.Equal(t, []string{"main", "src", "src/main.go", "src/pkg", "src/pkg/lib.go"}, changed)
+	assert.Empty(t, removed)
+
+	// Transfer the archive without contents, as a container would list
+	// its files.
+	archive := new(bytes.Buffer)
+	require.NoError(t, WriteSyncArchive(archive, src, changed))
+	filter, err := NewArchiveFilter(nil, nil)
+	require.NoError(t, err)
+	filter.NoContents = true
+	listing := new(bytes.Buffer)
+	require.NoError(t, filter.Copy(listing, archive))
+	destination, err := ReadSyncEntries(listing)
+	require.NoError(t, err)
+	assert.Equal(t, source, destination)
+
+	changed, removed = SyncChanges(source, destination, ignore)
+	assert.Empty(t, changed)
+	assert.Empty(t, removed)
+
+	// Modify, add and remove files.
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(src, "src", "main.go"), later, later))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "README"), []byte("hello"), 0o644))
+	require.NoError(t, os.RemoveAll(filepath.Join(src, "src", "pkg")))
+	destination["node_modules"] = SyncEntry{Mode: os.ModeDir | 0o755}
+
+	source, err = HostSyncEntries(src, ignore)
+	require.NoError(t, err)
+	changed, removed = SyncChanges(source, destination, ignore)
+	assert.Equal(t, []string{"README", "src/main.go"}, changed)
+	assert.Equal(t, []string{"src/pkg"}, removed)
+}
+
+func TestExtractSyncArchive(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "src", "pkg"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "src", "main.go"), []byte("package main"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "src", "pkg", "lib.go"), []byte("package pkg"), 0o644))
+	require.NoError(t, os.Symlink("src/main.go", filepath.Join(src, "main")))
+	earlier := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(src, "src", "main.go"), earlier, earlier))
+	source, err := HostSyncEntries(src, nil)
+	require.NoError(t, err)
+
+	dst := t.TempDir()
+	// Files of another type are replaced.
+	require.NoError(t, os.MkdirAll(filepath.Join(dst, "main"), 0o755))
+	changed, _ := SyncChanges(source, nil, nil)
+	archive := new(bytes.Buffer)
+	require.NoError(t, WriteSyncArchive(archive, src, changed))
+	require.NoError(t, ExtractSyncArchive(archive, dst, ".", func(name string) bool { return name != "src/pkg/lib.go" }))
+
+	destination, err := HostSyncEntries(dst, nil)
+	require.NoError(t, err)
+	delete(source, "src/pkg/lib.go")
+	assert.Equal(t, source, destination)
+	content, err := os.ReadFile(filepath.Join(dst, "main"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main", string(content))
+
+	// Entries must stay below the root.
+	archive.Reset()
+	require.NoError(t, WriteSyncArchive(archive, src, []string{"main"}))
+	err = ExtractSyncArchive(archive, dst, "..", func(string) bool { return true })
+	assert.ErrorContains(t, err, `invalid path "main" in tar archive`)
+
+	require.NoError(t, CreateSyncSymlink(dst, "main", "src/pkg"))
+	link, err := os.Readlink(filepath.Join(dst, "main"))
+	require.NoError(t, err)
+	assert.Equal(t, "src/pkg", link)
+
+	require.NoError(t, RemoveSyncPaths(dst, []string{"src", "missing"}))
+	destination, err = HostSyncEntries(dst, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main"}, sortedKeys(destination))
+	assert.ErrorContains(t, RemoveSyncPaths(dst, []string{"../main"}), `invalid path "../main" to remove`)
+}
+
+func sortedKeys(entries map[string]SyncEntry) []string {
+	keys := make([]string, 0, len(entries))
+	for name := range entries {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -6,7 +6,6 @@ import (
 	"os"
 	"time"
 
-	nettypes "github.com/containers/common/libnetwork/types"
 	imageTypes "github.com/containers/image/v5/types"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities/types"
@@ -62,10 +61,27 @@ type WaitOptions struct {
 	Ignore bool
 	// Use the latest created container.
 	Latest bool
+	// Filters select the containers to wait on.
+	Filters map[string][]string
+	// Timeout after which waiting is given up, zero means no timeout.
+	Timeout time.Duration
+	// Any returns once one of the containers is done waiting instead of
+	// waiting for all of them.
+	Any bool
+	// UntilLog waits until the container logs a line matching this
+	// regular expression.
+	UntilLog string
+	// UntilPort waits until this TCP port accepts connections on the
+	// loopback interface of the container's network namespace.
+	UntilPort uint16
 }
 
 // WaitReport is the result of waiting a container.
 type WaitReport struct {
+	// Id of the container.
+	Id string //nolint:revive,stylecheck
+	// RawInput is the name or ID given by the user.
+	RawInput string
 	// Error while waiting.
 	Error error
 	// ExitCode of the container.
@@ -118,9 +134,10 @@ type TopOptions struct {
 }
 
 type KillOptions struct {
-	All    bool
-	Latest bool
-	Signal string
+	All     bool
+	Filters map[string][]string
+	Latest  bool
+	Signal  string
 }
 
 type KillReport struct {
@@ -184,6 +201,31 @@ type CopyOptions struct {
 	// NoOverwriteDirNonDir when true prevents an existing directory or file from being overwritten
 	// by the other type
 	NoOverwriteDirNonDir bool
+	// NoOverwriteDir when true keeps the metadata of existing directories.
+	NoOverwriteDir bool
+	// StripXattrs when true drops the extended attributes of the copied files.
+	StripXattrs bool
+	// StripSpecialBits when true drops the setuid, setgid and sticky bits
+	// of the copied files.
+	StripSpecialBits bool
+	// Remove are paths relative to the destination which are removed
+	// before the archive is extracted.
+	Remove []string
+}
+
+// CopyToArchiveOptions are the options for ContainerCopyToArchive.
+type CopyToArchiveOptions struct {
+	// Excludes are patterns of paths which are not copied.
+	Excludes []string
+	// Includes are patterns of paths which are copied. If set, only
+	// matching paths and their parent directories are copied.
+	Includes []string
+	// NoChown when true keeps the user and group IDs of the files
+	// instead of changing them to the container user on the host.
+	NoChown bool
+	// NoContents leaves out the contents of regular files.  Their size
+	// is recorded in the copy.ContentSizeRecord PAX record instead.
+	NoContents bool
 }
 
 type CommitReport struct {
@@ -194,8 +236,24 @@ type ContainerExportOptions struct {
 	Output io.Writer
 }
 
+// ContainerNetCaptureOptions describes the options to capture packets in the
+// network namespace of a container.
+type ContainerNetCaptureOptions struct {
+	// Interface to capture packets on, all interfaces if empty.
+	Interface string
+	// Filter is a pcap-filter expression packets must match.
+	Filter string
+	// Snaplen is the maximum number of bytes captured of a packet.
+	Snaplen uint32
+	// Count stops the capture after the number of packets.
+	Count uint64
+	// Output receives the packets in the pcapng format.
+	Output io.Writer
+}
+
 type CheckpointOptions struct {
 	All            bool
+	Filters        map[string][]string
 	Export         string
 	CreateImage    string
 	IgnoreRootFS   bool
@@ -290,6 +348,20 @@ type ExecOptions struct {
 	Tty         bool
 	User        string
 	WorkDir     string
+	// Name of the exec session, which can be used to attach to it later.
+	Name string
+}
+
+// ExecAttachOptions describes the cli values to attach to a running exec
+// session
+type ExecAttachOptions struct {
+	// DetachKeys overrides the detach keys of the exec session if set.
+	DetachKeys *string
+	// NoStdin disables forwarding of stdin to the exec session.
+	NoStdin bool
+	Stdin   *os.File
+	Stdout  *os.File
+	Stderr  *os.File
 }
 
 // ContainerExistsOptions describes the cli values to check if a container exists
@@ -443,10 +515,7 @@ type ContainerPortOptions struct {
 
 // ContainerPortReport describes the output needed for
 // the CLI to output ports
-type ContainerPortReport struct {
-	Id    string //nolint:revive,stylecheck
-	Ports []nettypes.PortMapping
-}
+type ContainerPortReport = types.ContainerPortReport
 
 // ContainerCpOptions describes input options for cp.
 type ContainerCpOptions struct {
@@ -457,6 +526,16 @@ type ContainerCpOptions struct {
 	// OverwriteDirNonDir allows for overwriting a directory with a
 	// non-directory and vice versa.
 	OverwriteDirNonDir bool
+	// NoOverwriteDir keeps the metadata of existing directories.
+	NoOverwriteDir bool
+	// Excludes are patterns of paths which are not copied.
+	Excludes []string
+	// Includes are patterns of paths which are copied.
+	Includes []string
+	// Preserve lists the attributes of the files to keep.
+	Preserve []string
+	// Progress shows the number of files and bytes copied.
+	Progress bool
 }
 
 // ContainerStatsOptions describes input options for getting
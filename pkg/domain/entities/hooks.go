@@ -0,0 +1,20 @@
+package entities
+
+import "github.com/containers/podman/v5/libpod/define"
+
+// HooksValidateOptions describe the hypothetical container an OCI hook
+// configuration file is validated against.
+type HooksValidateOptions struct {
+	Annotations   map[string]string
+	Command       string
+	HasBindMounts bool
+}
+
+// HooksValidateReport describes the result of validating an OCI hook
+// configuration file.
+type HooksValidateReport struct {
+	*define.OCIHook
+	// Match is true if the hook would be used by the hypothetical
+	// container.
+	Match bool `json:"Match"`
+}
@@ -246,6 +246,9 @@ type ImagePruneOptions struct {
 	All      bool     `json:"all" schema:"all"`
 	External bool     `json:"external" schema:"external"`
 	Filter   []string `json:"filter" schema:"filter"`
+	// Policy removes the images selected by the image retention policy
+	// configured in containers.conf instead of dangling or unused ones.
+	Policy bool `json:"policy" schema:"policy"`
 }
 
 type ImageTagOptions struct{}
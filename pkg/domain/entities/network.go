@@ -3,6 +3,7 @@ package entities
 import (
 	"net"
 
+	"github.com/containers/common/libnetwork/types"
 	entitiesTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
 )
 
@@ -23,6 +24,10 @@ type NetworkReloadOptions struct {
 // NetworkReloadReport describes the results of reloading a container network.
 type NetworkReloadReport = entitiesTypes.NetworkReloadReport
 
+// NetworkInspectLiveReport describes a network and the containers connected
+// to it.
+type NetworkInspectLiveReport = entitiesTypes.NetworkInspectLiveReport
+
 // NetworkRmOptions describes options for removing networks
 type NetworkRmOptions struct {
 	Force   bool
@@ -57,6 +62,24 @@ type NetworkCreateOptions struct {
 type NetworkUpdateOptions struct {
 	AddDNSServers    []string `json:"adddnsservers"`
 	RemoveDNSServers []string `json:"removednsservers"`
+	// Subnets to add to the network.
+	AddSubnets []types.Subnet `json:"addsubnets,omitempty"`
+	// Subnets to remove from the network.
+	RemoveSubnets []types.IPNet `json:"removesubnets,omitempty"`
+	// New gateways, each one is set for the subnet which contains it.
+	Gateways []net.IP `json:"gateways,omitempty"`
+	// New IP ranges, each one is set for the subnet which contains it.
+	IPRanges []types.LeaseRange `json:"ipranges,omitempty"`
+	// Internal restricts external access from the network when set.
+	Internal *bool `json:"internal,omitempty"`
+	// Labels to add or overwrite.
+	AddLabels map[string]string `json:"addlabels,omitempty"`
+	// Names of the labels to remove.
+	RemoveLabels []string `json:"removelabels,omitempty"`
+	// Driver options to add or overwrite.
+	AddOptions map[string]string `json:"addoptions,omitempty"`
+	// Names of the driver options to remove.
+	RemoveOptions []string `json:"removeoptions,omitempty"`
 }
 
 // NetworkCreateReport describes a created network for the cli
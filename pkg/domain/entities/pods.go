@@ -12,9 +12,10 @@ import (
 )
 
 type PodKillOptions struct {
-	All    bool
-	Latest bool
-	Signal string
+	All     bool
+	Filters map[string][]string
+	Latest  bool
+	Signal  string
 }
 
 type PodKillReport = types.PodKillReport
@@ -24,21 +25,24 @@ type ListPodsReport = types.ListPodsReport
 type ListPodContainer = types.ListPodContainer
 
 type PodPauseOptions struct {
-	All    bool
-	Latest bool
+	All     bool
+	Filters map[string][]string
+	Latest  bool
 }
 
 type PodPauseReport = types.PodPauseReport
 
 type PodunpauseOptions struct {
-	All    bool
-	Latest bool
+	All     bool
+	Filters map[string][]string
+	Latest  bool
 }
 
 type PodUnpauseReport = types.PodUnpauseReport
 
 type PodStopOptions struct {
 	All     bool
+	Filters map[string][]string
 	Ignore  bool
 	Latest  bool
 	Timeout int
@@ -47,20 +51,23 @@ type PodStopOptions struct {
 type PodStopReport = types.PodStopReport
 
 type PodRestartOptions struct {
-	All    bool
-	Latest bool
+	All     bool
+	Filters map[string][]string
+	Latest  bool
 }
 
 type PodRestartReport = types.PodRestartReport
 type PodStartOptions struct {
-	All    bool
-	Latest bool
+	All     bool
+	Filters map[string][]string
+	Latest  bool
 }
 
 type PodStartReport = types.PodStartReport
 
 type PodRmOptions struct {
 	All     bool
+	Filters map[string][]string
 	Force   bool
 	Ignore  bool
 	Latest  bool
@@ -93,6 +100,7 @@ type PodCreateOptions struct {
 	Share              []string          `json:"share,omitempty"`
 	ShareParent        *bool             `json:"share_parent,omitempty"`
 	Restart            string            `json:"restart,omitempty"`
+	RestartPod         string            `json:"restart_pod,omitempty"`
 	Pid                string            `json:"pid,omitempty"`
 	Cpus               float64           `json:"cpus,omitempty"`
 	CpusetCpus         string            `json:"cpuset_cpus,omitempty"`
@@ -174,6 +182,7 @@ type ContainerCreateOptions struct {
 	HealthStartPeriod  string
 	HealthTimeout      string
 	HealthOnFailure    string
+	HealthCheckpoints  uint
 	Hostname           string `json:"hostname,omitempty"`
 	HTTPProxy          bool
 	HostUsers          []string
@@ -203,6 +212,7 @@ type ContainerCreateOptions struct {
 	PIDsLimit          *int64
 	Platform           string
 	Pod                string
+	PodCritical        bool
 	PodIDFile          string
 	Personality        string
 	PreserveFDs        uint
@@ -214,8 +224,12 @@ type ContainerCreateOptions struct {
 	ReadOnly           bool
 	ReadWriteTmpFS     bool
 	Restart            string
+	RestartDelay       string
+	RestartDelayReset  string
+	RestartMaxDelay    string
 	Replace            bool
 	Requires           []string
+	RequiresTimeout    string
 	Rm                 bool
 	RootFS             bool
 	Secrets            []string
@@ -347,6 +361,14 @@ func ToPodSpecGen(s specgen.PodSpecGenerator, p *PodCreateOptions) (*specgen.Pod
 		s.RestartPolicy = policy
 		s.RestartRetries = &retries
 	}
+	if p.RestartPod != "" {
+		policy, retries, err := util.ParseRestartPolicy(p.RestartPod)
+		if err != nil {
+			return nil, err
+		}
+		s.RestartPodPolicy = policy
+		s.RestartPodRetries = &retries
+	}
 
 	// Networking config
 
@@ -355,6 +377,8 @@ func ToPodSpecGen(s specgen.PodSpecGenerator, p *PodCreateOptions) (*specgen.Pod
 		s.PortMappings = p.Net.PublishPorts
 		s.Networks = p.Net.Networks
 		s.NetworkOptions = p.Net.NetworkOptions
+		s.NetworkRates = p.Net.NetworkRates
+		s.HostsGroup = p.Net.HostsGroup
 		if p.Net.UseImageResolvConf {
 			s.NoManageResolvConf = true
 		}
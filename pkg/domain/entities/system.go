@@ -9,11 +9,21 @@ type ServiceOptions = types.ServiceOptions
 type SystemPruneOptions = types.SystemPruneOptions
 type SystemPruneReport = types.SystemPruneReport
 type SystemMigrateOptions = types.SystemMigrateOptions
+type SystemExportOptions = types.SystemExportOptions
+type SystemImportOptions = types.SystemImportOptions
+type SystemImportReport = types.SystemImportReport
+type SystemCheckOptions = types.SystemCheckOptions
+type SystemCheckReport = types.SystemCheckReport
 type SystemDfOptions = types.SystemDfOptions
 type SystemDfReport = types.SystemDfReport
 type SystemDfImageReport = types.SystemDfImageReport
 type SystemDfContainerReport = types.SystemDfContainerReport
 type SystemDfVolumeReport = types.SystemDfVolumeReport
+type SystemDfAnalysisReport = types.SystemDfAnalysisReport
+type SystemDfImageAnalysis = types.SystemDfImageAnalysis
+type SystemDfLayerReport = types.SystemDfLayerReport
+type SystemDfBuildCacheReport = types.SystemDfBuildCacheReport
+type SystemDfPruneEstimate = types.SystemDfPruneEstimate
 type SystemVersionReport = types.SystemVersionReport
 type SystemUnshareOptions = types.SystemUnshareOptions
 type ComponentVersion = types.SystemComponentVersion
@@ -60,6 +60,10 @@ type NetOptions struct {
 	PublishPorts       []types.PortMapping                `json:"portmappings,omitempty"`
 	// NetworkOptions are additional options for each network
 	NetworkOptions map[string][]string `json:"network_options,omitempty"`
+	// NetworkRates are the bandwidth limits for each network
+	NetworkRates map[string]define.NetworkRate `json:"network_rates,omitempty"`
+	// HostsGroup is the group of containers sharing /etc/hosts entries
+	HostsGroup string `json:"hosts_group,omitempty"`
 }
 
 // InspectOptions all CLI inspect commands and inspect sub-commands use the same options
@@ -74,6 +78,10 @@ type InspectOptions struct {
 	Type string `json:",omitempty"`
 	// All -- inspect all
 	All bool `json:",omitempty"`
+	// Live (networks only) - include the connected containers.
+	Live bool `json:",omitempty"`
+	// ShowHooks (containers only) - include the matching OCI hooks.
+	ShowHooks bool `json:",omitempty"`
 }
 
 // DiffOptions all API and CLI diff commands and diff sub-commands use the same options
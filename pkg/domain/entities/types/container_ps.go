@@ -58,6 +58,9 @@ type ListContainer struct {
 	// restart policy. This is NOT incremented by normal container restarts
 	// (only by restart policy).
 	Restarts uint
+	// RestartingAt is the time at which the container is restarted by
+	// its restart policy after the backoff delay, 0 if it is not waiting.
+	RestartingAt int64 `json:",omitempty"`
 	// Size of the container rootfs.  Requires the size boolean to be true
 	Size *define.ContainerSize
 	// Time when container started
@@ -1,12 +1,20 @@
 package types
 
 import (
+	nettypes "github.com/containers/common/libnetwork/types"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/specgen"
 )
 
 type ContainerCopyFunc func() error
 
+// ContainerPortReport describes the output needed for
+// the CLI to output ports
+type ContainerPortReport struct {
+	Id    string //nolint:revive,stylecheck
+	Ports []nettypes.PortMapping
+}
+
 type ContainerStatReport struct {
 	define.FileInfo
 }
@@ -37,5 +45,9 @@ type ContainerStatsReport struct {
 
 type ContainerUpdateOptions struct {
 	NameOrID string
-	Specgen  *specgen.SpecGenerator
+	// Specgen holds the new resource limits, they are not changed if it
+	// is nil.
+	Specgen *specgen.SpecGenerator
+	// NetworkRates replace the bandwidth limits of the given networks.
+	NetworkRates map[string]define.NetworkRate
 }
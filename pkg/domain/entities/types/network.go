@@ -2,6 +2,7 @@ package types
 
 import (
 	commonTypes "github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
 )
 
 // NetworkPruneReport containers the name of network and an error
@@ -35,3 +36,11 @@ type NetworkRmReport struct {
 type NetworkCreateReport struct {
 	Name string
 }
+
+// NetworkInspectLiveReport is the network configuration together with all
+// containers connected to the network.
+type NetworkInspectLiveReport struct {
+	commonTypes.Network
+	// Endpoints are the containers connected to the network.
+	Endpoints []define.NetworkEndpoint `json:"endpoints"`
+}
@@ -37,12 +37,55 @@ type SystemPruneReport struct {
 // cli to migrate runtimes of containers
 type SystemMigrateOptions struct {
 	NewRuntime string
+	// Database is the database backend to migrate the state to.
+	Database string
+	// DryRun verifies the database migration without applying it.
+	DryRun bool
+	// Rollback undoes a previous database migration.
+	Rollback bool
+}
+
+// SystemExportOptions describes the options for exporting the local state
+// into a bundle
+type SystemExportOptions struct {
+	Output string
+}
+
+// SystemImportOptions describes the options for importing a bundle written
+// by system export
+type SystemImportOptions struct {
+	Input string
+}
+
+// SystemImportReport lists the objects created by system import
+type SystemImportReport struct {
+	Images     []string
+	Volumes    []string
+	Networks   []string
+	Pods       []string
+	Containers []string
+	Quadlets   []string
+}
+
+// SystemCheckOptions describes the options for checking the consistency of
+// the local state
+type SystemCheckOptions struct {
+	Quick                       bool
+	Repair                      bool
+	RepairLossy                 bool
+	UnreferencedLayerMaximumAge *time.Duration
+}
+
+// SystemCheckReport is the result of a system check
+type SystemCheckReport struct {
+	define.SystemCheckReport
 }
 
 // SystemDfOptions describes the options for getting df information
 type SystemDfOptions struct {
 	Format  string
 	Verbose bool
+	Analyze bool
 }
 
 // SystemDfReport describes the response for df information
@@ -51,6 +94,8 @@ type SystemDfReport struct {
 	Images     []*SystemDfImageReport
 	Containers []*SystemDfContainerReport
 	Volumes    []*SystemDfVolumeReport
+	// Analysis is only set when requested via SystemDfOptions.Analyze
+	Analysis *SystemDfAnalysisReport `json:",omitempty"`
 }
 
 // SystemDfImageReport describes an image for use with df
@@ -86,6 +131,63 @@ type SystemDfVolumeReport struct {
 	ReclaimableSize int64
 }
 
+// SystemDfAnalysisReport describes how image layers are shared between
+// images and how much space pruning would reclaim
+type SystemDfAnalysisReport struct {
+	Images     []*SystemDfImageAnalysis
+	Layers     []*SystemDfLayerReport
+	BuildCache []*SystemDfBuildCacheReport
+	Prune      SystemDfPruneEstimate
+}
+
+// SystemDfImageAnalysis describes the exclusive and shared size of an image
+type SystemDfImageAnalysis struct {
+	ImageID string
+	Names   []string
+	Layers  int
+	Size    int64
+	// ExclusiveSize is the size of the layers only referenced by this
+	// image, i.e. the space freed when removing it
+	ExclusiveSize int64
+	// SharedSize is the size of the layers also referenced by other images
+	SharedSize int64
+	Containers int
+}
+
+// SystemDfLayerReport describes a storage layer and the images referencing it
+type SystemDfLayerReport struct {
+	LayerID string
+	Size    int64
+	Images  []string
+}
+
+// SystemDfBuildCacheReport describes an untagged image left behind by builds
+type SystemDfBuildCacheReport struct {
+	ImageID string
+	Created time.Time
+	Size    int64
+	// Intermediate is true if other images are based on this one
+	Intermediate bool
+	// ReclaimableSize is the size of the layers only referenced by this
+	// image
+	ReclaimableSize int64
+}
+
+// SystemDfPruneEstimate describes how much space the individual parts of
+// `system prune` would reclaim
+type SystemDfPruneEstimate struct {
+	// Containers is the size of all containers not running
+	Containers int64
+	// DanglingImages is the space freed by pruning dangling images
+	DanglingImages int64
+	// UnusedImages is the space freed by pruning all images not used by a
+	// running container (`--all`)
+	UnusedImages int64
+	// Volumes is the size of all volumes not used by any container
+	// (`--volumes`)
+	Volumes int64
+}
+
 // SystemVersionReport describes version information about the running Podman service
 type SystemVersionReport struct {
 	// Always populated
@@ -14,6 +14,7 @@ type VolumeConfigResponse = types.VolumeConfigResponse
 
 type VolumeRmOptions struct {
 	All     bool
+	Filters map[string][]string
 	Force   bool
 	Ignore  bool
 	Timeout *uint
@@ -5,6 +5,7 @@ package filters
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/containers/common/pkg/util"
 	"github.com/containers/podman/v5/libpod"
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/opencontainers/go-digest"
 	"golang.org/x/exp/slices"
 )
 
@@ -42,19 +44,128 @@ func GenerateContainerFilterFuncs(filter string, filterValues []string, r *libpo
 			return util.StringMatchRegexSlice(c.Name(), filters)
 		}, nil
 	case "exited":
-		var exitCodes []int32
-		for _, exitCode := range filterValues {
-			ec, err := strconv.ParseInt(exitCode, 10, 32)
-			if err != nil {
-				return nil, fmt.Errorf("exited code out of range %q: %w", ec, err)
-			}
-			exitCodes = append(exitCodes, int32(ec))
+		exitCodes, err := parseNumericFilters(filter, filterValues)
+		if err != nil {
+			return nil, err
 		}
 		return func(c *libpod.Container) bool {
 			ec, exited, err := c.ExitCode()
 			if err == nil && exited {
-				for _, exitCode := range exitCodes {
-					if ec == exitCode {
+				return matchNumericFilters(exitCodes, int64(ec))
+			}
+			return false
+		}, nil
+	case "restart-count":
+		restartCounts, err := parseNumericFilters(filter, filterValues)
+		if err != nil {
+			return nil, err
+		}
+		return func(c *libpod.Container) bool {
+			count, err := c.RestartCount()
+			if err != nil {
+				return false
+			}
+			return matchNumericFilters(restartCounts, int64(count))
+		}, nil
+	case "oom-killed":
+		if len(filterValues) != 1 {
+			return nil, fmt.Errorf("specify exactly one value for %s", filter)
+		}
+		want, err := strconv.ParseBool(filterValues[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s filter value %q: %w", filter, filterValues[0], err)
+		}
+		return func(c *libpod.Container) bool {
+			oomKilled, err := c.OOMKilled()
+			return err == nil && oomKilled == want
+		}, nil
+	case "started-since":
+		if len(filterValues) != 1 {
+			return nil, fmt.Errorf("specify exactly one timestamp for %s", filter)
+		}
+		since, err := filters.ComputeUntilTimestamp(filterValues)
+		if err != nil {
+			return nil, err
+		}
+		return func(c *libpod.Container) bool {
+			started, err := c.StartedTime()
+			return err == nil && !started.IsZero() && started.After(since)
+		}, nil
+	case "command":
+		var patterns []*regexp.Regexp
+		for _, filterValue := range filterValues {
+			patterns = append(patterns, globToRegexp(filterValue))
+		}
+		return func(c *libpod.Container) bool {
+			command := strings.Join(c.Command(), " ")
+			for _, pattern := range patterns {
+				if pattern.MatchString(command) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case "image-digest":
+		var digests []digest.Digest
+		for _, filterValue := range filterValues {
+			d, err := digest.Parse(filterValue)
+			if err != nil {
+				return nil, fmt.Errorf("invalid image digest %q: %w", filterValue, err)
+			}
+			digests = append(digests, d)
+		}
+		// Containers share images, look each of them up only once.
+		imageMatches := make(map[string]bool)
+		return func(c *libpod.Container) bool {
+			imageID, _ := c.Image()
+			if imageID == "" {
+				return false
+			}
+			if match, ok := imageMatches[imageID]; ok {
+				return match
+			}
+			match := false
+			img, _, err := r.LibimageRuntime().LookupImage(imageID, nil)
+			if err == nil {
+				for _, d := range img.Digests() {
+					if slices.Contains(digests, d) {
+						match = true
+						break
+					}
+				}
+			}
+			imageMatches[imageID] = match
+			return match
+		}, nil
+	case "publish", "expose":
+		var ports []portFilter
+		for _, filterValue := range filterValues {
+			port, err := parsePortFilter(filterValue)
+			if err != nil {
+				return nil, err
+			}
+			ports = append(ports, port)
+		}
+		if filter == "expose" {
+			return func(c *libpod.Container) bool {
+				for containerPort, protocols := range c.ConfigNoCopy().ExposedPorts {
+					for _, protocol := range protocols {
+						if matchPortFilters(ports, containerPort, 1, protocol) {
+							return true
+						}
+					}
+				}
+				return false
+			}, nil
+		}
+		return func(c *libpod.Container) bool {
+			mappings, err := c.PortMappings()
+			if err != nil {
+				return false
+			}
+			for _, mapping := range mappings {
+				for _, protocol := range strings.Split(mapping.Protocol, ",") {
+					if matchPortFilters(ports, mapping.ContainerPort, mapping.Range, protocol) {
 						return true
 					}
 				}
@@ -299,6 +410,8 @@ func GeneratePruneContainerFilterFuncs(filter string, filterValues []string, r *
 		}, nil
 	case "until":
 		return prepareUntilFilterFunc(filterValues)
+	case "exited", "restart-count", "oom-killed", "started-since", "command", "image-digest", "publish", "expose":
+		return GenerateContainerFilterFuncs(filter, filterValues, r)
 	}
 	return nil, fmt.Errorf("%s is an invalid filter", filter)
 }
@@ -315,3 +428,111 @@ func prepareUntilFilterFunc(filterValues []string) (func(container *libpod.Conta
 		return false
 	}, nil
 }
+
+// numericFilter is a comparison of a numeric filter value, for instance the
+// ">0" of "exited>0".
+type numericFilter struct {
+	op    string
+	value int64
+}
+
+// parseNumericFilters parses values of the form [OP]N where OP is one of =,
+// <, <=, > or >=.  A missing OP means =.
+func parseNumericFilters(filter string, filterValues []string) ([]numericFilter, error) {
+	var numericFilters []numericFilter
+	for _, filterValue := range filterValues {
+		op := "="
+		for _, prefix := range []string{"<=", ">=", "=", "<", ">"} {
+			if rest, ok := strings.CutPrefix(filterValue, prefix); ok {
+				op = prefix
+				filterValue = rest
+				break
+			}
+		}
+		value, err := strconv.ParseInt(filterValue, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s filter value %q: %w", filter, filterValue, err)
+		}
+		numericFilters = append(numericFilters, numericFilter{op: op, value: value})
+	}
+	return numericFilters, nil
+}
+
+// matchNumericFilters returns true if value satisfies any of the filters.
+func matchNumericFilters(numericFilters []numericFilter, value int64) bool {
+	for _, f := range numericFilters {
+		var match bool
+		switch f.op {
+		case "=":
+			match = value == f.value
+		case "<":
+			match = value < f.value
+		case "<=":
+			match = value <= f.value
+		case ">":
+			match = value > f.value
+		case ">=":
+			match = value >= f.value
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// globToRegexp converts a glob pattern, where * matches any string and ?
+// any single character, to an anchored regular expression.
+func globToRegexp(pattern string) *regexp.Regexp {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	return regexp.MustCompile("^" + expr + "$")
+}
+
+// portFilter is a port range of a publish or expose filter.
+type portFilter struct {
+	start    uint16
+	end      uint16
+	protocol string
+}
+
+// parsePortFilter parses <port>[/<proto>] or <startport>-<endport>[/<proto>].
+// Like Docker, the protocol defaults to tcp.
+func parsePortFilter(filterValue string) (portFilter, error) {
+	ports, protocol, hasProtocol := strings.Cut(filterValue, "/")
+	if !hasProtocol {
+		protocol = "tcp"
+	}
+	startPort, endPort, isRange := strings.Cut(ports, "-")
+	start, err := strconv.ParseUint(startPort, 10, 16)
+	if err != nil {
+		return portFilter{}, fmt.Errorf("invalid port %q: %w", filterValue, err)
+	}
+	end := start
+	if isRange {
+		end, err = strconv.ParseUint(endPort, 10, 16)
+		if err != nil {
+			return portFilter{}, fmt.Errorf("invalid port %q: %w", filterValue, err)
+		}
+		if end < start {
+			return portFilter{}, fmt.Errorf("invalid port range %q", filterValue)
+		}
+	}
+	return portFilter{start: uint16(start), end: uint16(end), protocol: strings.ToLower(protocol)}, nil
+}
+
+// matchPortFilters returns true if any port of the range of length portRange
+// starting at port overlaps with the port range of any of the filters.
+func matchPortFilters(ports []portFilter, port, portRange uint16, protocol string) bool {
+	if portRange == 0 {
+		portRange = 1
+	}
+	last := uint32(port) + uint32(portRange) - 1
+	for _, p := range ports {
+		if p.protocol == protocol && uint32(p.start) <= last && uint32(p.end) >= uint32(port) {
+			return true
+		}
+	}
+	return false
+}
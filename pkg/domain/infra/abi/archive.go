@@ -2,8 +2,11 @@ package abi
 
 import (
 	"context"
+	"errors"
 	"io"
 
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/pkg/copy"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 )
 
@@ -12,13 +15,59 @@ func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrI
 	if err != nil {
 		return nil, err
 	}
-	return container.CopyFromArchive(ctx, containerPath, options.Chown, options.NoOverwriteDirNonDir, options.Rename, reader)
+	return container.CopyFromArchive(ctx, containerPath, libpod.CopyFromArchiveOptions{
+		Chown:                options.Chown,
+		NoOverwriteDirNonDir: options.NoOverwriteDirNonDir,
+		NoOverwriteDir:       options.NoOverwriteDir,
+		StripXattrs:          options.StripXattrs,
+		StripSpecialBits:     options.StripSpecialBits,
+		Rename:               options.Rename,
+		Remove:               options.Remove,
+	}, reader)
 }
 
-func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID, containerPath string, writer io.Writer) (entities.ContainerCopyFunc, error) {
+func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID, containerPath string, writer io.Writer, options entities.CopyToArchiveOptions) (entities.ContainerCopyFunc, error) {
 	container, err := ic.Libpod.LookupContainer(nameOrID)
 	if err != nil {
 		return nil, err
 	}
-	return container.CopyToArchive(ctx, containerPath, writer)
+	copyOptions := libpod.CopyToArchiveOptions{NoChown: options.NoChown}
+	if len(options.Includes) == 0 && len(options.Excludes) == 0 && !options.NoContents {
+		return container.CopyToArchive(ctx, containerPath, copyOptions, writer)
+	}
+
+	// Filter the archive before it is handed out, so that unwanted
+	// files are never sent over the wire.
+	filter, err := copy.NewArchiveFilter(options.Includes, options.Excludes)
+	if err != nil {
+		return nil, err
+	}
+	filter.NoContents = options.NoContents
+	pipeReader, pipeWriter := io.Pipe()
+	copyFunc, err := container.CopyToArchive(ctx, containerPath, copyOptions, pipeWriter)
+	if err != nil {
+		return nil, err
+	}
+	return func() error {
+		errChan := make(chan error, 1)
+		go func() {
+			err := copyFunc()
+			pipeWriter.CloseWithError(err)
+			errChan <- err
+		}()
+		err := filter.Copy(writer, pipeReader)
+		// Unblock the copy if the filter stopped early.
+		pipeReader.CloseWithError(err)
+		copyErr := <-errChan
+		if err != nil {
+			// Errors of the copy are passed on through the pipe.
+			return err
+		}
+		// Once the filter has read the whole archive, a closed pipe
+		// does not matter anymore.
+		if copyErr != nil && !errors.Is(copyErr, io.ErrClosedPipe) {
+			return copyErr
+		}
+		return nil
+	}, nil
 }
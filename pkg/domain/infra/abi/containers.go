@@ -1,17 +1,20 @@
 package abi
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/containers/buildah"
+	nettypes "github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/manifest"
@@ -25,6 +28,7 @@ import (
 	dfilters "github.com/containers/podman/v5/pkg/domain/filters"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi/terminal"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/netcap"
 	parallelctr "github.com/containers/podman/v5/pkg/parallel/ctr"
 	"github.com/containers/podman/v5/pkg/ps"
 	"github.com/containers/podman/v5/pkg/rootless"
@@ -35,6 +39,7 @@ import (
 	"github.com/containers/podman/v5/pkg/util"
 	"github.com/containers/storage"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
 )
 
 type getContainersOptions struct {
@@ -157,6 +162,73 @@ func getContainers(runtime *libpod.Runtime, options getContainersOptions) ([]con
 	return containers, nil
 }
 
+// parallelContainerOp runs applyFunc on the containers in parallel and
+// returns the errors by container ID.
+func parallelContainerOp(ctx context.Context, containers []containerWrapper, applyFunc func(*libpod.Container) error) (map[string]error, error) {
+	libpodContainers := make([]*libpod.Container, 0, len(containers))
+	for _, c := range containers {
+		if c.doesNotExist {
+			continue
+		}
+		libpodContainers = append(libpodContainers, c.Container)
+	}
+	errMap, err := parallelctr.ContainerOp(ctx, libpodContainers, applyFunc)
+	if err != nil {
+		return nil, err
+	}
+	errs := make(map[string]error, len(errMap))
+	for c, err := range errMap {
+		errs[c.ID()] = err
+	}
+	return errs, nil
+}
+
+// containerDependencyLevels groups the containers such that each container
+// comes after the ones it depends on.  The containers of a group do not
+// depend on each other, so they can be handled in parallel.
+func containerDependencyLevels(containers []containerWrapper) [][]containerWrapper {
+	pending := make(map[string]bool, len(containers))
+	for _, c := range containers {
+		if !c.doesNotExist {
+			pending[c.ID()] = true
+		}
+	}
+	var levels [][]containerWrapper
+	remaining := containers
+	for len(remaining) > 0 {
+		var level, next []containerWrapper
+		for _, c := range remaining {
+			ready := true
+			if !c.doesNotExist {
+				for _, dep := range c.Dependencies() {
+					if pending[dep] {
+						ready = false
+						break
+					}
+				}
+			}
+			if ready {
+				level = append(level, c)
+			} else {
+				next = append(next, c)
+			}
+		}
+		if len(level) == 0 {
+			// Dependency cycles cannot be created, but do not loop
+			// forever if there is one.
+			level, next = next, nil
+		}
+		for _, c := range level {
+			if !c.doesNotExist {
+				delete(pending, c.ID())
+			}
+		}
+		levels = append(levels, level)
+		remaining = next
+	}
+	return levels
+}
+
 // ContainerExists returns whether the container exists in container storage
 func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string, options entities.ContainerExistsOptions) (*entities.BoolReport, error) {
 	_, err := ic.Libpod.LookupContainer(nameOrID)
@@ -175,33 +247,107 @@ func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string,
 }
 
 func (ic *ContainerEngine) ContainerWait(ctx context.Context, namesOrIds []string, options entities.WaitOptions) ([]entities.WaitReport, error) {
-	responses := make([]entities.WaitReport, 0, len(namesOrIds))
-	containers, err := getContainers(ic.Libpod, getContainersOptions{latest: options.Latest, ignore: options.Ignore, names: namesOrIds})
+	var logPattern *regexp.Regexp
+	if options.UntilLog != "" {
+		var err error
+		if logPattern, err = regexp.Compile(options.UntilLog); err != nil {
+			return nil, fmt.Errorf("invalid log pattern %q: %w", options.UntilLog, err)
+		}
+	}
+	containers, err := getContainers(ic.Libpod, getContainersOptions{latest: options.Latest, ignore: options.Ignore, names: namesOrIds, filters: options.Filters})
 	if err != nil {
 		return nil, err
 	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+	if options.Any {
+		return waitAnyContainer(ctx, containers, options, logPattern), nil
+	}
+	// Waiting does not keep a worker busy, so the containers are waited
+	// for concurrently without the job control of pkg/parallel.
+	responses := make([]entities.WaitReport, len(containers))
+	var wg sync.WaitGroup
+	for i, c := range containers {
+		wg.Add(1)
+		go func(i int, c containerWrapper) {
+			defer wg.Done()
+			responses[i] = waitContainer(ctx, c, options, logPattern)
+		}(i, c)
+	}
+	wg.Wait()
+	return responses, nil
+}
+
+// waitAnyContainer waits for the containers concurrently and returns the
+// report of the first one done waiting without error, or all the reports if
+// there is none.
+func waitAnyContainer(ctx context.Context, containers []containerWrapper, options entities.WaitOptions, logPattern *regexp.Regexp) []entities.WaitReport {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	reportChan := make(chan entities.WaitReport, len(containers))
 	for _, c := range containers {
-		if c.doesNotExist { // Only set when `options.Ignore == true`
-			responses = append(responses, entities.WaitReport{ExitCode: -1})
-			continue
+		go func(c containerWrapper) {
+			reportChan <- waitContainer(ctx, c, options, logPattern)
+		}(c)
+	}
+	responses := make([]entities.WaitReport, 0, len(containers))
+	for range containers {
+		response := <-reportChan
+		if response.Error == nil && response.Id != "" {
+			return []entities.WaitReport{response}
 		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// waitContainer waits for the container to log a line matching logPattern,
+// to listen on the port and to meet one of the conditions of the options, in
+// that order.
+func waitContainer(ctx context.Context, c containerWrapper, options entities.WaitOptions, logPattern *regexp.Regexp) entities.WaitReport {
+	if c.doesNotExist { // Only set when `options.Ignore == true`
+		return entities.WaitReport{ExitCode: -1, RawInput: c.rawInput}
+	}
 
-		response := entities.WaitReport{}
-		var conditions []string
-		if len(options.Conditions) == 0 {
+	response := entities.WaitReport{Id: c.ID(), RawInput: c.rawInput}
+	err := func() error {
+		if logPattern != nil {
+			if err := c.WaitForLog(ctx, options.Interval, logPattern); err != nil {
+				return err
+			}
+		}
+		if options.UntilPort != 0 {
+			if err := c.WaitForPort(ctx, options.Interval, options.UntilPort); err != nil {
+				return err
+			}
+		}
+		conditions := options.Conditions
+		if len(conditions) == 0 {
+			if logPattern != nil || options.UntilPort != 0 {
+				// the container is still running
+				response.ExitCode = -1
+				return nil
+			}
 			conditions = []string{define.ContainerStateStopped.String(), define.ContainerStateExited.String()}
-		} else {
-			conditions = options.Conditions
 		}
 		exitCode, err := c.WaitForConditionWithInterval(ctx, options.Interval, conditions...)
-		if err != nil {
-			response.Error = err
-		} else {
+		if err == nil {
 			response.ExitCode = exitCode
 		}
-		responses = append(responses, response)
+		return err
+	}()
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("timed out waiting for container %s after %s: %w", c.ID(), options.Timeout, ctx.Err())
+		}
+		response.Error = err
 	}
-	return responses, nil
+	return response
 }
 
 func (ic *ContainerEngine) ContainerPause(ctx context.Context, namesOrIds []string, options entities.PauseUnPauseOptions) ([]*entities.PauseUnpauseReport, error) {
@@ -209,9 +355,15 @@ func (ic *ContainerEngine) ContainerPause(ctx context.Context, namesOrIds []stri
 	if err != nil {
 		return nil, err
 	}
+	errs, err := parallelContainerOp(ctx, containers, func(c *libpod.Container) error {
+		return c.Pause()
+	})
+	if err != nil {
+		return nil, err
+	}
 	reports := make([]*entities.PauseUnpauseReport, 0, len(containers))
 	for _, c := range containers {
-		err := c.Pause()
+		err := errs[c.ID()]
 		if err != nil && options.All && errors.Is(err, define.ErrCtrStateInvalid) {
 			logrus.Debugf("Container %s is not running", c.ID())
 			continue
@@ -230,9 +382,15 @@ func (ic *ContainerEngine) ContainerUnpause(ctx context.Context, namesOrIds []st
 	if err != nil {
 		return nil, err
 	}
+	errs, err := parallelContainerOp(ctx, containers, func(c *libpod.Container) error {
+		return c.Unpause()
+	})
+	if err != nil {
+		return nil, err
+	}
 	reports := make([]*entities.PauseUnpauseReport, 0, len(containers))
 	for _, c := range containers {
-		err := c.Unpause()
+		err := errs[c.ID()]
 		if err != nil && options.All && errors.Is(err, define.ErrCtrStateInvalid) {
 			logrus.Debugf("Container %s is not paused", c.ID())
 			continue
@@ -333,15 +491,21 @@ func (ic *ContainerEngine) ContainerKill(ctx context.Context, namesOrIds []strin
 	if err != nil {
 		return nil, err
 	}
-	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds})
+	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: namesOrIds, filters: options.Filters})
 	if err != nil {
 		return nil, err
 	}
 
+	errs, err := parallelContainerOp(ctx, containers, func(c *libpod.Container) error {
+		return c.Kill(uint(sig))
+	})
+	if err != nil {
+		return nil, err
+	}
 	reports := make([]*entities.KillReport, 0, len(containers))
 	for _, con := range containers {
-		err := con.Kill(uint(sig))
-		if options.All && errors.Is(err, define.ErrCtrStateInvalid) {
+		err := errs[con.ID()]
+		if (options.All || len(options.Filters) > 0) && errors.Is(err, define.ErrCtrStateInvalid) {
 			logrus.Debugf("Container %s is not running", con.ID())
 			continue
 		}
@@ -366,15 +530,27 @@ func (ic *ContainerEngine) ContainerRestart(ctx context.Context, namesOrIds []st
 		return nil, err
 	}
 
+	// A container can only be restarted while its dependencies run, so
+	// the dependencies are restarted first.
+	errs := make(map[string]error, len(containers))
+	for _, level := range containerDependencyLevels(containers) {
+		levelErrs, err := parallelContainerOp(ctx, level, func(c *libpod.Container) error {
+			timeout := c.StopTimeout()
+			if options.Timeout != nil {
+				timeout = *options.Timeout
+			}
+			return c.RestartWithTimeout(ctx, timeout)
+		})
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(errs, levelErrs)
+	}
 	reports := make([]*entities.RestartReport, 0, len(containers))
 	for _, c := range containers {
-		timeout := c.StopTimeout()
-		if options.Timeout != nil {
-			timeout = *options.Timeout
-		}
 		reports = append(reports, &entities.RestartReport{
 			Id:       c.ID(),
-			Err:      c.RestartWithTimeout(ctx, timeout),
+			Err:      errs[c.ID()],
 			RawInput: c.rawInput,
 		})
 	}
@@ -497,6 +673,11 @@ func (ic *ContainerEngine) ContainerInspect(ctx context.Context, namesOrIds []st
 		if err != nil {
 			return nil, nil, err
 		}
+		if options.ShowHooks {
+			if inspect.OCIHooks, err = ctr.OCIHooks(); err != nil {
+				return nil, nil, err
+			}
+		}
 
 		return []*entities.ContainerInspectReport{
 			{
@@ -530,6 +711,11 @@ func (ic *ContainerEngine) ContainerInspect(ctx context.Context, namesOrIds []st
 			}
 			return nil, nil, err
 		}
+		if options.ShowHooks {
+			if inspect.OCIHooks, err = ctr.OCIHooks(); err != nil {
+				return nil, nil, err
+			}
+		}
 
 		reports = append(reports, &entities.ContainerInspectReport{InspectContainerData: inspect})
 	}
@@ -624,6 +810,21 @@ func (ic *ContainerEngine) ContainerExport(ctx context.Context, nameOrID string,
 	return ctr.Export(options.Output)
 }
 
+func (ic *ContainerEngine) ContainerNetCapture(ctx context.Context, nameOrID string, options entities.ContainerNetCaptureOptions) error {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	captureOpts := netcap.Options{
+		Interface: options.Interface,
+		Filter:    options.Filter,
+		Snaplen:   options.Snaplen,
+		Count:     options.Count,
+	}
+	_, err = ctr.NetworkCapture(ctx, captureOpts, options.Output)
+	return err
+}
+
 func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds []string, options entities.CheckpointOptions) ([]*entities.CheckpointReport, error) {
 	checkOpts := libpod.ContainerCheckpointOptions{
 		Keep:           options.Keep,
@@ -640,23 +841,45 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 		CreateImage:    options.CreateImage,
 	}
 	// NOTE: all maps to running
-	containers, err := getContainers(ic.Libpod, getContainersOptions{running: options.All, latest: options.Latest, names: namesOrIds})
+	containers, err := getContainers(ic.Libpod, getContainersOptions{running: options.All, latest: options.Latest, names: namesOrIds, filters: options.Filters})
 	if err != nil {
 		return nil, err
 	}
 
-	reports := make([]*entities.CheckpointReport, 0, len(containers))
-	for _, c := range containers {
+	reports := make(map[string]*entities.CheckpointReport, len(containers))
+	var reportsLock sync.Mutex
+	checkpointCtr := func(c *libpod.Container) error {
 		criuStatistics, runtimeCheckpointDuration, err := c.Checkpoint(ctx, checkOpts)
-		reports = append(reports, &entities.CheckpointReport{
-			Err:             err,
-			Id:              c.ID(),
-			RawInput:        c.rawInput,
+		reportsLock.Lock()
+		defer reportsLock.Unlock()
+		reports[c.ID()] = &entities.CheckpointReport{
 			RuntimeDuration: runtimeCheckpointDuration,
 			CRIUStatistics:  criuStatistics,
-		})
+		}
+		return err
 	}
-	return reports, nil
+	var errs map[string]error
+	if checkOpts.TargetFile != "" || checkOpts.CreateImage != "" {
+		// All checkpoints are written to the same destination.
+		errs = make(map[string]error, len(containers))
+		for _, c := range containers {
+			errs[c.ID()] = checkpointCtr(c.Container)
+		}
+	} else {
+		errs, err = parallelContainerOp(ctx, containers, checkpointCtr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	orderedReports := make([]*entities.CheckpointReport, 0, len(containers))
+	for _, c := range containers {
+		report := reports[c.ID()]
+		report.Err = errs[c.ID()]
+		report.Id = c.ID()
+		report.RawInput = c.rawInput
+		orderedReports = append(orderedReports, report)
+	}
+	return orderedReports, nil
 }
 
 func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []string, options entities.RestoreOptions) ([]*entities.RestoreReport, error) {
@@ -824,6 +1047,7 @@ func makeExecConfig(options entities.ExecOptions, rt *libpod.Runtime) (*libpod.E
 	execConfig.PreserveFDs = options.PreserveFDs
 	execConfig.PreserveFD = options.PreserveFD
 	execConfig.AttachStdin = options.Interactive
+	execConfig.Name = options.Name
 
 	// Make an exit command
 	storageConfig := rt.StorageConfig()
@@ -929,6 +1153,29 @@ func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID s
 	return id, nil
 }
 
+func (ic *ContainerEngine) ContainerExecAttach(ctx context.Context, nameOrID string, options entities.ExecAttachOptions) error {
+	ctr, sessionID, err := ic.Libpod.LookupExecSession(nameOrID)
+	if err != nil {
+		return err
+	}
+
+	streams := new(define.AttachStreams)
+	streams.OutputStream = options.Stdout
+	streams.ErrorStream = options.Stderr
+	streams.AttachOutput = true
+	streams.AttachError = true
+	if !options.NoStdin {
+		streams.InputStream = bufio.NewReader(options.Stdin)
+		streams.AttachInput = true
+	}
+
+	err = terminal.AttachExecSession(ctx, ctr, sessionID, options.DetachKeys, streams)
+	if err != nil && !errors.Is(err, define.ErrDetach) {
+		return fmt.Errorf("attaching to exec session %s: %w", sessionID, err)
+	}
+	return nil
+}
+
 func (ic *ContainerEngine) ContainerStart(ctx context.Context, namesOrIds []string, options entities.ContainerStartOptions) ([]*entities.ContainerStartReport, error) {
 	reports := []*entities.ContainerStartReport{}
 	var exitCode = define.ExecErrorCodeGeneric
@@ -1522,6 +1769,44 @@ func (ic *ContainerEngine) ContainerPort(ctx context.Context, nameOrID string, o
 	return reports, nil
 }
 
+// ContainerPortAdd publishes additional ports of a container.  The ports use
+// the format of --publish.
+func (ic *ContainerEngine) ContainerPortAdd(ctx context.Context, nameOrID string, ports []string) (*entities.ContainerPortReport, error) {
+	return ic.updateContainerPorts(nameOrID, ports, generate.AddPortMappings)
+}
+
+// ContainerPortRm unpublishes ports of a container.  The ports use the format
+// of --publish, the host port and IP are optional.
+func (ic *ContainerEngine) ContainerPortRm(ctx context.Context, nameOrID string, ports []string) (*entities.ContainerPortReport, error) {
+	return ic.updateContainerPorts(nameOrID, ports, generate.RemovePortMappings)
+}
+
+func (ic *ContainerEngine) updateContainerPorts(nameOrID string, ports []string, update func(existing, ports []nettypes.PortMapping) ([]nettypes.PortMapping, error)) (*entities.ContainerPortReport, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	portMappings, err := specgenutil.CreatePortBindings(ports)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := ctr.PortMappings()
+	if err != nil {
+		return nil, err
+	}
+	newPortMappings, err := update(existing, portMappings)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctr.UpdatePortMappings(newPortMappings); err != nil {
+		return nil, err
+	}
+	return &entities.ContainerPortReport{
+		Id:    ctr.ID(),
+		Ports: newPortMappings,
+	}, nil
+}
+
 // Shutdown Libpod engine
 func (ic *ContainerEngine) Shutdown(_ context.Context) {
 	shutdownSync.Do(func() {
@@ -1751,13 +2036,13 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 
 // ContainerUpdate finds and updates the given container's cgroup config with the specified options
 func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, updateOptions *entities.ContainerUpdateOptions) (string, error) {
-	err := specgen.WeightDevices(updateOptions.Specgen)
-	if err != nil {
-		return "", err
-	}
-	err = specgen.FinishThrottleDevices(updateOptions.Specgen)
-	if err != nil {
-		return "", err
+	if updateOptions.Specgen != nil {
+		if err := specgen.WeightDevices(updateOptions.Specgen); err != nil {
+			return "", err
+		}
+		if err := specgen.FinishThrottleDevices(updateOptions.Specgen); err != nil {
+			return "", err
+		}
 	}
 	containers, err := getContainers(ic.Libpod, getContainersOptions{names: []string{updateOptions.NameOrID}})
 	if err != nil {
@@ -1767,8 +2052,15 @@ func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, updateOptions *e
 		return "", fmt.Errorf("container not found")
 	}
 
-	if err = containers[0].Update(updateOptions.Specgen.ResourceLimits); err != nil {
-		return "", err
+	if updateOptions.Specgen != nil {
+		if err = containers[0].Update(updateOptions.Specgen.ResourceLimits); err != nil {
+			return "", err
+		}
+	}
+	if len(updateOptions.NetworkRates) > 0 {
+		if err = containers[0].UpdateNetworkRates(updateOptions.NetworkRates); err != nil {
+			return "", err
+		}
 	}
 	return containers[0].ID(), nil
 }
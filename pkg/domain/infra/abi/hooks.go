@@ -0,0 +1,66 @@
+package abi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func (ic *ContainerEngine) HooksList(ctx context.Context) ([]*define.OCIHook, error) {
+	return ic.Libpod.OCIHooks()
+}
+
+func (ic *ContainerEngine) HooksInspect(ctx context.Context, names []string) ([]*define.OCIHook, []error, error) {
+	ociHooks, err := ic.Libpod.OCIHooks()
+	if err != nil {
+		return nil, nil, err
+	}
+	byName := make(map[string]*define.OCIHook, len(ociHooks))
+	for _, ociHook := range ociHooks {
+		byName[ociHook.Name] = ociHook
+	}
+	reports := make([]*define.OCIHook, 0, len(names))
+	errs := make([]error, 0, len(names))
+	for _, name := range names {
+		ociHook, ok := byName[name]
+		if !ok {
+			// accept the name without the .json extension
+			ociHook, ok = byName[name+".json"]
+		}
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %w", name, define.ErrNoSuchHook))
+			continue
+		}
+		reports = append(reports, ociHook)
+	}
+	return reports, errs, nil
+}
+
+func (ic *ContainerEngine) HooksValidate(ctx context.Context, path string, options entities.HooksValidateOptions) (*entities.HooksValidateReport, error) {
+	ociHook := libpod.ReadOCIHook(path)
+	if ociHook == nil {
+		return nil, fmt.Errorf("%s: %w", path, define.ErrNoSuchHook)
+	}
+	report := &entities.HooksValidateReport{OCIHook: ociHook}
+	if ociHook.Error != "" {
+		return report, nil
+	}
+
+	// The hypothetical container only needs the fields the when
+	// conditions look at.  Without a command, command conditions are not
+	// checked.
+	hypothetical := &spec.Spec{Annotations: options.Annotations}
+	if options.Command != "" {
+		hypothetical.Process = &spec.Process{Args: []string{options.Command}}
+	}
+	match, err := ociHook.When.Match(hypothetical, options.Annotations, options.HasBindMounts)
+	if err != nil {
+		return nil, fmt.Errorf("matching hook %q: %w", ociHook.Name, err)
+	}
+	report.Match = match
+	return report, nil
+}
@@ -33,11 +33,13 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	domainUtils "github.com/containers/podman/v5/pkg/domain/utils"
 	"github.com/containers/podman/v5/pkg/errorhandling"
+	"github.com/containers/podman/v5/pkg/retention"
 	"github.com/containers/podman/v5/pkg/rootless"
 	"github.com/containers/storage"
 	"github.com/opencontainers/go-digest"
 	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 const UnknownDigestSuffix = docker.UnknownDigestSuffix
@@ -51,11 +53,15 @@ func (ir *ImageEngine) Exists(_ context.Context, nameOrID string) (*entities.Boo
 }
 
 func (ir *ImageEngine) Prune(ctx context.Context, opts entities.ImagePruneOptions) ([]*reports.PruneReport, error) {
+	if opts.Policy {
+		return ir.pruneByPolicy(ctx, opts)
+	}
+
 	pruneOptions := &libimage.RemoveImagesOptions{
 		RemoveContainerFunc:     ir.Libpod.RemoveContainersForImageCallback(ctx),
 		IsExternalContainerFunc: ir.Libpod.IsExternalContainerCallback(ctx),
 		ExternalContainers:      opts.External,
-		Filters:                 append(opts.Filter, "readonly=false"),
+		Filters:                 append(slices.Clone(opts.Filter), "readonly=false"),
 		WithSize:                true,
 	}
 
@@ -107,6 +113,91 @@ func (ir *ImageEngine) Prune(ctx context.Context, opts entities.ImagePruneOption
 	return pruneReports, nil
 }
 
+// pruneByPolicy removes the images selected by the image retention policy in
+// containers.conf.  Filters further restrict the images considered.
+func (ir *ImageEngine) pruneByPolicy(ctx context.Context, opts entities.ImagePruneOptions) ([]*reports.PruneReport, error) {
+	conf, err := ir.Libpod.GetConfigNoCopy()
+	if err != nil {
+		return nil, err
+	}
+	policy, err := retention.Load(conf)
+	if err != nil {
+		return nil, err
+	}
+	if policy.IsEmpty() {
+		return nil, errors.New("no image retention policy configured in the [image_retention] table of containers.conf")
+	}
+
+	listOptions := &libimage.ListImagesOptions{
+		Filters:                 append(slices.Clone(opts.Filter), "readonly=false"),
+		IsExternalContainerFunc: ir.Libpod.IsExternalContainerCallback(ctx),
+	}
+	images, err := ir.Libpod.LibimageRuntime().ListImages(ctx, nil, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]retention.Image, 0, len(images))
+	for _, img := range images {
+		repoTags, err := img.RepoTags()
+		if err != nil {
+			return nil, err
+		}
+		labels, err := img.Labels(ctx)
+		if err != nil {
+			return nil, err
+		}
+		containers, err := img.Containers()
+		if err != nil {
+			return nil, err
+		}
+		lastUsed, err := ir.Libpod.ImageLastUsed(img.ID())
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, retention.Image{
+			ID:       img.ID(),
+			RepoTags: repoTags,
+			Labels:   labels,
+			Created:  img.Created(),
+			LastUsed: lastUsed,
+			InUse:    len(containers) > 0,
+		})
+	}
+
+	expired := policy.Expired(candidates, time.Now())
+	if len(expired) == 0 {
+		return []*reports.PruneReport{}, nil
+	}
+
+	// Remove the images one by one via the id filter rather than by name
+	// to remove all of their tags at once.
+	containersFilter := "containers=false"
+	if opts.External {
+		containersFilter = "containers=external"
+	}
+	pruneReports := make([]*reports.PruneReport, 0, len(expired))
+	for _, id := range expired {
+		removedImages, rmErrors := ir.Libpod.LibimageRuntime().RemoveImages(ctx, nil, &libimage.RemoveImagesOptions{
+			RemoveContainerFunc:     ir.Libpod.RemoveContainersForImageCallback(ctx),
+			IsExternalContainerFunc: ir.Libpod.IsExternalContainerCallback(ctx),
+			ExternalContainers:      opts.External,
+			Filters:                 []string{"readonly=false", containersFilter, "id=" + id},
+			WithSize:                true,
+		})
+		if rmErrors != nil {
+			return nil, errorhandling.JoinErrors(rmErrors)
+		}
+		for _, rmReport := range removedImages {
+			pruneReports = append(pruneReports, &reports.PruneReport{
+				Id:   rmReport.ID,
+				Size: uint64(rmReport.Size),
+			})
+		}
+	}
+	return pruneReports, nil
+}
+
 func toDomainHistoryLayer(layer *libimage.ImageHistory) entities.ImageHistoryLayer {
 	l := entities.ImageHistoryLayer{
 		Comment:   layer.Comment,
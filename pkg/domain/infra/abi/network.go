@@ -12,18 +12,25 @@ import (
 	netutil "github.com/containers/common/libnetwork/util"
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
 func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, netName string, options entities.NetworkUpdateOptions) error {
-	var networkUpdateOptions types.NetworkUpdateOptions
-	networkUpdateOptions.AddDNSServers = options.AddDNSServers
-	networkUpdateOptions.RemoveDNSServers = options.RemoveDNSServers
-	err := ic.Libpod.Network().NetworkUpdate(netName, networkUpdateOptions)
-	if err != nil {
-		return err
+	networkUpdateOptions := types.NetworkUpdateOptions{
+		AddDNSServers:    options.AddDNSServers,
+		RemoveDNSServers: options.RemoveDNSServers,
+		AddSubnets:       options.AddSubnets,
+		RemoveSubnets:    options.RemoveSubnets,
+		Gateways:         options.Gateways,
+		LeaseRanges:      options.IPRanges,
+		Internal:         options.Internal,
+		AddLabels:        options.AddLabels,
+		RemoveLabels:     options.RemoveLabels,
+		AddOptions:       options.AddOptions,
+		RemoveOptions:    options.RemoveOptions,
 	}
-	return nil
+	return ic.Libpod.UpdateNetwork(netName, networkUpdateOptions)
 }
 
 func (ic *ContainerEngine) NetworkList(ctx context.Context, options entities.NetworkListOptions) ([]types.Network, error) {
@@ -82,6 +89,22 @@ func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []stri
 	return networks, errs, nil
 }
 
+func (ic *ContainerEngine) NetworkInspectLive(ctx context.Context, namesOrIds []string, options entities.InspectOptions) ([]*entities.NetworkInspectLiveReport, []error, error) {
+	networks, errs, err := ic.NetworkInspect(ctx, namesOrIds, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	reports := make([]*entities.NetworkInspectLiveReport, 0, len(networks))
+	for _, net := range networks {
+		endpoints, err := ic.Libpod.NetworkEndpoints(net.Name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("inspecting endpoints of network %s: %w", net.Name, err)
+		}
+		reports = append(reports, &entities.NetworkInspectLiveReport{Network: net, Endpoints: endpoints})
+	}
+	return reports, errs, nil
+}
+
 func (ic *ContainerEngine) NetworkReload(ctx context.Context, names []string, options entities.NetworkReloadOptions) ([]*entities.NetworkReloadReport, error) {
 	containers, err := getContainers(ic.Libpod, getContainersOptions{all: options.All, latest: options.Latest, names: names})
 	if err != nil {
@@ -155,7 +178,14 @@ func (ic *ContainerEngine) NetworkCreate(ctx context.Context, network types.Netw
 	if slices.Contains([]string{"none", "host", "bridge", "private", slirp4netns.BinaryName, pasta.BinaryName, "container", "ns", "default"}, network.Name) {
 		return nil, fmt.Errorf("cannot create network with name %q because it conflicts with a valid network mode", network.Name)
 	}
-	network, err := ic.Libpod.Network().NetworkCreate(network, createOptions)
+	// the egress allow-list is stored in a label, the backends reject it as option
+	network.Options = maps.Clone(network.Options)
+	labels, err := define.MoveEgressOption(network.Options, maps.Clone(network.Labels))
+	if err != nil {
+		return nil, err
+	}
+	network.Labels = labels
+	network, err = ic.Libpod.Network().NetworkCreate(network, createOptions)
 	if err != nil {
 		return nil, err
 	}
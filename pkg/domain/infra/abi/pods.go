@@ -17,10 +17,21 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// getPodsByContext returns a slice of pods. Note that all, latest and pods are
-// mutually exclusive arguments.
-func getPodsByContext(all, latest bool, pods []string, runtime *libpod.Runtime) ([]*libpod.Pod, error) {
+// getPodsByContext returns a slice of pods. Note that all, latest, filters and
+// pods are mutually exclusive arguments.
+func getPodsByContext(all, latest bool, filters map[string][]string, pods []string, runtime *libpod.Runtime) ([]*libpod.Pod, error) {
 	var outpods []*libpod.Pod
+	if len(filters) > 0 {
+		filterFuncs := make([]libpod.PodFilter, 0, len(filters))
+		for k, v := range filters {
+			f, err := dfilters.GeneratePodFilterFunc(k, v, runtime)
+			if err != nil {
+				return nil, err
+			}
+			filterFuncs = append(filterFuncs, f)
+		}
+		return runtime.Pods(filterFuncs...)
+	}
 	if all {
 		return runtime.GetAllPods()
 	}
@@ -62,7 +73,7 @@ func (ic *ContainerEngine) PodKill(ctx context.Context, namesOrIds []string, opt
 	if err != nil {
 		return nil, err
 	}
-	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	pods, err := getPodsByContext(options.All, options.Latest, options.Filters, namesOrIds, ic.Libpod)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +101,7 @@ func (ic *ContainerEngine) PodKill(ctx context.Context, namesOrIds []string, opt
 func (ic *ContainerEngine) PodLogs(ctx context.Context, nameOrID string, options entities.PodLogsOptions) error {
 	// Implementation accepts slice
 	podName := []string{nameOrID}
-	pod, err := getPodsByContext(false, options.Latest, podName, ic.Libpod)
+	pod, err := getPodsByContext(false, options.Latest, nil, podName, ic.Libpod)
 	if err != nil {
 		return err
 	}
@@ -129,7 +140,7 @@ func (ic *ContainerEngine) PodLogs(ctx context.Context, nameOrID string, options
 
 func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, options entities.PodPauseOptions) ([]*entities.PodPauseReport, error) {
 	reports := []*entities.PodPauseReport{}
-	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	pods, err := getPodsByContext(options.All, options.Latest, options.Filters, namesOrIds, ic.Libpod)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +166,7 @@ func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, op
 
 func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string, options entities.PodunpauseOptions) ([]*entities.PodUnpauseReport, error) {
 	reports := []*entities.PodUnpauseReport{}
-	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	pods, err := getPodsByContext(options.All, options.Latest, options.Filters, namesOrIds, ic.Libpod)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +200,7 @@ func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string,
 
 func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, options entities.PodStopOptions) ([]*entities.PodStopReport, error) {
 	reports := []*entities.PodStopReport{}
-	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	pods, err := getPodsByContext(options.All, options.Latest, options.Filters, namesOrIds, ic.Libpod)
 	if err != nil && !(options.Ignore && errors.Is(err, define.ErrNoSuchPod)) {
 		return nil, err
 	}
@@ -215,7 +226,7 @@ func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, opt
 
 func (ic *ContainerEngine) PodRestart(ctx context.Context, namesOrIds []string, options entities.PodRestartOptions) ([]*entities.PodRestartReport, error) {
 	reports := []*entities.PodRestartReport{}
-	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	pods, err := getPodsByContext(options.All, options.Latest, options.Filters, namesOrIds, ic.Libpod)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +252,7 @@ func (ic *ContainerEngine) PodRestart(ctx context.Context, namesOrIds []string,
 
 func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, options entities.PodStartOptions) ([]*entities.PodStartReport, error) {
 	reports := []*entities.PodStartReport{}
-	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	pods, err := getPodsByContext(options.All, options.Latest, options.Filters, namesOrIds, ic.Libpod)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +278,7 @@ func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, op
 }
 
 func (ic *ContainerEngine) PodRm(ctx context.Context, namesOrIds []string, options entities.PodRmOptions) ([]*entities.PodRmReport, error) {
-	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	pods, err := getPodsByContext(options.All, options.Latest, options.Filters, namesOrIds, ic.Libpod)
 	if err != nil && !(options.Ignore && errors.Is(err, define.ErrNoSuchPod)) {
 		return nil, err
 	}
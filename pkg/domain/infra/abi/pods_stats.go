@@ -27,7 +27,7 @@ func (ic *ContainerEngine) PodStats(ctx context.Context, namesOrIds []string, op
 		}
 	}
 	// Get the (running) pods and convert them to the entities format.
-	pods, err := getPodsByContext(options.All, options.Latest, namesOrIds, ic.Libpod)
+	pods, err := getPodsByContext(options.All, options.Latest, nil, namesOrIds, ic.Libpod)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get list of pods: %w", err)
 	}
@@ -268,12 +268,147 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.System
 		dfVolumes = append(dfVolumes, &report)
 	}
 
-	return &entities.SystemDfReport{
+	report := &entities.SystemDfReport{
 		ImagesSize: totalImageSize,
 		Images:     dfImages,
 		Containers: dfContainers,
 		Volumes:    dfVolumes,
-	}, nil
+	}
+	if options.Analyze {
+		report.Analysis, err = ic.systemDfAnalyze(ctx, dfContainers, dfVolumes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// systemDfAnalyze computes which layers are shared between images, how much
+// space each image exclusively occupies and how much `system prune` would
+// reclaim.
+func (ic *ContainerEngine) systemDfAnalyze(ctx context.Context, dfContainers []*entities.SystemDfContainerReport, dfVolumes []*entities.SystemDfVolumeReport) (*entities.SystemDfAnalysisReport, error) {
+	images, err := ic.Libpod.LibimageRuntime().ListImages(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := &entities.SystemDfAnalysisReport{
+		Images:     []*entities.SystemDfImageAnalysis{},
+		Layers:     []*entities.SystemDfLayerReport{},
+		BuildCache: []*entities.SystemDfBuildCacheReport{},
+	}
+
+	// Map each layer to the images referencing it.
+	layers := make(map[string]*entities.SystemDfLayerReport)
+	imageLayers := make(map[string][]string, len(images))
+	for _, img := range images {
+		storageLayers, err := ic.Libpod.ImageLayers(img)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, 0, len(storageLayers))
+		for _, l := range storageLayers {
+			layer, ok := layers[l.ID]
+			if !ok {
+				layer = &entities.SystemDfLayerReport{LayerID: l.ID, Images: []string{}}
+				if l.UncompressedSize > 0 {
+					layer.Size = l.UncompressedSize
+				}
+				layers[l.ID] = layer
+				analysis.Layers = append(analysis.Layers, layer)
+			}
+			layer.Images = append(layer.Images, img.ID())
+			ids = append(ids, l.ID)
+		}
+		imageLayers[img.ID()] = ids
+	}
+
+	// reclaimable returns the size of all layers which are only referenced
+	// by images in the specified set.
+	reclaimable := func(set map[string]bool) int64 {
+		var size int64
+		for _, layer := range analysis.Layers {
+			exclusive := true
+			for _, id := range layer.Images {
+				if !set[id] {
+					exclusive = false
+					break
+				}
+			}
+			if exclusive {
+				size += layer.Size
+			}
+		}
+		return size
+	}
+
+	// Images used by a running container survive `system prune --all`.
+	activeImages := make(map[string]bool)
+	for _, c := range dfContainers {
+		if c.Status == define.ContainerStateRunning.String() || c.Status == define.ContainerStatePaused.String() {
+			activeImages[c.Image] = true
+		}
+	}
+
+	danglingImages := make(map[string]bool)
+	unusedImages := make(map[string]bool)
+	for _, img := range images {
+		containers, err := img.Containers()
+		if err != nil {
+			return nil, err
+		}
+		imageAnalysis := &entities.SystemDfImageAnalysis{
+			ImageID:    img.ID(),
+			Names:      img.Names(),
+			Layers:     len(imageLayers[img.ID()]),
+			Containers: len(containers),
+		}
+		for _, id := range imageLayers[img.ID()] {
+			layer := layers[id]
+			imageAnalysis.Size += layer.Size
+			if len(layer.Images) == 1 {
+				imageAnalysis.ExclusiveSize += layer.Size
+			} else {
+				imageAnalysis.SharedSize += layer.Size
+			}
+		}
+		analysis.Images = append(analysis.Images, imageAnalysis)
+
+		if !activeImages[img.ID()] {
+			unusedImages[img.ID()] = true
+		}
+
+		if len(img.Names()) > 0 {
+			continue
+		}
+		intermediate, err := img.IsIntermediate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !intermediate && len(containers) == 0 {
+			danglingImages[img.ID()] = true
+		}
+		analysis.BuildCache = append(analysis.BuildCache, &entities.SystemDfBuildCacheReport{
+			ImageID:         img.ID(),
+			Created:         img.Created(),
+			Size:            imageAnalysis.Size,
+			Intermediate:    intermediate,
+			ReclaimableSize: imageAnalysis.ExclusiveSize,
+		})
+	}
+
+	for _, c := range dfContainers {
+		if c.Status != define.ContainerStateRunning.String() && c.Status != define.ContainerStatePaused.String() {
+			analysis.Prune.Containers += c.RWSize
+		}
+	}
+	for _, v := range dfVolumes {
+		analysis.Prune.Volumes += v.ReclaimableSize
+	}
+	analysis.Prune.DanglingImages = reclaimable(danglingImages)
+	analysis.Prune.UnusedImages = reclaimable(unusedImages)
+
+	return analysis, nil
 }
 
 func (ic *ContainerEngine) Reset(ctx context.Context) error {
@@ -284,7 +419,26 @@ func (ic *ContainerEngine) Renumber(ctx context.Context) error {
 	return ic.Libpod.RenumberLocks()
 }
 
+func (ic *ContainerEngine) SystemCheck(ctx context.Context, options entities.SystemCheckOptions) (*entities.SystemCheckReport, error) {
+	report, err := ic.Libpod.SystemCheck(ctx, define.SystemCheckOptions{
+		Quick:                       options.Quick,
+		Repair:                      options.Repair,
+		RepairLossy:                 options.RepairLossy,
+		UnreferencedLayerMaximumAge: options.UnreferencedLayerMaximumAge,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &entities.SystemCheckReport{SystemCheckReport: report}, nil
+}
+
 func (ic *ContainerEngine) Migrate(ctx context.Context, options entities.SystemMigrateOptions) error {
+	switch {
+	case options.Rollback:
+		return ic.Libpod.RollbackDatabaseMigration()
+	case options.Database != "":
+		return ic.Libpod.MigrateDatabase(options.Database, options.DryRun)
+	}
 	return ic.Libpod.Migrate(options.NewRuntime)
 }
 
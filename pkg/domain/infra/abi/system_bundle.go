@@ -0,0 +1,482 @@
+//go:build !remote
+
+package abi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/containers/common/libimage"
+	nettypes "github.com/containers/common/libnetwork/types"
+	"github.com/containers/common/pkg/secrets"
+	"github.com/containers/podman/v5/libpod"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/domain/infra/abi/parse"
+	"github.com/containers/podman/v5/pkg/rootless"
+	"github.com/containers/podman/v5/pkg/systemd/quadlet"
+	"github.com/containers/podman/v5/utils"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/homedir"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// systemBundleVersion is the version of the bundle format written by
+	// SystemExport.  Bump it on incompatible changes.
+	systemBundleVersion = 1
+
+	bundleManifestFile = "manifest.json"
+	bundleImagesFile   = "images.tar"
+	bundleVolumesDir   = "volumes"
+	bundleQuadletDir   = "quadlet"
+)
+
+// systemBundle is the manifest of a bundle written by SystemExport.
+type systemBundle struct {
+	Version int
+	Created time.Time
+	// Images are the names (or IDs of untagged images) saved in
+	// images.tar.
+	Images []string
+	// Volumes with a data archive in the volumes directory.
+	Volumes []*libpod.VolumeConfig
+	// Networks except the default one.
+	Networks []nettypes.Network
+	// Secrets only contain the metadata, the data is never exported.
+	Secrets    []secrets.Secret
+	Pods       []*libpod.PodConfig
+	Containers []*libpod.ContainerConfig
+	// Quadlets are the paths of the quadlet files relative to the quadlet
+	// directory.
+	Quadlets []string
+}
+
+// quadletUnitDir returns the directory holding the quadlet files managed by
+// the current user.
+func quadletUnitDir() (string, error) {
+	if !rootless.IsRootless() {
+		return quadlet.UnitDirAdmin, nil
+	}
+	configHome, err := homedir.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configHome, "containers", "systemd"), nil
+}
+
+// SystemExport writes images, volumes, networks, secret metadata, pods,
+// containers and quadlet files into a bundle which can be imported with
+// SystemImport.
+func (ic *ContainerEngine) SystemExport(ctx context.Context, options entities.SystemExportOptions) error {
+	dir, err := os.MkdirTemp("", "podman-export")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Removing temporary export directory %s: %v", dir, err)
+		}
+	}()
+
+	bundle := systemBundle{
+		Version: systemBundleVersion,
+		Created: time.Now(),
+	}
+
+	// Images
+	images, err := ic.Libpod.LibimageRuntime().ListImages(ctx, nil, nil)
+	if err != nil {
+		return err
+	}
+	for _, img := range images {
+		isManifestList, err := img.IsManifestList(ctx)
+		if err != nil {
+			return err
+		}
+		if isManifestList {
+			continue
+		}
+		names := img.Names()
+		if len(names) == 0 {
+			names = []string{img.ID()}
+		}
+		bundle.Images = append(bundle.Images, names...)
+	}
+	if len(bundle.Images) > 0 {
+		if err := ic.Libpod.LibimageRuntime().Save(ctx, bundle.Images, "docker-archive", filepath.Join(dir, bundleImagesFile), &libimage.SaveOptions{}); err != nil {
+			return fmt.Errorf("saving images: %w", err)
+		}
+	}
+
+	// Volumes
+	if err := os.Mkdir(filepath.Join(dir, bundleVolumesDir), 0o700); err != nil {
+		return err
+	}
+	vols, err := ic.Libpod.GetAllVolumes()
+	if err != nil {
+		return err
+	}
+	for _, vol := range vols {
+		if vol.Driver() == define.VolumeDriverImage {
+			logrus.Warnf("Skipping export of image volume %s", vol.Name())
+			continue
+		}
+		volConfig, err := vol.Config()
+		if err != nil {
+			return err
+		}
+		if err := exportVolumeData(vol, filepath.Join(dir, bundleVolumesDir, vol.Name()+".tar")); err != nil {
+			return err
+		}
+		bundle.Volumes = append(bundle.Volumes, volConfig)
+	}
+
+	// Networks
+	networks, err := ic.Libpod.Network().NetworkList()
+	if err != nil {
+		return err
+	}
+	defaultNetwork := ic.Libpod.Network().DefaultNetworkName()
+	for _, network := range networks {
+		if network.Name != defaultNetwork {
+			bundle.Networks = append(bundle.Networks, network)
+		}
+	}
+
+	// Secrets
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return err
+	}
+	bundle.Secrets, err = manager.List()
+	if err != nil {
+		return err
+	}
+
+	// Pods
+	pods, err := ic.Libpod.GetAllPods()
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		podConfig, err := pod.Config()
+		if err != nil {
+			return err
+		}
+		bundle.Pods = append(bundle.Pods, podConfig)
+	}
+
+	// Containers
+	ctrs, err := ic.Libpod.GetAllContainers()
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		ctrConfig := ctr.ConfigWithNetworks()
+		if ctrConfig == nil {
+			return fmt.Errorf("failed to get config of container %s", ctr.ID())
+		}
+		bundle.Containers = append(bundle.Containers, ctrConfig)
+	}
+
+	// Quadlet files
+	bundle.Quadlets, err = exportQuadlets(filepath.Join(dir, bundleQuadletDir))
+	if err != nil {
+		return err
+	}
+
+	manifest, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, bundleManifestFile), manifest, 0o600); err != nil {
+		return err
+	}
+
+	return utils.CreateTarFromSrc(dir, options.Output)
+}
+
+// exportVolumeData writes the content of the volume into a tar archive at
+// path.
+func exportVolumeData(vol *libpod.Volume, path string) error {
+	mountPoint, err := vol.MountPoint()
+	if err != nil {
+		return err
+	}
+	if mountPoint == "" {
+		if vol.NeedsMount() {
+			logrus.Warnf("Skipping export of the data of volume %s: volume is not mounted", vol.Name())
+		}
+		return nil
+	}
+	tarball, err := archive.Tar(mountPoint, archive.Uncompressed)
+	if err != nil {
+		return fmt.Errorf("archiving volume %s: %w", vol.Name(), err)
+	}
+	defer tarball.Close()
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := io.Copy(file, tarball); err != nil {
+		return fmt.Errorf("archiving volume %s: %w", vol.Name(), err)
+	}
+	return nil
+}
+
+// exportQuadlets copies the quadlet files of the current user into dest and
+// returns their paths relative to dest.
+func exportQuadlets(dest string) ([]string, error) {
+	unitDir, err := quadletUnitDir()
+	if err != nil {
+		return nil, err
+	}
+	var quadlets []string
+	err = filepath.WalkDir(unitDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == unitDir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(unitDir, path)
+		if err != nil {
+			return err
+		}
+		if err := copyFile(path, filepath.Join(dest, rel)); err != nil {
+			return err
+		}
+		quadlets = append(quadlets, rel)
+		return nil
+	})
+	return quadlets, err
+}
+
+func copyFile(src, dest string) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dest, data, 0o644)
+}
+
+// SystemImport re-creates the content of a bundle written by SystemExport.
+// All objects keep their names and containers are not started.
+func (ic *ContainerEngine) SystemImport(ctx context.Context, options entities.SystemImportOptions) (*entities.SystemImportReport, error) {
+	dir, err := os.MkdirTemp("", "podman-import")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Removing temporary import directory %s: %v", dir, err)
+		}
+	}()
+
+	tarball, err := os.Open(options.Input)
+	if err != nil {
+		return nil, err
+	}
+	defer tarball.Close()
+	if err := utils.UntarToFileSystem(dir, tarball, nil); err != nil {
+		return nil, fmt.Errorf("extracting bundle %s: %w", options.Input, err)
+	}
+
+	var bundle systemBundle
+	manifest, err := os.ReadFile(filepath.Join(dir, bundleManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading bundle manifest: %w", err)
+	}
+	if err := json.Unmarshal(manifest, &bundle); err != nil {
+		return nil, fmt.Errorf("parsing bundle manifest: %w", err)
+	}
+	if bundle.Version != systemBundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %d, expected %d", bundle.Version, systemBundleVersion)
+	}
+
+	// Secret data is not part of the bundle, so make sure all secrets
+	// used by containers exist before changing anything.
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	var missingSecrets []string
+	for _, ctrConfig := range bundle.Containers {
+		for _, secret := range ctrConfig.Secrets {
+			existing, err := manager.Lookup(secret.Name)
+			if err != nil {
+				if errors.Is(err, secrets.ErrNoSuchSecret) {
+					missingSecrets = append(missingSecrets, secret.Name)
+					continue
+				}
+				return nil, err
+			}
+			secret.Secret = existing
+		}
+	}
+	if len(missingSecrets) > 0 {
+		return nil, fmt.Errorf("secrets %v used by the exported containers must be created before importing: %w", missingSecrets, secrets.ErrNoSuchSecret)
+	}
+
+	report := &entities.SystemImportReport{}
+
+	for _, network := range bundle.Networks {
+		if _, err := ic.Libpod.Network().NetworkInspect(network.Name); err == nil {
+			logrus.Infof("Network %s exists already, skipping", network.Name)
+			continue
+		}
+		if _, err := ic.Libpod.Network().NetworkCreate(network, nil); err != nil {
+			return report, fmt.Errorf("creating network %s: %w", network.Name, err)
+		}
+		report.Networks = append(report.Networks, network.Name)
+	}
+
+	if len(bundle.Images) > 0 {
+		report.Images, err = ic.Libpod.LibimageRuntime().Load(ctx, filepath.Join(dir, bundleImagesFile), &libimage.LoadOptions{})
+		if err != nil {
+			return report, fmt.Errorf("loading images: %w", err)
+		}
+	}
+
+	for _, volConfig := range bundle.Volumes {
+		if err := ic.restoreBundleVolume(ctx, volConfig, filepath.Join(dir, bundleVolumesDir, volConfig.Name+".tar")); err != nil {
+			return report, err
+		}
+		report.Volumes = append(report.Volumes, volConfig.Name)
+	}
+
+	for _, podConfig := range bundle.Pods {
+		if _, err := ic.Libpod.ImportPod(ctx, podConfig); err != nil {
+			return report, fmt.Errorf("importing pod %s: %w", podConfig.Name, err)
+		}
+		report.Pods = append(report.Pods, podConfig.Name)
+	}
+
+	for _, ctrConfig := range bundle.Containers {
+		if ctrConfig.RootfsImageID == "" {
+			continue
+		}
+		// Fall back to the image name in case the ID of the image
+		// changed when saving it.
+		if _, _, err := ic.Libpod.LibimageRuntime().LookupImage(ctrConfig.RootfsImageID, nil); err != nil {
+			img, _, err := ic.Libpod.LibimageRuntime().LookupImage(ctrConfig.RootfsImageName, nil)
+			if err != nil {
+				return report, fmt.Errorf("looking up image of container %s: %w", ctrConfig.Name, err)
+			}
+			ctrConfig.RootfsImageID = img.ID()
+		}
+	}
+	ctrs, err := ic.Libpod.ImportContainers(ctx, bundle.Containers)
+	for _, ctr := range ctrs {
+		report.Containers = append(report.Containers, ctr.Name())
+	}
+	if err != nil {
+		return report, err
+	}
+
+	unitDir, err := quadletUnitDir()
+	if err != nil {
+		return report, err
+	}
+	report.Quadlets, err = importQuadlets(filepath.Join(dir, bundleQuadletDir), unitDir, bundle.Quadlets)
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// importQuadlets copies the quadlet files at the relative paths quadlets
+// from srcDir to unitDir and returns the paths of the files written.  Files
+// which exist already are skipped.  The paths come from the bundle, so all of
+// them must be local to both directories.
+func importQuadlets(srcDir, unitDir string, quadlets []string) ([]string, error) {
+	for _, rel := range quadlets {
+		if !filepath.IsLocal(rel) {
+			return nil, fmt.Errorf("invalid quadlet path %q in bundle: %w", rel, define.ErrInvalidArg)
+		}
+	}
+	var written []string
+	for _, rel := range quadlets {
+		dest, err := securejoin.SecureJoin(unitDir, rel)
+		if err != nil {
+			return written, err
+		}
+		if _, err := os.Stat(dest); err == nil {
+			logrus.Warnf("Quadlet file %s exists already, skipping", dest)
+			continue
+		}
+		src, err := securejoin.SecureJoin(srcDir, rel)
+		if err != nil {
+			return written, err
+		}
+		if err := copyFile(src, dest); err != nil {
+			return written, err
+		}
+		written = append(written, dest)
+	}
+	return written, nil
+}
+
+// restoreBundleVolume re-creates the volume described by volConfig and restores its
+// data from the tar archive at path.
+func (ic *ContainerEngine) restoreBundleVolume(ctx context.Context, volConfig *libpod.VolumeConfig, path string) error {
+	volumeOptions := []libpod.VolumeCreateOption{
+		libpod.WithVolumeName(volConfig.Name),
+		libpod.WithVolumeLabels(volConfig.Labels),
+	}
+	if volConfig.Driver != "" && volConfig.Driver != define.VolumeDriverLocal {
+		volumeOptions = append(volumeOptions, libpod.WithVolumeDriver(volConfig.Driver))
+	}
+	if len(volConfig.Options) > 0 {
+		parsedOptions, err := parse.VolumeOptions(volConfig.Options)
+		if err != nil {
+			return err
+		}
+		volumeOptions = append(volumeOptions, parsedOptions...)
+	}
+	vol, err := ic.Libpod.NewVolume(ctx, volumeOptions...)
+	if err != nil {
+		return fmt.Errorf("creating volume %s: %w", volConfig.Name, err)
+	}
+
+	tarball, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// No data has been exported.
+			return nil
+		}
+		return err
+	}
+	defer tarball.Close()
+
+	mountPoint, err := vol.Mount()
+	if err != nil {
+		return fmt.Errorf("mounting volume %s: %w", vol.Name(), err)
+	}
+	defer func() {
+		if err := vol.Unmount(); err != nil {
+			logrus.Errorf("Unmounting volume %s: %v", vol.Name(), err)
+		}
+	}()
+	if err := utils.UntarToFileSystem(mountPoint, tarball, nil); err != nil {
+		return fmt.Errorf("restoring data of volume %s: %w", vol.Name(), err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+//go:build !remote
+
+package abi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportQuadlets(t *testing.T) {
+	dir := t.TempDir()
+	srcDir := filepath.Join(dir, "bundle", bundleQuadletDir)
+	unitDir := filepath.Join(dir, "units")
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "web.container"), []byte("[Container]\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "sub", "db.volume"), []byte("[Volume]\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".bashrc"), []byte("original"), 0o644))
+
+	for _, quadlets := range [][]string{
+		{"web.container", "../../.bashrc"},
+		{"../units/web.container"},
+		{"/tmp/web.container"},
+		{""},
+	} {
+		_, err := importQuadlets(srcDir, unitDir, quadlets)
+		assert.ErrorIs(t, err, define.ErrInvalidArg, "%v", quadlets)
+	}
+	_, err := os.Stat(unitDir)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	data, err := os.ReadFile(filepath.Join(dir, ".bashrc"))
+	require.NoError(t, err)
+	assert.Equal(t, "original", string(data))
+
+	written, err := importQuadlets(srcDir, unitDir, []string{"web.container", "sub/db.volume"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(unitDir, "web.container"), filepath.Join(unitDir, "sub", "db.volume")}, written)
+	data, err = os.ReadFile(filepath.Join(unitDir, "sub", "db.volume"))
+	require.NoError(t, err)
+	assert.Equal(t, "[Volume]\n", string(data))
+
+	// Existing files are kept.
+	written, err = importQuadlets(srcDir, unitDir, []string{"web.container"})
+	require.NoError(t, err)
+	assert.Empty(t, written)
+}
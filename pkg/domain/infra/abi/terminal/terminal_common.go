@@ -38,6 +38,34 @@ func ExecAttachCtr(ctx context.Context, ctr *libpod.Container, execConfig *libpo
 	return ctr.Exec(execConfig, streams, resizechan)
 }
 
+// AttachExecSession attaches to a running exec session of a container
+func AttachExecSession(ctx context.Context, ctr *libpod.Container, sessionID string, detachKeys *string, streams *define.AttachStreams) error {
+	session, err := ctr.ExecSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	var resizechan chan resize.TerminalSize
+	haveTerminal := term.IsTerminal(int(os.Stdin.Fd()))
+
+	// Check if we are attached to a terminal. If we are, generate resize
+	// events, and set the terminal to raw mode
+	if haveTerminal && session.Config.Terminal {
+		resizechan = make(chan resize.TerminalSize)
+		cancel, oldTermState, err := handleTerminalAttach(ctx, resizechan)
+		if err != nil {
+			return err
+		}
+		defer cancel()
+		defer func() {
+			if err := restoreTerminal(oldTermState); err != nil {
+				logrus.Errorf("Unable to restore terminal: %q", err)
+			}
+		}()
+	}
+	return ctr.ExecAttach(sessionID, streams, detachKeys, resizechan)
+}
+
 // StartAttachCtr starts and (if required) attaches to a container
 // if you change the signature of this function from os.File to io.Writer, it will trigger a downstream
 // error. we may need to just lint disable this one.
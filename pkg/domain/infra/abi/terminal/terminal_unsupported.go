@@ -16,6 +16,11 @@ func ExecAttachCtr(ctx context.Context, ctr *libpod.Container, execConfig *libpo
 	return -1, errors.New("not implemented ExecAttachCtr")
 }
 
+// AttachExecSession attaches to a running exec session of a container
+func AttachExecSession(ctx context.Context, ctr *libpod.Container, sessionID string, detachKeys *string, streams *define.AttachStreams) error {
+	return errors.New("not implemented AttachExecSession")
+}
+
 // StartAttachCtr starts and (if required) attaches to a container
 // if you change the signature of this function from os.File to io.Writer, it will trigger a downstream
 // error. we may need to just lint disable this one.
@@ -11,6 +11,7 @@ import (
 	"github.com/containers/podman/v5/pkg/domain/entities/reports"
 	"github.com/containers/podman/v5/pkg/domain/filters"
 	"github.com/containers/podman/v5/pkg/domain/infra/abi/parse"
+	"github.com/containers/podman/v5/pkg/parallel"
 )
 
 func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.VolumeCreateOptions) (*entities.IDOrNameResponse, error) {
@@ -52,12 +53,26 @@ func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, op
 		reports = []*entities.VolumeRmReport{}
 	)
 
-	if opts.All {
+	switch {
+	case len(opts.Filters) > 0:
+		volumeFilters := make([]libpod.VolumeFilter, 0, len(opts.Filters))
+		for filter, value := range opts.Filters {
+			filterFunc, err := filters.GenerateVolumeFilters(filter, value, ic.Libpod)
+			if err != nil {
+				return nil, err
+			}
+			volumeFilters = append(volumeFilters, filterFunc)
+		}
+		vols, err = ic.Libpod.Volumes(volumeFilters...)
+		if err != nil {
+			return nil, err
+		}
+	case opts.All:
 		vols, err = ic.Libpod.Volumes()
 		if err != nil {
 			return nil, err
 		}
-	} else {
+	default:
 		for _, id := range namesOrIds {
 			vol, err := ic.Libpod.LookupVolume(id)
 			if err != nil {
@@ -73,9 +88,16 @@ func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, op
 			vols = append(vols, vol)
 		}
 	}
+	errChans := make([]<-chan error, 0, len(vols))
 	for _, vol := range vols {
+		vol := vol
+		errChans = append(errChans, parallel.Enqueue(ctx, func() error {
+			return ic.Libpod.RemoveVolume(ctx, vol, opts.Force, opts.Timeout)
+		}))
+	}
+	for i, vol := range vols {
 		reports = append(reports, &entities.VolumeRmReport{
-			Err: ic.Libpod.RemoveVolume(ctx, vol, opts.Force, opts.Timeout),
+			Err: <-errChans[i],
 			Id:  vol.Name(),
 		})
 	}
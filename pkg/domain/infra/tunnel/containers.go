@@ -40,21 +40,82 @@ func (ic *ContainerEngine) ContainerExists(ctx context.Context, nameOrID string,
 }
 
 func (ic *ContainerEngine) ContainerWait(ctx context.Context, namesOrIds []string, opts entities.WaitOptions) ([]entities.WaitReport, error) {
-	responses := make([]entities.WaitReport, 0, len(namesOrIds))
 	options := new(containers.WaitOptions).WithConditions(opts.Conditions).WithInterval(opts.Interval.String())
-	for _, n := range namesOrIds {
-		response := entities.WaitReport{}
-		exitCode, err := containers.Wait(ic.ClientCtx, n, options)
+	if opts.UntilLog != "" {
+		options.WithUntilLog(opts.UntilLog)
+	}
+	if opts.UntilPort != 0 {
+		options.WithUntilPort(uint(opts.UntilPort))
+	}
+	ids := namesOrIds
+	rawInputs := namesOrIds
+	if len(opts.Filters) > 0 {
+		ctrs, inputs, err := getContainersAndInputByContext(ic.ClientCtx, false, opts.Ignore, namesOrIds, opts.Filters)
 		if err != nil {
-			if opts.Ignore && errorhandling.Contains(err, define.ErrNoSuchCtr) {
-				response.ExitCode = -1
-			} else {
-				response.Error = err
-			}
-		} else {
+			return nil, err
+		}
+		ids = make([]string, 0, len(ctrs))
+		for i := range ctrs {
+			ids = append(ids, ctrs[i].ID)
+		}
+		rawInputs = inputs
+	}
+
+	waitCtx, cancel := context.WithCancel(ic.ClientCtx)
+	defer cancel()
+	if opts.Timeout > 0 {
+		waitCtx, cancel = context.WithTimeout(waitCtx, opts.Timeout)
+		defer cancel()
+	}
+	// wait returns the report for the container and whether it is done
+	// waiting, that is neither failed nor was ignored.
+	wait := func(i int) (entities.WaitReport, bool) {
+		response := entities.WaitReport{RawInput: rawInputs[i]}
+		if len(opts.Filters) > 0 {
+			response.Id = ids[i]
+		}
+		exitCode, err := containers.Wait(waitCtx, ids[i], options)
+		switch {
+		case err == nil:
 			response.ExitCode = exitCode
+			return response, true
+		case opts.Ignore && errorhandling.Contains(err, define.ErrNoSuchCtr):
+			response.ExitCode = -1
+		case errors.Is(waitCtx.Err(), context.DeadlineExceeded):
+			response.Error = fmt.Errorf("timed out waiting for container %s after %s: %w", ids[i], opts.Timeout, waitCtx.Err())
+		default:
+			response.Error = err
 		}
-		responses = append(responses, response)
+		return response, false
+	}
+
+	responses := make([]entities.WaitReport, 0, len(ids))
+	if !opts.Any {
+		for i := range ids {
+			response, _ := wait(i)
+			responses = append(responses, response)
+		}
+		return responses, nil
+	}
+	// Wait for all containers concurrently and return the first one
+	// done waiting, the others are canceled on return.
+	type waitResult struct {
+		response entities.WaitReport
+		done     bool
+	}
+	resultChan := make(chan waitResult, len(ids))
+	for i := range ids {
+		go func(i int) {
+			response, done := wait(i)
+			resultChan <- waitResult{response, done}
+		}(i)
+	}
+	for range ids {
+		result := <-resultChan
+		if result.done {
+			return []entities.WaitReport{result.response}, nil
+		}
+		responses = append(responses, result.response)
 	}
 	return responses, nil
 }
@@ -153,7 +214,7 @@ func (ic *ContainerEngine) ContainerStop(ctx context.Context, namesOrIds []strin
 }
 
 func (ic *ContainerEngine) ContainerKill(ctx context.Context, namesOrIds []string, opts entities.KillOptions) ([]*entities.KillReport, error) {
-	ctrs, rawInputs, err := getContainersAndInputByContext(ic.ClientCtx, opts.All, false, namesOrIds, nil)
+	ctrs, rawInputs, err := getContainersAndInputByContext(ic.ClientCtx, opts.All, false, namesOrIds, opts.Filters)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +226,7 @@ func (ic *ContainerEngine) ContainerKill(ctx context.Context, namesOrIds []strin
 	reports := make([]*entities.KillReport, 0, len(ctrs))
 	for _, c := range ctrs {
 		err := containers.Kill(ic.ClientCtx, c.ID, options)
-		if err != nil && opts.All && strings.Contains(err.Error(), define.ErrCtrStateInvalid.Error()) {
+		if err != nil && (opts.All || len(opts.Filters) > 0) && strings.Contains(err.Error(), define.ErrCtrStateInvalid.Error()) {
 			logrus.Debugf("Container %s is not running", c.ID)
 			continue
 		}
@@ -294,7 +355,7 @@ func (ic *ContainerEngine) ContainerInspect(ctx context.Context, namesOrIds []st
 		reports = make([]*entities.ContainerInspectReport, 0, len(namesOrIds))
 		errs    = []error{}
 	)
-	options := new(containers.InspectOptions).WithSize(opts.Size)
+	options := new(containers.InspectOptions).WithSize(opts.Size).WithHooks(opts.ShowHooks)
 	for _, name := range namesOrIds {
 		inspect, err := containers.Inspect(ic.ClientCtx, name, options)
 		if err != nil {
@@ -369,6 +430,29 @@ func (ic *ContainerEngine) ContainerExport(ctx context.Context, nameOrID string,
 	return containers.Export(ic.ClientCtx, nameOrID, options.Output, nil)
 }
 
+func (ic *ContainerEngine) ContainerNetCapture(ctx context.Context, nameOrID string, opts entities.ContainerNetCaptureOptions) error {
+	options := new(containers.NetCaptureOptions).WithInterface(opts.Interface).WithFilter(opts.Filter).
+		WithSnaplen(uint64(opts.Snaplen)).WithCount(opts.Count)
+
+	// the connection is stored in the client context, stop the capture
+	// when the given context is cancelled
+	clientCtx, cancel := context.WithCancel(ic.ClientCtx)
+	defer cancel()
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-clientCtx.Done():
+		}
+	}()
+	err := containers.NetCapture(clientCtx, nameOrID, opts.Output, options)
+	if ctx.Err() != nil {
+		// interrupted by the user
+		return nil
+	}
+	return err
+}
+
 func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds []string, opts entities.CheckpointOptions) ([]*entities.CheckpointReport, error) {
 	var (
 		err          error
@@ -388,7 +472,7 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 	options.WithLeaveRunning(opts.LeaveRunning)
 	options.WithWithPrevious(opts.WithPrevious)
 
-	if opts.All {
+	if opts.All && len(opts.Filters) == 0 {
 		allCtrs, err := getContainersByContext(ic.ClientCtx, true, false, []string{})
 		if err != nil {
 			return nil, err
@@ -400,7 +484,7 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 			}
 		}
 	} else {
-		ctrs, rawInputs, err = getContainersAndInputByContext(ic.ClientCtx, false, false, namesOrIds, nil)
+		ctrs, rawInputs, err = getContainersAndInputByContext(ic.ClientCtx, false, false, namesOrIds, opts.Filters)
 		if err != nil {
 			return nil, err
 		}
@@ -585,6 +669,7 @@ func makeExecConfig(options entities.ExecOptions) *handlers.ExecCreateConfig {
 	createConfig.Env = env
 	createConfig.WorkingDir = options.WorkDir
 	createConfig.Cmd = options.Cmd
+	createConfig.Name = options.Name
 
 	return createConfig
 }
@@ -647,6 +732,22 @@ func (ic *ContainerEngine) ContainerExecDetached(ctx context.Context, nameOrID s
 	return sessionID, nil
 }
 
+func (ic *ContainerEngine) ContainerExecAttach(ctx context.Context, nameOrID string, options entities.ExecAttachOptions) error {
+	attachOpts := new(containers.ExecAttachOptions)
+	if options.DetachKeys != nil {
+		attachOpts.WithDetachKeys(*options.DetachKeys)
+	}
+	stdin := options.Stdin
+	if options.NoStdin {
+		stdin = nil
+	}
+	err := containers.ExecAttach(ic.ClientCtx, nameOrID, stdin, options.Stdout, options.Stderr, attachOpts)
+	if err != nil && !errors.Is(err, define.ErrDetach) {
+		return err
+	}
+	return nil
+}
+
 func startAndAttach(ic *ContainerEngine, name string, detachKeys *string, sigProxy bool, input, output, errput *os.File) error {
 	if output == nil && errput == nil {
 		fmt.Printf("%s\n", name)
@@ -1066,13 +1167,47 @@ func (ic *ContainerEngine) ContainerPort(ctx context.Context, nameOrID string, o
 	return reports, nil
 }
 
+func (ic *ContainerEngine) ContainerPortAdd(ctx context.Context, nameOrID string, ports []string) (*entities.ContainerPortReport, error) {
+	return containers.AddPorts(ic.ClientCtx, nameOrID, new(containers.AddPortsOptions).WithPorts(ports))
+}
+
+func (ic *ContainerEngine) ContainerPortRm(ctx context.Context, nameOrID string, ports []string) (*entities.ContainerPortReport, error) {
+	return containers.RemovePorts(ic.ClientCtx, nameOrID, new(containers.RemovePortsOptions).WithPorts(ports))
+}
+
 func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrID, path string, reader io.Reader, options entities.CopyOptions) (entities.ContainerCopyFunc, error) {
 	copyOptions := new(containers.CopyOptions).WithChown(options.Chown).WithRename(options.Rename).WithNoOverwriteDirNonDir(options.NoOverwriteDirNonDir)
+	// Only send the newer options when set, so older servers keep working.
+	if options.NoOverwriteDir {
+		copyOptions.WithNoOverwriteDir(true)
+	}
+	if options.StripXattrs {
+		copyOptions.WithStripXattrs(true)
+	}
+	if options.StripSpecialBits {
+		copyOptions.WithStripSpecialBits(true)
+	}
+	if len(options.Remove) > 0 {
+		copyOptions.WithRemove(options.Remove)
+	}
 	return containers.CopyFromArchiveWithOptions(ic.ClientCtx, nameOrID, path, reader, copyOptions)
 }
 
-func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer) (entities.ContainerCopyFunc, error) {
-	return containers.CopyToArchive(ic.ClientCtx, nameOrID, path, writer)
+func (ic *ContainerEngine) ContainerCopyToArchive(ctx context.Context, nameOrID string, path string, writer io.Writer, options entities.CopyToArchiveOptions) (entities.ContainerCopyFunc, error) {
+	copyOptions := new(containers.CopyToArchiveOptions)
+	if len(options.Excludes) > 0 {
+		copyOptions.WithExclude(options.Excludes)
+	}
+	if len(options.Includes) > 0 {
+		copyOptions.WithInclude(options.Includes)
+	}
+	if options.NoChown {
+		copyOptions.WithNoChown(true)
+	}
+	if options.NoContents {
+		copyOptions.WithNoContents(true)
+	}
+	return containers.CopyToArchiveWithOptions(ic.ClientCtx, nameOrID, path, writer, copyOptions)
 }
 
 func (ic *ContainerEngine) ContainerStat(ctx context.Context, nameOrID string, path string) (*entities.ContainerStatReport, error) {
@@ -1106,13 +1241,13 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 
 // ContainerUpdate finds and updates the given container's cgroup config with the specified options
 func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, updateOptions *entities.ContainerUpdateOptions) (string, error) {
-	err := specgen.WeightDevices(updateOptions.Specgen)
-	if err != nil {
-		return "", err
-	}
-	err = specgen.FinishThrottleDevices(updateOptions.Specgen)
-	if err != nil {
-		return "", err
+	if updateOptions.Specgen != nil {
+		if err := specgen.WeightDevices(updateOptions.Specgen); err != nil {
+			return "", err
+		}
+		if err := specgen.FinishThrottleDevices(updateOptions.Specgen); err != nil {
+			return "", err
+		}
 	}
 	return containers.Update(ic.ClientCtx, updateOptions)
 }
@@ -81,16 +81,20 @@ func getContainersAndInputByContext(contextWithConnection context.Context, all,
 	return filtered, rawInputs, nil
 }
 
-func getPodsByContext(contextWithConnection context.Context, all bool, namesOrIDs []string) ([]*entities.ListPodsReport, error) {
+func getPodsByContext(contextWithConnection context.Context, all bool, filters map[string][]string, namesOrIDs []string) ([]*entities.ListPodsReport, error) {
 	if all && len(namesOrIDs) > 0 {
 		return nil, errors.New("cannot look up specific pods and all")
 	}
 
-	allPods, err := pods.List(contextWithConnection, nil)
+	options := new(pods.ListOptions)
+	if len(filters) > 0 {
+		options.WithFilters(filters)
+	}
+	allPods, err := pods.List(contextWithConnection, options)
 	if err != nil {
 		return nil, err
 	}
-	if all {
+	if all || len(filters) > 0 {
 		return allPods, nil
 	}
 
@@ -0,0 +1,21 @@
+package tunnel
+
+import (
+	"context"
+	"errors"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+)
+
+func (ic *ContainerEngine) HooksList(ctx context.Context) ([]*define.OCIHook, error) {
+	return nil, errors.New("listing OCI hooks is not supported for remote clients")
+}
+
+func (ic *ContainerEngine) HooksInspect(ctx context.Context, names []string) ([]*define.OCIHook, []error, error) {
+	return nil, nil, errors.New("inspecting OCI hooks is not supported for remote clients")
+}
+
+func (ic *ContainerEngine) HooksValidate(ctx context.Context, path string, options entities.HooksValidateOptions) (*entities.HooksValidateReport, error) {
+	return nil, errors.New("validating OCI hooks is not supported for remote clients")
+}
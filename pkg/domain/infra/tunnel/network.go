@@ -14,6 +14,11 @@ import (
 
 func (ic *ContainerEngine) NetworkUpdate(ctx context.Context, netName string, opts entities.NetworkUpdateOptions) error {
 	options := new(network.UpdateOptions).WithAddDNSServers(opts.AddDNSServers).WithRemoveDNSServers(opts.RemoveDNSServers)
+	options.WithAddSubnets(opts.AddSubnets).WithRemoveSubnets(opts.RemoveSubnets).WithGateways(opts.Gateways).WithIPRanges(opts.IPRanges)
+	options.WithAddLabels(opts.AddLabels).WithRemoveLabels(opts.RemoveLabels).WithAddOptions(opts.AddOptions).WithRemoveOptions(opts.RemoveOptions)
+	if opts.Internal != nil {
+		options.WithInternal(*opts.Internal)
+	}
 	return network.Update(ic.ClientCtx, netName, options)
 }
 
@@ -46,6 +51,30 @@ func (ic *ContainerEngine) NetworkInspect(ctx context.Context, namesOrIds []stri
 	return reports, errs, nil
 }
 
+func (ic *ContainerEngine) NetworkInspectLive(ctx context.Context, namesOrIds []string, opts entities.InspectOptions) ([]*entities.NetworkInspectLiveReport, []error, error) {
+	var (
+		reports = make([]*entities.NetworkInspectLiveReport, 0, len(namesOrIds))
+		errs    = []error{}
+	)
+	options := new(network.InspectOptions)
+	for _, name := range namesOrIds {
+		report, err := network.InspectLive(ic.ClientCtx, name, options)
+		if err != nil {
+			errModel, ok := err.(*errorhandling.ErrorModel)
+			if !ok {
+				return nil, nil, err
+			}
+			if errModel.ResponseCode == 404 {
+				errs = append(errs, fmt.Errorf("network %s: %w", name, define.ErrNoSuchNetwork))
+				continue
+			}
+			return nil, nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, errs, nil
+}
+
 func (ic *ContainerEngine) NetworkReload(ctx context.Context, names []string, opts entities.NetworkReloadOptions) ([]*entities.NetworkReloadReport, error) {
 	return nil, errors.New("not implemented")
 }
@@ -23,7 +23,7 @@ func (ic *ContainerEngine) PodKill(ctx context.Context, namesOrIds []string, opt
 		return nil, err
 	}
 
-	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, namesOrIds)
+	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, opts.Filters, namesOrIds)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (ic *ContainerEngine) PodLogs(ctx context.Context, nameOrIDs string, option
 }
 
 func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, options entities.PodPauseOptions) ([]*entities.PodPauseReport, error) {
-	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, namesOrIds)
+	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, options.Filters, namesOrIds)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func (ic *ContainerEngine) PodPause(ctx context.Context, namesOrIds []string, op
 }
 
 func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string, options entities.PodunpauseOptions) ([]*entities.PodUnpauseReport, error) {
-	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, namesOrIds)
+	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, options.Filters, namesOrIds)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +102,7 @@ func (ic *ContainerEngine) PodUnpause(ctx context.Context, namesOrIds []string,
 
 func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, opts entities.PodStopOptions) ([]*entities.PodStopReport, error) {
 	timeout := -1
-	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, namesOrIds)
+	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, opts.Filters, namesOrIds)
 	if err != nil && !(opts.Ignore && errors.Is(err, define.ErrNoSuchPod)) {
 		return nil, err
 	}
@@ -127,7 +127,7 @@ func (ic *ContainerEngine) PodStop(ctx context.Context, namesOrIds []string, opt
 }
 
 func (ic *ContainerEngine) PodRestart(ctx context.Context, namesOrIds []string, options entities.PodRestartOptions) ([]*entities.PodRestartReport, error) {
-	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, namesOrIds)
+	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, options.Filters, namesOrIds)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +148,7 @@ func (ic *ContainerEngine) PodRestart(ctx context.Context, namesOrIds []string,
 }
 
 func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, options entities.PodStartOptions) ([]*entities.PodStartReport, error) {
-	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, namesOrIds)
+	foundPods, err := getPodsByContext(ic.ClientCtx, options.All, options.Filters, namesOrIds)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +169,7 @@ func (ic *ContainerEngine) PodStart(ctx context.Context, namesOrIds []string, op
 }
 
 func (ic *ContainerEngine) PodRm(ctx context.Context, namesOrIds []string, opts entities.PodRmOptions) ([]*entities.PodRmReport, error) {
-	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, namesOrIds)
+	foundPods, err := getPodsByContext(ic.ClientCtx, opts.All, opts.Filters, namesOrIds)
 	if err != nil && !(opts.Ignore && errors.Is(err, define.ErrNoSuchPod)) {
 		return nil, err
 	}
@@ -35,8 +35,20 @@ func (ic *ContainerEngine) Reset(ctx context.Context) error {
 	return errors.New("system reset is not supported on remote clients")
 }
 
+func (ic *ContainerEngine) SystemCheck(ctx context.Context, options entities.SystemCheckOptions) (*entities.SystemCheckReport, error) {
+	return nil, errors.New("system check is not supported on remote clients")
+}
+
+func (ic *ContainerEngine) SystemExport(ctx context.Context, options entities.SystemExportOptions) error {
+	return errors.New("system export is not supported on remote clients")
+}
+
+func (ic *ContainerEngine) SystemImport(ctx context.Context, options entities.SystemImportOptions) (*entities.SystemImportReport, error) {
+	return nil, errors.New("system import is not supported on remote clients")
+}
+
 func (ic *ContainerEngine) SystemDf(ctx context.Context, options entities.SystemDfOptions) (*entities.SystemDfReport, error) {
-	return system.DiskUsage(ic.ClientCtx, nil)
+	return system.DiskUsage(ic.ClientCtx, new(system.DiskOptions).WithAnalyze(options.Analyze))
 }
 
 func (ic *ContainerEngine) Unshare(ctx context.Context, args []string, options entities.SystemUnshareOptions) error {
@@ -20,11 +20,16 @@ func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.Volum
 }
 
 func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, opts entities.VolumeRmOptions) ([]*entities.VolumeRmReport, error) {
-	if opts.All {
-		vols, err := volumes.List(ic.ClientCtx, nil)
+	if opts.All || len(opts.Filters) > 0 {
+		options := new(volumes.ListOptions)
+		if len(opts.Filters) > 0 {
+			options.WithFilters(opts.Filters)
+		}
+		vols, err := volumes.List(ic.ClientCtx, options)
 		if err != nil {
 			return nil, err
 		}
+		namesOrIds = nil
 		for _, v := range vols {
 			namesOrIds = append(namesOrIds, v.Name)
 		}
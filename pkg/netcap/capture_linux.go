@@ -0,0 +1,207 @@
+//go:build linux
+
+package netcap
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+	"unsafe"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+// captureInterface is an interface packets are captured on.
+type captureInterface struct {
+	index int
+	name  string
+}
+
+// Capture captures packets in the network namespace at netNSPath and writes
+// them to w in the pcapng format until ctx is cancelled or options.Count
+// packets were captured. It returns the number of captured packets.
+func Capture(ctx context.Context, netNSPath string, options Options, w io.Writer) (uint64, error) {
+	filter, err := Compile(options.Filter)
+	if err != nil {
+		return 0, err
+	}
+	snaplen := options.Snaplen
+	if snaplen == 0 {
+		snaplen = DefaultSnaplen
+	}
+
+	var (
+		fd         = -1
+		interfaces []captureInterface
+	)
+	// the packet socket belongs to the network namespace it was created in,
+	// packets can be read from it after leaving the namespace
+	err = ns.WithNetNSPath(netNSPath, func(_ ns.NetNS) error {
+		var err error
+		interfaces, err = captureInterfaces(options.Interface)
+		if err != nil {
+			return err
+		}
+		ifindex := 0
+		if options.Interface != "" {
+			ifindex = interfaces[0].index
+		}
+		fd, err = openPacketSocket(ifindex, filter)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(fd)
+
+	pw, err := NewWriter(w, "podman")
+	if err != nil {
+		return 0, err
+	}
+	ids := make(map[int]uint32, len(interfaces))
+	for _, iface := range interfaces {
+		id, err := pw.AddInterface(iface.name, LinkTypeEthernet, snaplen)
+		if err != nil {
+			return 0, err
+		}
+		ids[iface.index] = id
+	}
+
+	var count uint64
+	buf := make([]byte, snaplen)
+	oob := make([]byte, unix.CmsgSpace(int(unsafe.Sizeof(unix.Timespec{}))))
+	for ctx.Err() == nil {
+		// MSG_TRUNC returns the original length of truncated packets
+		n, oobn, _, from, err := unix.Recvmsg(fd, buf, oob, unix.MSG_TRUNC)
+		if err != nil {
+			if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+				continue
+			}
+			return count, fmt.Errorf("reading packet: %w", err)
+		}
+		sa, ok := from.(*unix.SockaddrLinklayer)
+		if !ok {
+			continue
+		}
+		id, ok := ids[sa.Ifindex]
+		if !ok {
+			// interface created after the capture started
+			continue
+		}
+		direction := DirectionInbound
+		if sa.Pkttype == unix.PACKET_OUTGOING {
+			direction = DirectionOutbound
+		}
+		captured := n
+		if captured > len(buf) {
+			captured = len(buf)
+		}
+		if err := pw.WritePacket(id, packetTimestamp(oob[:oobn]), buf[:captured], uint32(n), direction); err != nil {
+			return count, err
+		}
+		count++
+		if options.Count > 0 && count >= options.Count {
+			break
+		}
+	}
+	return count, nil
+}
+
+// captureInterfaces returns the interface with the given name or all
+// Ethernet interfaces of the current network namespace if name is empty.
+func captureInterfaces(name string) ([]captureInterface, error) {
+	if name != "" {
+		link, err := netlink.LinkByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("interface %s: %w", name, err)
+		}
+		if !isEthernet(link) {
+			return nil, fmt.Errorf("interface %s has unsupported link type %s", name, link.Attrs().EncapType)
+		}
+		return []captureInterface{{index: link.Attrs().Index, name: name}}, nil
+	}
+
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, err
+	}
+	interfaces := make([]captureInterface, 0, len(links))
+	for _, link := range links {
+		if isEthernet(link) {
+			interfaces = append(interfaces, captureInterface{index: link.Attrs().Index, name: link.Attrs().Name})
+		}
+	}
+	return interfaces, nil
+}
+
+// isEthernet returns true for links with Ethernet headers, the loopback
+// interface uses zeroed Ethernet headers.
+func isEthernet(link netlink.Link) bool {
+	encap := link.Attrs().EncapType
+	return encap == "ether" || encap == "loopback"
+}
+
+// openPacketSocket opens a packet socket receiving all packets on the given
+// interface, or on all interfaces if ifindex is 0, which match the filter.
+func openPacketSocket(ifindex int, filter []bpf.RawInstruction) (int, error) {
+	// do not pass a protocol yet, otherwise the socket receives packets
+	// before the filter is attached
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return -1, fmt.Errorf("creating packet socket: %w", err)
+	}
+
+	prog := make([]unix.SockFilter, 0, len(filter))
+	for _, ins := range filter {
+		prog = append(prog, unix.SockFilter{Code: ins.Op, Jt: ins.Jt, Jf: ins.Jf, K: ins.K})
+	}
+	fprog := unix.SockFprog{Len: uint16(len(prog)), Filter: &prog[0]}
+	if err := unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &fprog); err != nil {
+		unix.Close(fd)
+		return -1, fmt.Errorf("attaching filter: %w", err)
+	}
+	if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_TIMESTAMPNS, 1); err != nil {
+		unix.Close(fd)
+		return -1, fmt.Errorf("enabling packet timestamps: %w", err)
+	}
+	// wake up regularly to check whether the capture was cancelled
+	timeout := unix.NsecToTimeval((200 * time.Millisecond).Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		unix.Close(fd)
+		return -1, fmt.Errorf("setting receive timeout: %w", err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: ifindex}); err != nil {
+		unix.Close(fd)
+		return -1, fmt.Errorf("binding packet socket: %w", err)
+	}
+	return fd, nil
+}
+
+// packetTimestamp returns the receive time of a packet from the control
+// messages, or the current time if it is missing.
+func packetTimestamp(oob []byte) time.Time {
+	msgs, err := unix.ParseSocketControlMessage(oob)
+	if err == nil {
+		for _, msg := range msgs {
+			if msg.Header.Level == unix.SOL_SOCKET && msg.Header.Type == unix.SCM_TIMESTAMPNS &&
+				len(msg.Data) >= int(unsafe.Sizeof(unix.Timespec{})) {
+				ts := (*unix.Timespec)(unsafe.Pointer(&msg.Data[0]))
+				return time.Unix(ts.Unix())
+			}
+		}
+	}
+	return time.Now()
+}
+
+// htons converts a short from host to network byte order.
+func htons(v uint16) uint16 {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	return *(*uint16)(unsafe.Pointer(&b[0]))
+}
@@ -0,0 +1,14 @@
+//go:build !linux
+
+package netcap
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// Capture is only supported on Linux.
+func Capture(ctx context.Context, netNSPath string, options Options, w io.Writer) (uint64, error) {
+	return 0, errors.New("packet capture is only supported on Linux")
+}
@@ -0,0 +1,558 @@
+package netcap
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/bpf"
+)
+
+// Compile compiles a filter expression into a classic BPF program which can
+// be attached to a packet socket. The expression uses a subset of the
+// pcap-filter(7) syntax for Ethernet frames:
+//
+//	ip, ip6, arp, tcp, udp, sctp, icmp, icmp6
+//	[src|dst] host ADDR
+//	[src|dst] net CIDR
+//	[tcp|udp|sctp] [src|dst] port PORT
+//	[tcp|udp|sctp] [src|dst] portrange PORT-PORT
+//
+// Primitives can be combined with and (&&), or (||), not (!) and parentheses.
+// An empty expression matches all packets.
+func Compile(expr string) ([]bpf.RawInstruction, error) {
+	c := &compiler{}
+	accept, reject := c.newLabel(), c.newLabel()
+
+	if strings.TrimSpace(expr) != "" {
+		p := &parser{tokens: tokenize(expr)}
+		root, err := p.parseOr()
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter %q: %w", expr, err)
+		}
+		if tok := p.peek(); tok != "" {
+			return nil, fmt.Errorf("invalid filter %q: unexpected %q", expr, tok)
+		}
+		root.compile(c, accept, reject)
+	}
+
+	c.place(accept)
+	c.emit(bpf.RetConstant{Val: 0xFFFFFFFF})
+	c.place(reject)
+	c.emit(bpf.RetConstant{Val: 0})
+
+	insns, err := c.resolve()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", expr, err)
+	}
+	return bpf.Assemble(insns)
+}
+
+// tokenize splits a filter expression into words, parentheses and operators.
+func tokenize(expr string) []string {
+	var tokens []string
+	word := strings.Builder{}
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for i := 0; i < len(expr); i++ {
+		switch ch := expr[i]; {
+		case ch == ' ' || ch == '\t' || ch == '\n':
+			flush()
+		case ch == '(' || ch == ')' || ch == '!':
+			flush()
+			tokens = append(tokens, string(ch))
+		case (ch == '&' || ch == '|') && i+1 < len(expr) && expr[i+1] == ch:
+			flush()
+			tokens = append(tokens, expr[i:i+2])
+			i++
+		default:
+			word.WriteByte(ch)
+		}
+	}
+	flush()
+	return tokens
+}
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+	etherTypeARP  = 0x0806
+
+	protoICMP   = 1
+	protoTCP    = 6
+	protoUDP    = 17
+	protoICMPv6 = 58
+	protoSCTP   = 132
+)
+
+var portProtocols = map[string]uint32{
+	"tcp":  protoTCP,
+	"udp":  protoUDP,
+	"sctp": protoSCTP,
+}
+
+type direction int
+
+const (
+	dirAny direction = iota
+	dirSrc
+	dirDst
+)
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) next() string {
+	tok := p.peek()
+	if tok != "" {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "or" || p.peek() == "||" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "and" || p.peek() == "&&" {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	switch tok := p.next(); tok {
+	case "":
+		return nil, errors.New("unexpected end of expression")
+	case "not", "!":
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{x}, nil
+	case "(":
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, errors.New("missing closing parenthesis")
+		}
+		return x, nil
+	default:
+		p.pos--
+		return p.parsePrimitive()
+	}
+}
+
+func (p *parser) parsePrimitive() (node, error) {
+	proto := ""
+	switch tok := p.peek(); tok {
+	case "ip", "ip6", "arp", "tcp", "udp", "sctp", "icmp", "icmp6":
+		proto = p.next()
+	}
+
+	dir := dirAny
+	switch p.peek() {
+	case "src":
+		dir = dirSrc
+		p.next()
+	case "dst":
+		dir = dirDst
+		p.next()
+	}
+
+	kind := p.peek()
+	switch kind {
+	case "host", "net", "port", "portrange":
+		p.next()
+	default:
+		if dir == dirAny {
+			if proto == "" {
+				return nil, fmt.Errorf("unknown primitive %q", kind)
+			}
+			return protoNode{proto}, nil
+		}
+		// like pcap-filter, a direction without a keyword is a host
+		kind = "host"
+	}
+
+	value := p.next()
+	if value == "" {
+		return nil, fmt.Errorf("missing value for %s", kind)
+	}
+
+	var qualifier node
+	switch kind {
+	case "host", "net":
+		ipNet, err := parseAddress(kind, value)
+		if err != nil {
+			return nil, err
+		}
+		if proto != "" && proto != "ip" && proto != "ip6" {
+			if _, ok := portProtocols[proto]; !ok && proto != "icmp" && proto != "icmp6" {
+				return nil, fmt.Errorf("%s cannot be used with %s", kind, proto)
+			}
+		}
+		qualifier = hostNode{dir: dir, ipNet: ipNet}
+	case "port", "portrange":
+		lo, hi, err := parsePortRange(kind, value)
+		if err != nil {
+			return nil, err
+		}
+		protos := []uint32{protoTCP, protoUDP, protoSCTP}
+		if proto != "" {
+			p, ok := portProtocols[proto]
+			if !ok {
+				return nil, fmt.Errorf("%s cannot be used with %s", kind, proto)
+			}
+			protos = []uint32{p}
+			proto = ""
+		}
+		qualifier = portNode{dir: dir, lo: lo, hi: hi, protos: protos}
+	}
+	if proto != "" {
+		return andNode{protoNode{proto}, qualifier}, nil
+	}
+	return qualifier, nil
+}
+
+func parseAddress(kind, value string) (*net.IPNet, error) {
+	if strings.Contains(value, "/") {
+		if kind == "host" {
+			return nil, fmt.Errorf("host %q must not have a prefix length, use net", value)
+		}
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, err
+		}
+		return ipNet, nil
+	}
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", value)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+func parsePortRange(kind, value string) (uint32, uint32, error) {
+	start, end, isRange := strings.Cut(value, "-")
+	if isRange != (kind == "portrange") {
+		return 0, 0, fmt.Errorf("invalid %s %q", kind, value)
+	}
+	lo, err := strconv.ParseUint(start, 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid %s %q", kind, value)
+	}
+	hi := lo
+	if isRange {
+		hi, err = strconv.ParseUint(end, 10, 16)
+		if err != nil || hi < lo {
+			return 0, 0, fmt.Errorf("invalid %s %q", kind, value)
+		}
+	}
+	return uint32(lo), uint32(hi), nil
+}
+
+// labelNext is a jump target which continues with the next instruction.
+const labelNext = -1
+
+type pendingInstruction struct {
+	ins bpf.Instruction
+	// jt and jf are the labels of the targets for conditional jumps
+	jt, jf int
+}
+
+type compiler struct {
+	insns  []pendingInstruction
+	labels []int
+}
+
+func (c *compiler) newLabel() int {
+	c.labels = append(c.labels, -1)
+	return len(c.labels) - 1
+}
+
+func (c *compiler) place(label int) {
+	c.labels[label] = len(c.insns)
+}
+
+func (c *compiler) emit(ins bpf.Instruction) {
+	c.insns = append(c.insns, pendingInstruction{ins: ins, jt: labelNext, jf: labelNext})
+}
+
+func (c *compiler) jump(cond bpf.JumpTest, val uint32, jt, jf int) {
+	c.insns = append(c.insns, pendingInstruction{ins: bpf.JumpIf{Cond: cond, Val: val}, jt: jt, jf: jf})
+}
+
+func (c *compiler) resolve() ([]bpf.Instruction, error) {
+	// the kernel limit of classic BPF programs
+	if len(c.insns) > 4096 {
+		return nil, errors.New("expression is too complex")
+	}
+	insns := make([]bpf.Instruction, 0, len(c.insns))
+	for i, pending := range c.insns {
+		jumpIf, ok := pending.ins.(bpf.JumpIf)
+		if ok {
+			skipTrue, err := c.skip(i, pending.jt)
+			if err != nil {
+				return nil, err
+			}
+			skipFalse, err := c.skip(i, pending.jf)
+			if err != nil {
+				return nil, err
+			}
+			jumpIf.SkipTrue, jumpIf.SkipFalse = skipTrue, skipFalse
+			pending.ins = jumpIf
+		}
+		insns = append(insns, pending.ins)
+	}
+	return insns, nil
+}
+
+func (c *compiler) skip(pos, label int) (uint8, error) {
+	if label == labelNext {
+		return 0, nil
+	}
+	skip := c.labels[label] - pos - 1
+	if skip < 0 || skip > 255 {
+		return 0, errors.New("expression is too complex")
+	}
+	return uint8(skip), nil
+}
+
+// loadEtherType loads the EtherType of the frame into A.
+func (c *compiler) loadEtherType() {
+	c.emit(bpf.LoadAbsolute{Off: 12, Size: 2})
+}
+
+// node is a part of a filter expression. compile emits instructions which
+// jump to t if the packet matches and to f otherwise.
+type node interface {
+	compile(c *compiler, t, f int)
+}
+
+type andNode struct{ left, right node }
+
+func (n andNode) compile(c *compiler, t, f int) {
+	right := c.newLabel()
+	n.left.compile(c, right, f)
+	c.place(right)
+	n.right.compile(c, t, f)
+}
+
+type orNode struct{ left, right node }
+
+func (n orNode) compile(c *compiler, t, f int) {
+	right := c.newLabel()
+	n.left.compile(c, t, right)
+	c.place(right)
+	n.right.compile(c, t, f)
+}
+
+type notNode struct{ x node }
+
+func (n notNode) compile(c *compiler, t, f int) {
+	n.x.compile(c, f, t)
+}
+
+type protoNode struct{ proto string }
+
+func (n protoNode) compile(c *compiler, t, f int) {
+	c.loadEtherType()
+	switch n.proto {
+	case "ip":
+		c.jump(bpf.JumpEqual, etherTypeIPv4, t, f)
+	case "ip6":
+		c.jump(bpf.JumpEqual, etherTypeIPv6, t, f)
+	case "arp":
+		c.jump(bpf.JumpEqual, etherTypeARP, t, f)
+	case "icmp":
+		c.jump(bpf.JumpEqual, etherTypeIPv4, labelNext, f)
+		c.emit(bpf.LoadAbsolute{Off: 23, Size: 1})
+		c.jump(bpf.JumpEqual, protoICMP, t, f)
+	case "icmp6":
+		c.jump(bpf.JumpEqual, etherTypeIPv6, labelNext, f)
+		c.emit(bpf.LoadAbsolute{Off: 20, Size: 1})
+		c.jump(bpf.JumpEqual, protoICMPv6, t, f)
+	default:
+		proto := portProtocols[n.proto]
+		ipv6 := c.newLabel()
+		c.jump(bpf.JumpEqual, etherTypeIPv4, labelNext, ipv6)
+		c.emit(bpf.LoadAbsolute{Off: 23, Size: 1})
+		c.jump(bpf.JumpEqual, proto, t, f)
+		c.place(ipv6)
+		c.jump(bpf.JumpEqual, etherTypeIPv6, labelNext, f)
+		c.emit(bpf.LoadAbsolute{Off: 20, Size: 1})
+		c.jump(bpf.JumpEqual, proto, t, f)
+	}
+}
+
+type hostNode struct {
+	dir   direction
+	ipNet *net.IPNet
+}
+
+func (n hostNode) compile(c *compiler, t, f int) {
+	// offsets of the source and destination address in the frame
+	etherType, src, dst := uint32(etherTypeIPv4), uint32(26), uint32(30)
+	ip, mask := n.ipNet.IP.To4(), n.ipNet.Mask
+	if ip == nil {
+		etherType, src, dst = etherTypeIPv6, 22, 38
+		ip = n.ipNet.IP.To16()
+	}
+	if len(mask) != len(ip) {
+		mask = mask[len(mask)-len(ip):]
+	}
+
+	c.loadEtherType()
+	c.jump(bpf.JumpEqual, etherType, labelNext, f)
+	if ones, _ := n.ipNet.Mask.Size(); ones == 0 {
+		// the whole address family matches, the comparison must still
+		// jump to the target
+		c.jump(bpf.JumpEqual, etherType, t, f)
+		return
+	}
+
+	switch n.dir {
+	case dirSrc:
+		c.compareAddress(src, ip, mask, t, f)
+	case dirDst:
+		c.compareAddress(dst, ip, mask, t, f)
+	default:
+		other := c.newLabel()
+		c.compareAddress(src, ip, mask, t, other)
+		c.place(other)
+		c.compareAddress(dst, ip, mask, t, f)
+	}
+}
+
+// compareAddress compares the masked address at the given offset word by
+// word.
+func (c *compiler) compareAddress(off uint32, ip net.IP, mask net.IPMask, t, f int) {
+	words := make([]int, 0, len(ip)/4)
+	for i := 0; i < len(ip); i += 4 {
+		if mask[i] != 0 || mask[i+1] != 0 || mask[i+2] != 0 || mask[i+3] != 0 {
+			words = append(words, i)
+		}
+	}
+	for j, i := range words {
+		wordMask := uint32(mask[i])<<24 | uint32(mask[i+1])<<16 | uint32(mask[i+2])<<8 | uint32(mask[i+3])
+		wordIP := uint32(ip[i])<<24 | uint32(ip[i+1])<<16 | uint32(ip[i+2])<<8 | uint32(ip[i+3])
+		c.emit(bpf.LoadAbsolute{Off: off + uint32(i), Size: 4})
+		if wordMask != 0xFFFFFFFF {
+			c.emit(bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: wordMask})
+		}
+		jt := labelNext
+		if j == len(words)-1 {
+			jt = t
+		}
+		c.jump(bpf.JumpEqual, wordIP&wordMask, jt, f)
+	}
+}
+
+type portNode struct {
+	dir    direction
+	lo, hi uint32
+	protos []uint32
+}
+
+func (n portNode) compile(c *compiler, t, f int) {
+	ipv6 := c.newLabel()
+	c.loadEtherType()
+	c.jump(bpf.JumpEqual, etherTypeIPv4, labelNext, ipv6)
+	c.emit(bpf.LoadAbsolute{Off: 23, Size: 1})
+	c.matchProtocols(n.protos, f)
+	// only the first fragment has the transport header
+	c.emit(bpf.LoadAbsolute{Off: 20, Size: 2})
+	c.jump(bpf.JumpBitsSet, 0x1fff, f, labelNext)
+	c.emit(bpf.LoadMemShift{Off: 14})
+	n.comparePorts(c, bpf.LoadIndirect{Off: 14, Size: 2}, bpf.LoadIndirect{Off: 16, Size: 2}, t, f)
+
+	c.place(ipv6)
+	c.jump(bpf.JumpEqual, etherTypeIPv6, labelNext, f)
+	c.emit(bpf.LoadAbsolute{Off: 20, Size: 1})
+	c.matchProtocols(n.protos, f)
+	n.comparePorts(c, bpf.LoadAbsolute{Off: 54, Size: 2}, bpf.LoadAbsolute{Off: 56, Size: 2}, t, f)
+}
+
+// matchProtocols continues with the next instruction if A is one of the
+// protocols and jumps to f otherwise.
+func (c *compiler) matchProtocols(protos []uint32, f int) {
+	match := c.newLabel()
+	for i, proto := range protos {
+		jf := labelNext
+		if i == len(protos)-1 {
+			jf = f
+		}
+		c.jump(bpf.JumpEqual, proto, match, jf)
+	}
+	c.place(match)
+}
+
+func (n portNode) comparePorts(c *compiler, loadSrc, loadDst bpf.Instruction, t, f int) {
+	switch n.dir {
+	case dirSrc:
+		c.emit(loadSrc)
+		n.comparePort(c, t, f)
+	case dirDst:
+		c.emit(loadDst)
+		n.comparePort(c, t, f)
+	default:
+		other := c.newLabel()
+		c.emit(loadSrc)
+		n.comparePort(c, t, other)
+		c.place(other)
+		c.emit(loadDst)
+		n.comparePort(c, t, f)
+	}
+}
+
+func (n portNode) comparePort(c *compiler, t, f int) {
+	if n.lo == n.hi {
+		c.jump(bpf.JumpEqual, n.lo, t, f)
+		return
+	}
+	c.jump(bpf.JumpGreaterOrEqual, n.lo, labelNext, f)
+	c.jump(bpf.JumpGreaterThan, n.hi, f, t)
+}
@@ -0,0 +1,149 @@
+package netcap
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/bpf"
+)
+
+// ethernetFrame builds an Ethernet frame with an IP header and, for TCP and
+// UDP, the ports of the transport header.
+func ethernetFrame(src, dst string, proto uint8, srcPort, dstPort uint16) []byte {
+	srcIP, dstIP := net.ParseIP(src), net.ParseIP(dst)
+	frame := make([]byte, 14)
+	var header []byte
+	if srcIP.To4() != nil {
+		binary.BigEndian.PutUint16(frame[12:], etherTypeIPv4)
+		header = make([]byte, 20)
+		header[0] = 0x45
+		header[9] = proto
+		copy(header[12:], srcIP.To4())
+		copy(header[16:], dstIP.To4())
+	} else {
+		binary.BigEndian.PutUint16(frame[12:], etherTypeIPv6)
+		header = make([]byte, 40)
+		header[0] = 0x60
+		header[6] = proto
+		copy(header[8:], srcIP.To16())
+		copy(header[24:], dstIP.To16())
+	}
+	transport := make([]byte, 8)
+	binary.BigEndian.PutUint16(transport[0:], srcPort)
+	binary.BigEndian.PutUint16(transport[2:], dstPort)
+
+	frame = append(frame, header...)
+	return append(frame, transport...)
+}
+
+func TestCompile(t *testing.T) {
+	tcp4 := ethernetFrame("10.88.0.2", "1.1.1.1", protoTCP, 40000, 443)
+	udp4 := ethernetFrame("10.88.0.2", "10.88.0.1", protoUDP, 40001, 53)
+	icmp4 := ethernetFrame("10.88.0.2", "10.88.0.1", protoICMP, 0, 0)
+	tcp6 := ethernetFrame("fd00::2", "2001:db8::1", protoTCP, 40002, 8080)
+	icmp6 := ethernetFrame("fd00::2", "fd00::1", protoICMPv6, 0, 0)
+	arp := make([]byte, 42)
+	binary.BigEndian.PutUint16(arp[12:], etherTypeARP)
+
+	fragment := ethernetFrame("10.88.0.2", "1.1.1.1", protoTCP, 40000, 443)
+	// set a fragment offset, the transport header is not in the packet
+	binary.BigEndian.PutUint16(fragment[20:], 0x0010)
+
+	packets := map[string][]byte{
+		"tcp4":     tcp4,
+		"udp4":     udp4,
+		"icmp4":    icmp4,
+		"tcp6":     tcp6,
+		"icmp6":    icmp6,
+		"arp":      arp,
+		"fragment": fragment,
+	}
+
+	tests := []struct {
+		filter  string
+		matches []string
+	}{
+		{"", []string{"tcp4", "udp4", "icmp4", "tcp6", "icmp6", "arp", "fragment"}},
+		{"ip", []string{"tcp4", "udp4", "icmp4", "fragment"}},
+		{"ip6", []string{"tcp6", "icmp6"}},
+		{"arp", []string{"arp"}},
+		{"tcp", []string{"tcp4", "tcp6", "fragment"}},
+		{"udp", []string{"udp4"}},
+		{"icmp", []string{"icmp4"}},
+		{"icmp6", []string{"icmp6"}},
+		{"not arp", []string{"tcp4", "udp4", "icmp4", "tcp6", "icmp6", "fragment"}},
+		{"!ip && !ip6", []string{"arp"}},
+		{"host 10.88.0.1", []string{"udp4", "icmp4"}},
+		{"src host 10.88.0.2", []string{"tcp4", "udp4", "icmp4", "fragment"}},
+		{"dst 1.1.1.1", []string{"tcp4", "fragment"}},
+		{"dst host 10.88.0.2", nil},
+		{"net 10.88.0.0/16", []string{"tcp4", "udp4", "icmp4", "fragment"}},
+		{"dst net 1.0.0.0/8", []string{"tcp4", "fragment"}},
+		{"net 0.0.0.0/0", []string{"tcp4", "udp4", "icmp4", "fragment"}},
+		{"host fd00::1", []string{"icmp6"}},
+		{"dst net 2001:db8::/32", []string{"tcp6"}},
+		{"net fd00::/8", []string{"tcp6", "icmp6"}},
+		{"port 443", []string{"tcp4"}},
+		{"tcp port 53", nil},
+		{"udp dst port 53", []string{"udp4"}},
+		{"src port 53", nil},
+		{"port 8080", []string{"tcp6"}},
+		{"portrange 40000-40001", []string{"tcp4", "udp4"}},
+		{"tcp src portrange 40000-40002", []string{"tcp4", "tcp6"}},
+		{"tcp and (port 443 or port 8080)", []string{"tcp4", "tcp6"}},
+		{"udp or icmp6 or arp", []string{"udp4", "icmp6", "arp"}},
+		{"host 10.88.0.2 and not port 53", []string{"tcp4", "icmp4", "fragment"}},
+		{"icmp host 10.88.0.1", []string{"icmp4"}},
+		{"ip6 host fd00::2", []string{"tcp6", "icmp6"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			raw, err := Compile(tt.filter)
+			require.NoError(t, err)
+			insns, ok := bpf.Disassemble(raw)
+			require.True(t, ok)
+			vm, err := bpf.NewVM(insns)
+			require.NoError(t, err)
+
+			var matches []string
+			for name, packet := range packets {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+				if n > 0 {
+					matches = append(matches, name)
+				}
+			}
+			assert.ElementsMatch(t, tt.matches, matches)
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		filter string
+		err    string
+	}{
+		{"foo", `unknown primitive "foo"`},
+		{"host", "missing value for host"},
+		{"host example.com", `invalid IP address "example.com"`},
+		{"host 10.0.0.0/8", "must not have a prefix length"},
+		{"net 10.0.0.0/33", "invalid CIDR address"},
+		{"port 65536", `invalid port "65536"`},
+		{"port 80-90", `invalid port "80-90"`},
+		{"portrange 90-80", `invalid portrange "90-80"`},
+		{"icmp port 80", "port cannot be used with icmp"},
+		{"arp host 10.0.0.1", "host cannot be used with arp"},
+		{"(tcp", "missing closing parenthesis"},
+		{"tcp and", "unexpected end of expression"},
+		{"tcp udp", `unexpected "udp"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			_, err := Compile(tt.filter)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
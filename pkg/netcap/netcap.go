@@ -0,0 +1,19 @@
+// Package netcap captures packets in network namespaces and writes them in
+// the pcapng format.
+package netcap
+
+// DefaultSnaplen is the default maximum number of bytes captured of a packet.
+const DefaultSnaplen = 262144
+
+// Options are the options of a packet capture.
+type Options struct {
+	// Interface to capture packets on, all interfaces if empty.
+	Interface string
+	// Filter only captures packets matching the expression, see Compile.
+	Filter string
+	// Snaplen is the maximum number of bytes captured of a packet,
+	// DefaultSnaplen if 0.
+	Snaplen uint32
+	// Count stops the capture after the number of packets, 0 means no limit.
+	Count uint64
+}
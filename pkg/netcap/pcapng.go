@@ -0,0 +1,139 @@
+package netcap
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// pcapng block types, options and flags, see https://www.ietf.org/archive/id/draft-ietf-opsawg-pcapng-01.html
+const (
+	blockTypeSectionHeader        = 0x0A0D0D0A
+	blockTypeInterface            = 0x00000001
+	blockTypeEnhancedPacket       = 0x00000006
+	byteOrderMagic                = 0x1A2B3C4D
+	optionEndOfOpt                = 0
+	optionSHBUserAppl             = 4
+	optionIfName                  = 2
+	optionIfTsResol               = 9
+	optionEPBFlags                = 2
+	epbFlagInbound                = 1
+	epbFlagOutbound               = 2
+	tsResolNanoseconds      uint8 = 9
+)
+
+// LinkTypeEthernet is the pcapng link type of Ethernet interfaces.
+const LinkTypeEthernet = 1
+
+// Direction is the direction of a captured packet as seen from the
+// interface.
+type Direction int
+
+const (
+	// DirectionUnknown is used when the direction of a packet is unknown.
+	DirectionUnknown Direction = iota
+	// DirectionInbound is used for packets received by the interface.
+	DirectionInbound
+	// DirectionOutbound is used for packets sent by the interface.
+	DirectionOutbound
+)
+
+// Writer writes packets in the pcapng format.
+type Writer struct {
+	w          io.Writer
+	interfaces uint32
+}
+
+// NewWriter writes the section header of a pcapng file to w and returns a
+// Writer to add interfaces and packets to it.
+func NewWriter(w io.Writer, application string) (*Writer, error) {
+	opts := appendOption(nil, optionSHBUserAppl, []byte(application))
+	opts = appendOption(opts, optionEndOfOpt, nil)
+
+	body := make([]byte, 16, 16+len(opts))
+	binary.LittleEndian.PutUint32(body[0:], byteOrderMagic)
+	binary.LittleEndian.PutUint16(body[4:], 1)
+	binary.LittleEndian.PutUint16(body[6:], 0)
+	// the section length is not known in advance
+	binary.LittleEndian.PutUint64(body[8:], 0xFFFFFFFFFFFFFFFF)
+	body = append(body, opts...)
+
+	if err := writeBlock(w, blockTypeSectionHeader, body); err != nil {
+		return nil, err
+	}
+	return &Writer{w: w}, nil
+}
+
+// AddInterface writes an interface description and returns the ID which must
+// be used for packets captured on the interface.
+func (w *Writer) AddInterface(name string, linkType uint16, snaplen uint32) (uint32, error) {
+	opts := appendOption(nil, optionIfName, []byte(name))
+	opts = appendOption(opts, optionIfTsResol, []byte{tsResolNanoseconds})
+	opts = appendOption(opts, optionEndOfOpt, nil)
+
+	body := make([]byte, 8, 8+len(opts))
+	binary.LittleEndian.PutUint16(body[0:], linkType)
+	binary.LittleEndian.PutUint32(body[4:], snaplen)
+	body = append(body, opts...)
+
+	if err := writeBlock(w.w, blockTypeInterface, body); err != nil {
+		return 0, err
+	}
+	id := w.interfaces
+	w.interfaces++
+	return id, nil
+}
+
+// WritePacket writes a packet captured on the given interface. data may be
+// shorter than the original length of the packet if it was truncated.
+func (w *Writer) WritePacket(interfaceID uint32, ts time.Time, data []byte, length uint32, direction Direction) error {
+	var opts []byte
+	switch direction {
+	case DirectionInbound:
+		opts = appendOption(opts, optionEPBFlags, binary.LittleEndian.AppendUint32(nil, epbFlagInbound))
+	case DirectionOutbound:
+		opts = appendOption(opts, optionEPBFlags, binary.LittleEndian.AppendUint32(nil, epbFlagOutbound))
+	}
+	if opts != nil {
+		opts = appendOption(opts, optionEndOfOpt, nil)
+	}
+
+	nanos := uint64(ts.UnixNano())
+	body := make([]byte, 20, 20+pad(len(data))+len(opts))
+	binary.LittleEndian.PutUint32(body[0:], interfaceID)
+	binary.LittleEndian.PutUint32(body[4:], uint32(nanos>>32))
+	binary.LittleEndian.PutUint32(body[8:], uint32(nanos))
+	binary.LittleEndian.PutUint32(body[12:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(body[16:], length)
+	body = append(body, data...)
+	body = append(body, make([]byte, pad(len(data))-len(data))...)
+	body = append(body, opts...)
+
+	return writeBlock(w.w, blockTypeEnhancedPacket, body)
+}
+
+// writeBlock writes a block with the given type and body, which must be
+// padded to 32 bits already.
+func writeBlock(w io.Writer, blockType uint32, body []byte) error {
+	length := uint32(12 + len(body))
+	block := make([]byte, 0, length)
+	block = binary.LittleEndian.AppendUint32(block, blockType)
+	block = binary.LittleEndian.AppendUint32(block, length)
+	block = append(block, body...)
+	block = binary.LittleEndian.AppendUint32(block, length)
+	_, err := w.Write(block)
+	return err
+}
+
+// appendOption appends an option with its value padded to 32 bits.
+func appendOption(opts []byte, code uint16, value []byte) []byte {
+	opts = binary.LittleEndian.AppendUint16(opts, code)
+	opts = binary.LittleEndian.AppendUint16(opts, uint16(len(value)))
+	opts = append(opts, value...)
+	return append(opts, make([]byte, pad(len(value))-len(value))...)
+}
+
+// pad returns n rounded up to a multiple of 4.
+func pad(n int) int {
+	return (n + 3) &^ 3
+}
@@ -0,0 +1,86 @@
+package netcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type block struct {
+	blockType uint32
+	body      []byte
+}
+
+// readBlocks splits a pcapng stream into blocks and checks their lengths.
+func readBlocks(t *testing.T, data []byte) []block {
+	var blocks []block
+	for len(data) > 0 {
+		require.GreaterOrEqual(t, len(data), 12)
+		blockType := binary.LittleEndian.Uint32(data[0:])
+		length := binary.LittleEndian.Uint32(data[4:])
+		require.Zero(t, length%4, "block length must be padded")
+		require.GreaterOrEqual(t, uint32(len(data)), length)
+		assert.Equal(t, length, binary.LittleEndian.Uint32(data[length-4:]), "trailing block length")
+		blocks = append(blocks, block{blockType: blockType, body: data[8 : length-4]})
+		data = data[length:]
+	}
+	return blocks
+}
+
+func TestWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "podman")
+	require.NoError(t, err)
+
+	eth0, err := w.AddInterface("eth0", LinkTypeEthernet, DefaultSnaplen)
+	require.NoError(t, err)
+	lo, err := w.AddInterface("lo", LinkTypeEthernet, 64)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), eth0)
+	assert.Equal(t, uint32(1), lo)
+
+	ts := time.Unix(1700000000, 123456789)
+	packet := []byte{1, 2, 3, 4, 5}
+	require.NoError(t, w.WritePacket(lo, ts, packet, 1500, DirectionOutbound))
+	require.NoError(t, w.WritePacket(eth0, ts, packet, 5, DirectionUnknown))
+
+	blocks := readBlocks(t, buf.Bytes())
+	require.Len(t, blocks, 5)
+
+	shb := blocks[0]
+	assert.Equal(t, uint32(blockTypeSectionHeader), shb.blockType)
+	assert.Equal(t, uint32(byteOrderMagic), binary.LittleEndian.Uint32(shb.body[0:]))
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(shb.body[4:]))
+	// shb_userappl option
+	assert.Equal(t, uint16(optionSHBUserAppl), binary.LittleEndian.Uint16(shb.body[16:]))
+	assert.Equal(t, "podman", string(shb.body[20:26]))
+
+	idb := blocks[2]
+	assert.Equal(t, uint32(blockTypeInterface), idb.blockType)
+	assert.Equal(t, uint16(LinkTypeEthernet), binary.LittleEndian.Uint16(idb.body[0:]))
+	assert.Equal(t, uint32(64), binary.LittleEndian.Uint32(idb.body[4:]))
+	assert.Equal(t, uint16(optionIfName), binary.LittleEndian.Uint16(idb.body[8:]))
+	assert.Equal(t, uint16(2), binary.LittleEndian.Uint16(idb.body[10:]))
+	assert.Equal(t, "lo", string(idb.body[12:14]))
+	assert.Equal(t, uint16(optionIfTsResol), binary.LittleEndian.Uint16(idb.body[16:]))
+	assert.Equal(t, tsResolNanoseconds, idb.body[20])
+
+	epb := blocks[3]
+	assert.Equal(t, uint32(blockTypeEnhancedPacket), epb.blockType)
+	assert.Equal(t, lo, binary.LittleEndian.Uint32(epb.body[0:]))
+	nanos := uint64(binary.LittleEndian.Uint32(epb.body[4:]))<<32 | uint64(binary.LittleEndian.Uint32(epb.body[8:]))
+	assert.Equal(t, uint64(ts.UnixNano()), nanos)
+	assert.Equal(t, uint32(5), binary.LittleEndian.Uint32(epb.body[12:]))
+	assert.Equal(t, uint32(1500), binary.LittleEndian.Uint32(epb.body[16:]))
+	assert.Equal(t, packet, epb.body[20:25])
+	// epb_flags option after the padded packet data
+	assert.Equal(t, uint16(optionEPBFlags), binary.LittleEndian.Uint16(epb.body[28:]))
+	assert.Equal(t, uint32(epbFlagOutbound), binary.LittleEndian.Uint32(epb.body[32:]))
+
+	// packets without direction have no options
+	assert.Len(t, blocks[4].body, 28)
+}
@@ -148,6 +148,7 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		networks                                []string
 		healthStatus                            string
 		restartCount                            uint
+		restartingAt                            time.Time
 	)
 
 	batchErr := ctr.Batch(func(c *libpod.Container) error {
@@ -201,6 +202,11 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 			return err
 		}
 
+		restartingAt, err = c.RestartingAt()
+		if err != nil {
+			return err
+		}
+
 		if !opts.Size && !opts.Namespace {
 			return nil
 		}
@@ -262,6 +268,9 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		State:      conState.String(),
 		Status:     healthStatus,
 	}
+	if !restartingAt.IsZero() {
+		ps.RestartingAt = restartingAt.Unix()
+	}
 	if opts.Pod && len(conConfig.Pod) > 0 {
 		podName, err := rt.GetPodName(conConfig.Pod)
 		if err != nil {
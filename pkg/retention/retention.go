@@ -0,0 +1,205 @@
+// Package retention implements declarative image retention policies read from
+// the [image_retention] table of containers.conf.
+package retention
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/storage/pkg/homedir"
+)
+
+// Policy describes the rules of the [image_retention] table in
+// containers.conf.  An image selected by any rule is removed unless it is
+// used by a container or protected by KeepLabels.
+type Policy struct {
+	// KeepTags keeps the newest N images of every repository.  Older
+	// tagged images of the repository are removed.  0 disables the rule.
+	KeepTags int `toml:"keep_tags,omitempty"`
+	// UnusedDays removes images which have not been used to create a
+	// container for the specified number of days.  Images which have never
+	// been used are aged by their creation date.  0 disables the rule.
+	UnusedDays int `toml:"unused_days,omitempty"`
+	// KeepLabels protects images with any of the specified labels, given
+	// in the `key` or `key=value` format.
+	KeepLabels []string `toml:"keep_labels,omitempty"`
+}
+
+// Image describes a local image to apply a Policy to.
+type Image struct {
+	ID string
+	// RepoTags are the `repository:tag` names of the image.
+	RepoTags []string
+	Labels   map[string]string
+	Created  time.Time
+	// LastUsed is the time a container was last created from the image.
+	// The zero value means it has never been used.
+	LastUsed time.Time
+	// InUse is true if a container is using the image.
+	InUse bool
+}
+
+// IsEmpty returns true if the policy does not contain any rule removing
+// images.
+func (p *Policy) IsEmpty() bool {
+	return p.KeepTags <= 0 && p.UnusedDays <= 0
+}
+
+// Load reads the image retention policy from the containers.conf files conf
+// was loaded from, in the same order as c/common merges them.  Settings in
+// later files override the ones in earlier files.
+func Load(conf *config.Config) (*Policy, error) {
+	paths, err := configPaths(conf)
+	if err != nil {
+		return nil, err
+	}
+	retention := struct {
+		ImageRetention Policy `toml:"image_retention"`
+	}{}
+	for _, path := range paths {
+		if _, err := toml.DecodeFile(path, &retention); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("reading image retention policy from %q: %w", path, err)
+		}
+	}
+	if err := retention.ImageRetention.validate(); err != nil {
+		return nil, err
+	}
+	return &retention.ImageRetention, nil
+}
+
+func (p *Policy) validate() error {
+	if p.KeepTags < 0 {
+		return fmt.Errorf("invalid image retention policy: keep_tags must not be negative: %d", p.KeepTags)
+	}
+	if p.UnusedDays < 0 {
+		return fmt.Errorf("invalid image retention policy: unused_days must not be negative: %d", p.UnusedDays)
+	}
+	for _, label := range p.KeepLabels {
+		if label == "" || strings.HasPrefix(label, "=") {
+			return fmt.Errorf("invalid image retention policy: invalid label %q in keep_labels", label)
+		}
+	}
+	return nil
+}
+
+// configPaths returns the containers.conf files to read the policy from.
+// c/common does not expose the files it merged, so the system and user files
+// are looked up as described in containers.conf(5), followed by the modules
+// loaded into conf and the override file.
+func configPaths(conf *config.Config) ([]string, error) {
+	var paths []string
+	if path := os.Getenv("CONTAINERS_CONF"); path != "" {
+		paths = append(paths, path)
+	} else {
+		paths = append(paths, config.DefaultContainersConfig, config.OverrideContainersConfig)
+		paths = append(paths, dropInPaths(config.OverrideContainersConfig+".d")...)
+
+		configHome, err := homedir.GetConfigHome()
+		if err != nil {
+			return nil, err
+		}
+		userConfig := filepath.Join(configHome, "containers", "containers.conf")
+		paths = append(paths, userConfig)
+		paths = append(paths, dropInPaths(userConfig+".d")...)
+	}
+	paths = append(paths, conf.LoadedModules()...)
+	return append(paths, overridePath()...), nil
+}
+
+func overridePath() []string {
+	if path := os.Getenv("CONTAINERS_CONF_OVERRIDE"); path != "" {
+		return []string{path}
+	}
+	return nil
+}
+
+// dropInPaths returns the sorted *.conf files in dir.
+func dropInPaths(dir string) []string {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.conf"))
+	if err != nil {
+		return nil
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Expired returns the IDs of the images to remove according to the policy.
+func (p *Policy) Expired(images []Image, now time.Time) []string {
+	// Determine the newest images of every repository.
+	kept := make(map[string]bool)
+	if p.KeepTags > 0 {
+		repos := make(map[string][]*Image)
+		for i := range images {
+			img := &images[i]
+			seen := make(map[string]bool)
+			for _, repoTag := range img.RepoTags {
+				repo := repository(repoTag)
+				if seen[repo] {
+					continue
+				}
+				seen[repo] = true
+				repos[repo] = append(repos[repo], img)
+			}
+		}
+		for _, imgs := range repos {
+			sort.SliceStable(imgs, func(i, j int) bool {
+				return imgs[i].Created.After(imgs[j].Created)
+			})
+			for i := 0; i < len(imgs) && i < p.KeepTags; i++ {
+				kept[imgs[i].ID] = true
+			}
+		}
+	}
+
+	var expired []string
+	for _, img := range images {
+		if img.InUse || p.protected(img) {
+			continue
+		}
+		if p.KeepTags > 0 && len(img.RepoTags) > 0 && !kept[img.ID] {
+			expired = append(expired, img.ID)
+			continue
+		}
+		if p.UnusedDays > 0 {
+			lastUsed := img.Created
+			if img.LastUsed.After(lastUsed) {
+				lastUsed = img.LastUsed
+			}
+			if now.Sub(lastUsed) > time.Duration(p.UnusedDays)*24*time.Hour {
+				expired = append(expired, img.ID)
+			}
+		}
+	}
+	return expired
+}
+
+// protected returns true if the image matches any of the KeepLabels.
+func (p *Policy) protected(img Image) bool {
+	for _, label := range p.KeepLabels {
+		key, value, hasValue := strings.Cut(label, "=")
+		v, ok := img.Labels[key]
+		if ok && (!hasValue || v == value) {
+			return true
+		}
+	}
+	return false
+}
+
+// repository strips the tag from a `repository:tag` name.
+func repository(repoTag string) string {
+	if i := strings.LastIndex(repoTag, ":"); i > strings.LastIndex(repoTag, "/") {
+		return repoTag[:i]
+	}
+	return repoTag
+}
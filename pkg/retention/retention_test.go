@@ -0,0 +1,101 @@
+package retention
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpired(t *testing.T) {
+	now := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	images := []Image{
+		{ID: "old", RepoTags: []string{"quay.io/foo/app:1"}, Created: now.Add(-30 * day)},
+		{ID: "mid", RepoTags: []string{"quay.io/foo/app:2"}, Created: now.Add(-20 * day)},
+		{ID: "new", RepoTags: []string{"quay.io/foo/app:3"}, Created: now.Add(-10 * day)},
+		{ID: "used", RepoTags: []string{"localhost:5000/bar:latest"}, Created: now.Add(-60 * day), LastUsed: now.Add(-1 * day)},
+		{ID: "stale", RepoTags: []string{"localhost:5000/baz:latest"}, Created: now.Add(-60 * day)},
+		{ID: "label", RepoTags: []string{"localhost:5000/keep:latest"}, Created: now.Add(-60 * day), Labels: map[string]string{"keep": "true"}},
+		{ID: "running", RepoTags: []string{"localhost:5000/run:latest"}, Created: now.Add(-60 * day), InUse: true},
+		{ID: "dangling", Created: now.Add(-2 * day)},
+	}
+
+	tests := []struct {
+		name   string
+		policy Policy
+		want   []string
+	}{
+		{
+			name:   "empty policy",
+			policy: Policy{},
+			want:   nil,
+		},
+		{
+			name:   "keep newest tags",
+			policy: Policy{KeepTags: 2},
+			want:   []string{"old"},
+		},
+		{
+			name:   "unused days",
+			policy: Policy{UnusedDays: 15},
+			want:   []string{"old", "mid", "stale", "label"},
+		},
+		{
+			name:   "keep labels",
+			policy: Policy{UnusedDays: 15, KeepLabels: []string{"keep=true"}},
+			want:   []string{"old", "mid", "stale"},
+		},
+		{
+			name:   "label value mismatch",
+			policy: Policy{UnusedDays: 15, KeepLabels: []string{"keep=false"}},
+			want:   []string{"old", "mid", "stale", "label"},
+		},
+		{
+			name:   "combined rules",
+			policy: Policy{KeepTags: 1, UnusedDays: 1, KeepLabels: []string{"keep"}},
+			want:   []string{"old", "mid", "new", "stale", "dangling"},
+		},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.policy.Expired(images, now))
+		})
+	}
+}
+
+func TestRepository(t *testing.T) {
+	assert.Equal(t, "quay.io/foo/app", repository("quay.io/foo/app:1"))
+	assert.Equal(t, "localhost:5000/bar", repository("localhost:5000/bar:latest"))
+	assert.Equal(t, "localhost:5000/bar", repository("localhost:5000/bar"))
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	conf := filepath.Join(dir, "containers.conf")
+	err := os.WriteFile(conf, []byte("[engine]\nevents_logger=\"file\"\n\n[image_retention]\nkeep_tags = 3\nunused_days = 30\nkeep_labels = [\"keep\"]\n"), 0o644)
+	assert.NoError(t, err)
+	override := filepath.Join(dir, "override.conf")
+	err = os.WriteFile(override, []byte("[image_retention]\nunused_days = 7\n"), 0o644)
+	assert.NoError(t, err)
+
+	t.Setenv("CONTAINERS_CONF", conf)
+	t.Setenv("CONTAINERS_CONF_OVERRIDE", override)
+	module := filepath.Join(dir, "module.conf")
+	err = os.WriteFile(module, []byte("[image_retention]\nkeep_tags = 5\nunused_days = 14\n"), 0o644)
+	assert.NoError(t, err)
+	cfg, err := config.New(&config.Options{Modules: []string{module}})
+	assert.NoError(t, err)
+	policy, err := Load(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, &Policy{KeepTags: 5, UnusedDays: 7, KeepLabels: []string{"keep"}}, policy)
+
+	err = os.WriteFile(override, []byte("[image_retention]\nkeep_tags = -1\n"), 0o644)
+	assert.NoError(t, err)
+	_, err = Load(cfg)
+	assert.Error(t, err)
+}
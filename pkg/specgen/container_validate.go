@@ -3,6 +3,7 @@ package specgen
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/containers/podman/v5/libpod/define"
@@ -46,6 +47,10 @@ func (s *SpecGenerator) Validate() error {
 	//
 	// ContainerBasicConfig
 	//
+	// Only containers of a pod can restart it
+	if s.PodCritical != nil && *s.PodCritical && len(s.Pod) == 0 {
+		return fmt.Errorf("pod critical containers must be part of a pod: %w", ErrInvalidSpecConfig)
+	}
 	// Rootfs and Image cannot both populated
 	if len(s.ContainerStorageConfig.Image) > 0 && len(s.ContainerStorageConfig.Rootfs) > 0 {
 		return fmt.Errorf("both image and rootfs cannot be simultaneously: %w", ErrInvalidSpecConfig)
@@ -83,6 +88,15 @@ func (s *SpecGenerator) Validate() error {
 	if s.ContainerStorageConfig.ShmSize != nil && (s.ContainerStorageConfig.IpcNS.IsHost() || s.ContainerStorageConfig.IpcNS.IsNone()) {
 		return fmt.Errorf("cannot set shmsize when running in the %s IPC Namespace", s.ContainerStorageConfig.IpcNS)
 	}
+	// rootfs volumes need a source container and an absolute destination
+	for _, v := range s.ContainerStorageConfig.RootfsVolumes {
+		if v.Source == "" {
+			return fmt.Errorf("rootfs volume %q has no source container: %w", v.Destination, ErrInvalidSpecConfig)
+		}
+		if !filepath.IsAbs(v.Destination) {
+			return fmt.Errorf("rootfs volume destination %q must be an absolute path: %w", v.Destination, ErrInvalidSpecConfig)
+		}
+	}
 
 	//
 	// ContainerSecurityConfig
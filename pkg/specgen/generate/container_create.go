@@ -21,6 +21,7 @@ import (
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/containers/podman/v5/pkg/specgenutil"
 	"github.com/containers/podman/v5/pkg/util"
+	"github.com/coreos/go-systemd/v22/daemon"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opencontainers/selinux/go-selinux/label"
 	"github.com/sirupsen/logrus"
@@ -463,6 +464,9 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 	for _, imageVolume := range s.ImageVolumes {
 		destinations = append(destinations, imageVolume.Destination)
 	}
+	for _, rootfsVolume := range s.RootfsVolumes {
+		destinations = append(destinations, rootfsVolume.Destination)
+	}
 
 	if len(destinations) > 0 || !infraVolumes {
 		options = append(options, libpod.WithUserVolumes(destinations))
@@ -506,6 +510,14 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		options = append(options, libpod.WithImageVolumes(vols))
 	}
 
+	for _, v := range s.RootfsVolumes {
+		srcCtr, err := rt.LookupContainer(v.Source)
+		if err != nil {
+			return nil, fmt.Errorf("looking up container for rootfs volume %q: %w", v.Destination, err)
+		}
+		options = append(options, libpod.WithRootfsVolume(srcCtr, v.Destination))
+	}
+
 	if s.Command != nil {
 		options = append(options, libpod.WithCommand(s.Command))
 	}
@@ -620,6 +632,12 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		restartPolicy = s.RestartPolicy
 	}
 	options = append(options, libpod.WithRestartRetries(retries), libpod.WithRestartPolicy(restartPolicy))
+	if s.PodCritical != nil && *s.PodCritical {
+		options = append(options, libpod.WithPodCritical())
+	}
+	if s.RestartBackoff != nil {
+		options = append(options, libpod.WithRestartBackoff(*s.RestartBackoff))
+	}
 
 	healthCheckSet := false
 	if s.ContainerHealthCheckConfig.HealthConfig != nil {
@@ -636,9 +654,21 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		options = append(options, libpod.WithHealthCheckOnFailureAction(s.ContainerHealthCheckConfig.HealthCheckOnFailureAction))
 	}
 
-	if s.SdNotifyMode == define.SdNotifyModeHealthy && !healthCheckSet {
+	if s.ContainerHealthCheckConfig.HealthCheckCheckpointRetention != 0 {
+		options = append(options, libpod.WithHealthCheckCheckpointRetention(s.ContainerHealthCheckConfig.HealthCheckCheckpointRetention))
+	}
+
+	if (s.SdNotifyMode == define.SdNotifyModeHealthy || s.SdNotifyMode == define.SdNotifyModeHealthyThenWatchdog) && !healthCheckSet {
 		return nil, fmt.Errorf("%w: sdnotify policy %q requires a healthcheck to be set", define.ErrInvalidArg, s.SdNotifyMode)
 	}
+	if s.SdNotifyMode == define.SdNotifyModeHealthyThenWatchdog && s.ContainerHealthCheckConfig.HealthConfig != nil {
+		// The watchdog is only pinged by healthchecks, so they must
+		// run more often than the watchdog expects pings.
+		interval := s.ContainerHealthCheckConfig.HealthConfig.Interval
+		if watchdog, err := daemon.SdWatchdogEnabled(false); err == nil && watchdog > 0 && (interval <= 0 || interval >= watchdog) {
+			logrus.Warnf("Healthchecks do not run more often than the systemd watchdog timeout %s, the service will be restarted by the watchdog", watchdog)
+		}
+	}
 
 	if len(s.Secrets) != 0 {
 		manager, err := rt.SecretsManager()
@@ -668,14 +698,27 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 
 	if len(s.DependencyContainers) > 0 {
 		deps := make([]*libpod.Container, 0, len(s.DependencyContainers))
+		var conditions map[string]string
 		for _, ctr := range s.DependencyContainers {
 			depCtr, err := rt.LookupContainer(ctr)
 			if err != nil {
 				return nil, fmt.Errorf("%q is not a valid container, cannot be used as a dependency: %w", ctr, err)
 			}
 			deps = append(deps, depCtr)
+			if condition, ok := s.DependencyConditions[ctr]; ok {
+				if condition == define.DependencyConditionHealthy && !depCtr.HasHealthCheck() {
+					return nil, fmt.Errorf("dependency %q has no healthcheck, cannot wait for it to be healthy", ctr)
+				}
+				if conditions == nil {
+					conditions = make(map[string]string)
+				}
+				conditions[depCtr.ID()] = condition
+			}
 		}
 		options = append(options, libpod.WithDependencyCtrs(deps))
+		if len(conditions) > 0 || s.DependencyTimeout > 0 {
+			options = append(options, libpod.WithDependencyConditions(conditions, s.DependencyTimeout))
+		}
 	}
 	if s.PidFile != "" {
 		options = append(options, libpod.WithPidFile(s.PidFile))
@@ -364,6 +364,10 @@ func namespaceOptions(s *specgen.SpecGenerator, rt *libpod.Runtime, pod *libpod.
 			s.Networks[rtConfig.Network.DefaultNetwork] = opts
 			delete(s.Networks, "default")
 		}
+		if opts, ok := s.NetworkOptions["default"]; ok {
+			s.NetworkOptions[rtConfig.Network.DefaultNetwork] = opts
+			delete(s.NetworkOptions, "default")
+		}
 		toReturn = append(toReturn, libpod.WithNetNS(portMappings, expose, postConfigureNetNS, "bridge", s.Networks))
 	}
 
@@ -390,6 +394,12 @@ func namespaceOptions(s *specgen.SpecGenerator, rt *libpod.Runtime, pod *libpod.
 	if s.NetworkOptions != nil {
 		toReturn = append(toReturn, libpod.WithNetworkOptions(s.NetworkOptions))
 	}
+	if len(s.NetworkRates) > 0 {
+		toReturn = append(toReturn, libpod.WithNetworkRates(s.NetworkRates))
+	}
+	if s.HostsGroup != "" {
+		toReturn = append(toReturn, libpod.WithHostsGroup(s.HostsGroup))
+	}
 
 	return toReturn, nil
 }
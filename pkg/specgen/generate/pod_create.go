@@ -162,6 +162,13 @@ func createPodOptions(p *specgen.PodSpecGenerator) ([]libpod.PodCreateOption, er
 	if p.RestartRetries != nil {
 		options = append(options, libpod.WithPodRestartRetries(*p.RestartRetries))
 	}
+	if p.RestartPodPolicy != "" {
+		var retries uint
+		if p.RestartPodRetries != nil {
+			retries = *p.RestartPodRetries
+		}
+		options = append(options, libpod.WithRestartPod(p.RestartPodPolicy, retries))
+	}
 
 	return options, nil
 }
@@ -256,6 +263,12 @@ func MapSpec(p *specgen.PodSpecGenerator) (*specgen.SpecGenerator, error) {
 	if len(p.Networks) > 0 {
 		spec.Networks = p.Networks
 	}
+	if len(p.NetworkRates) > 0 {
+		spec.NetworkRates = p.NetworkRates
+	}
+	if p.HostsGroup != "" {
+		spec.HostsGroup = p.HostsGroup
+	}
 	// deprecated cni networks for api users
 	if len(p.CNINetworks) > 0 {
 		spec.CNINetworks = p.CNINetworks
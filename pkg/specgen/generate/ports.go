@@ -131,6 +131,97 @@ outer:
 	return port, fmt.Errorf("failed to find an open port to expose container port %d %son the host", port.ContainerPort, rangePort)
 }
 
+// AddPortMappings merges the new port mappings into the existing port mappings
+// of a container. Conflicting host ports are rejected and new mappings without
+// a host port get a random one.
+func AddPortMappings(existing, ports []types.PortMapping) ([]types.PortMapping, error) {
+	all := make([]types.PortMapping, 0, len(existing)+len(ports))
+	all = append(all, existing...)
+	all = append(all, ports...)
+	merged, err := ParsePortMapping(all, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// ParsePortMapping only catches conflicts within port ranges, make
+	// sure that no host port is used twice.
+	for i, a := range merged {
+		for _, b := range merged[i+1:] {
+			if a.Protocol != b.Protocol || (a.HostIP != b.HostIP && a.HostIP != "" && b.HostIP != "") {
+				continue
+			}
+			if uint32(a.HostPort) < uint32(b.HostPort)+uint32(mappingRange(b)) &&
+				uint32(b.HostPort) < uint32(a.HostPort)+uint32(mappingRange(a)) {
+				hostPort := a.HostPort
+				if b.HostPort > hostPort {
+					hostPort = b.HostPort
+				}
+				return nil, fmt.Errorf("conflicting port mappings for host port %d (protocol %s)", hostPort, a.Protocol)
+			}
+		}
+	}
+	return merged, nil
+}
+
+// RemovePortMappings removes the given ports from the existing port mappings
+// of a container. A port to remove matches all mappings of its container port
+// and protocol, unless the host port or host IP are given as well.
+// Port ranges are split if only part of them is removed.
+func RemovePortMappings(existing, ports []types.PortMapping) ([]types.PortMapping, error) {
+	// Split all mappings into single ports with a single protocol.
+	var remaining []types.PortMapping
+	for _, port := range existing {
+		protocols, err := checkProtocol(port.Protocol, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, protocol := range protocols {
+			for i := uint16(0); i < mappingRange(port); i++ {
+				remaining = append(remaining, types.PortMapping{
+					HostIP:        port.HostIP,
+					HostPort:      port.HostPort + i,
+					ContainerPort: port.ContainerPort + i,
+					Protocol:      protocol,
+					Range:         1,
+				})
+			}
+		}
+	}
+
+	for _, port := range ports {
+		protocols, err := checkProtocol(port.Protocol, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, protocol := range protocols {
+			for i := uint16(0); i < mappingRange(port); i++ {
+				found := false
+				remaining = slices.DeleteFunc(remaining, func(p types.PortMapping) bool {
+					match := p.Protocol == protocol && p.ContainerPort == port.ContainerPort+i &&
+						(port.HostPort == 0 || p.HostPort == port.HostPort+i) &&
+						(port.HostIP == "" || p.HostIP == port.HostIP)
+					found = found || match
+					return match
+				})
+				if !found {
+					return nil, fmt.Errorf("container port %d/%s is not published", port.ContainerPort+i, protocol)
+				}
+			}
+		}
+	}
+
+	// Join the remaining ports into ranges again.
+	return ParsePortMapping(remaining, nil)
+}
+
+// mappingRange returns the number of ports of the mapping.
+func mappingRange(port types.PortMapping) uint16 {
+	if port.Range == 0 {
+		return 1
+	}
+	return port.Range
+}
+
 // Parse port maps to port mappings.
 // Returns a set of port mappings, and maps of utilized container and
 // host ports.
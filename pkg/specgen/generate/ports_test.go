@@ -989,3 +989,76 @@ func TestParsePortMappingError(t *testing.T) {
 		})
 	}
 }
+
+func TestAddPortMappings(t *testing.T) {
+	existing := []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 80, Protocol: "tcp", Range: 1},
+	}
+
+	ports, err := AddPortMappings(existing, []types.PortMapping{
+		{HostPort: 8081, ContainerPort: 81, Protocol: "tcp"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 80, Protocol: "tcp", Range: 2},
+	}, ports)
+
+	_, err = AddPortMappings(existing, []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 90, Protocol: "tcp"},
+	})
+	assert.ErrorContains(t, err, "conflicting port mappings for host port 8080")
+}
+
+func TestRemovePortMappings(t *testing.T) {
+	existing := []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 80, Protocol: "tcp", Range: 3},
+		{HostPort: 5353, ContainerPort: 53, Protocol: "tcp,udp", Range: 1},
+	}
+
+	tests := []struct {
+		name   string
+		remove []types.PortMapping
+		want   []types.PortMapping
+		err    string
+	}{
+		{
+			name:   "split range",
+			remove: []types.PortMapping{{ContainerPort: 81, Protocol: "tcp"}},
+			want: []types.PortMapping{
+				{HostPort: 5353, ContainerPort: 53, Protocol: "tcp", Range: 1},
+				{HostPort: 8080, ContainerPort: 80, Protocol: "tcp", Range: 1},
+				{HostPort: 8082, ContainerPort: 82, Protocol: "tcp", Range: 1},
+				{HostPort: 5353, ContainerPort: 53, Protocol: "udp", Range: 1},
+			},
+		},
+		{
+			name:   "single protocol",
+			remove: []types.PortMapping{{HostPort: 5353, ContainerPort: 53, Protocol: "udp"}, {ContainerPort: 80, Range: 3}},
+			want: []types.PortMapping{
+				{HostPort: 5353, ContainerPort: 53, Protocol: "tcp", Range: 1},
+			},
+		},
+		{
+			name:   "wrong host port",
+			remove: []types.PortMapping{{HostPort: 9090, ContainerPort: 80}},
+			err:    "container port 80/tcp is not published",
+		},
+		{
+			name:   "not published",
+			remove: []types.PortMapping{{ContainerPort: 53, Protocol: "sctp"}},
+			err:    "container port 53/sctp is not published",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RemovePortMappings(existing, tt.remove)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
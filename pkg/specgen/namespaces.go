@@ -355,11 +355,15 @@ func ParseNetworkFlag(networks []string) (Namespace, map[string]types.PerNetwork
 		_, options, hasOptions := strings.Cut(ns, ":")
 		netOpts := types.PerNetworkOptions{}
 		if hasOptions {
-			var err error
-			netOpts, err = parseBridgeNetworkOptions(options)
+			var (
+				extraOpts []string
+				err       error
+			)
+			netOpts, extraOpts, err = parseBridgeNetworkOptions(options)
 			if err != nil {
 				return toReturn, nil, nil, err
 			}
+			networkOptions = addNetworkOptions(networkOptions, "default", extraOpts)
 		}
 		// we have to set the special default network name here
 		podmanNetworks["default"] = netOpts
@@ -390,11 +394,12 @@ func ParseNetworkFlag(networks []string) (Namespace, map[string]types.PerNetwork
 			if name == "" {
 				return toReturn, nil, nil, errors.New("network name cannot be empty")
 			}
-			netOpts, err := parseBridgeNetworkOptions(options)
+			netOpts, extraOpts, err := parseBridgeNetworkOptions(options)
 			if err != nil {
 				return toReturn, nil, nil, fmt.Errorf("invalid option for network %s: %w", name, err)
 			}
 			podmanNetworks[name] = netOpts
+			networkOptions = addNetworkOptions(networkOptions, name, extraOpts)
 		} else {
 			// Assume we have been given a comma separated list of networks for backwards compat.
 			networkList := strings.Split(ns, ",")
@@ -423,11 +428,15 @@ func ParseNetworkFlag(networks []string) (Namespace, map[string]types.PerNetwork
 			}
 			netOpts := types.PerNetworkOptions{}
 			if hasOptions {
-				var err error
-				netOpts, err = parseBridgeNetworkOptions(options)
+				var (
+					extraOpts []string
+					err       error
+				)
+				netOpts, extraOpts, err = parseBridgeNetworkOptions(options)
 				if err != nil {
 					return toReturn, nil, nil, fmt.Errorf("invalid option for network %s: %w", name, err)
 				}
+				networkOptions = addNetworkOptions(networkOptions, name, extraOpts)
 			}
 			podmanNetworks[name] = netOpts
 		}
@@ -436,10 +445,27 @@ func ParseNetworkFlag(networks []string) (Namespace, map[string]types.PerNetwork
 	return toReturn, podmanNetworks, networkOptions, nil
 }
 
-func parseBridgeNetworkOptions(opts string) (types.PerNetworkOptions, error) {
+// addNetworkOptions adds the options of the given network to the network
+// options map, which is created if needed.
+func addNetworkOptions(networkOptions map[string][]string, network string, opts []string) map[string][]string {
+	if len(opts) == 0 {
+		return networkOptions
+	}
+	if networkOptions == nil {
+		networkOptions = make(map[string][]string)
+	}
+	networkOptions[network] = append(networkOptions[network], opts...)
+	return networkOptions
+}
+
+// parseBridgeNetworkOptions parses the options of a bridge network. Options
+// which are not part of types.PerNetworkOptions, e.g. egress rules, are
+// returned as extra options in key=value form.
+func parseBridgeNetworkOptions(opts string) (types.PerNetworkOptions, []string, error) {
 	netOpts := types.PerNetworkOptions{}
+	var extraOpts []string
 	if len(opts) == 0 {
-		return netOpts, nil
+		return netOpts, nil, nil
 	}
 	allopts := strings.Split(opts, ",")
 	for _, opt := range allopts {
@@ -448,34 +474,41 @@ func parseBridgeNetworkOptions(opts string) (types.PerNetworkOptions, error) {
 		case "ip", "ip6":
 			ip := net.ParseIP(value)
 			if ip == nil {
-				return netOpts, fmt.Errorf("invalid ip address %q", value)
+				return netOpts, nil, fmt.Errorf("invalid ip address %q", value)
 			}
 			netOpts.StaticIPs = append(netOpts.StaticIPs, ip)
 
 		case "mac":
 			mac, err := net.ParseMAC(value)
 			if err != nil {
-				return netOpts, err
+				return netOpts, nil, err
 			}
 			netOpts.StaticMAC = types.HardwareAddr(mac)
 
 		case "alias":
 			if value == "" {
-				return netOpts, errors.New("alias cannot be empty")
+				return netOpts, nil, errors.New("alias cannot be empty")
 			}
 			netOpts.Aliases = append(netOpts.Aliases, value)
 
 		case "interface_name":
 			if value == "" {
-				return netOpts, errors.New("interface_name cannot be empty")
+				return netOpts, nil, errors.New("interface_name cannot be empty")
 			}
 			netOpts.InterfaceName = value
 
+		case "egress":
+			rule, err := define.ParseEgressRule(value)
+			if err != nil {
+				return netOpts, nil, err
+			}
+			extraOpts = append(extraOpts, "egress="+rule.String())
+
 		default:
-			return netOpts, fmt.Errorf("unknown bridge network option: %s", name)
+			return netOpts, nil, fmt.Errorf("unknown bridge network option: %s", name)
 		}
 	}
-	return netOpts, nil
+	return netOpts, extraOpts, nil
 }
 
 func SetupUserNS(idmappings *storageTypes.IDMappingOptions, userns Namespace, g *generate.Generator) (string, error) {
@@ -230,6 +230,35 @@ func TestParseNetworkFlag(t *testing.T) {
 			nsmode: Namespace{NSMode: Bridge},
 			err:    "network name cannot be empty: invalid argument",
 		},
+		{
+			name:   "network egress rules",
+			args:   []string{"net1:egress=10.0.0.0/8,egress=registry.example.com:443,alias=a", "net2:egress=[fd00::1]:8000-8100"},
+			nsmode: Namespace{NSMode: Bridge},
+			networks: map[string]types.PerNetworkOptions{
+				"net1": {Aliases: []string{"a"}},
+				"net2": {},
+			},
+			options: map[string][]string{
+				"net1": {"egress=10.0.0.0/8", "egress=registry.example.com:443"},
+				"net2": {"egress=[fd00::1]:8000-8100"},
+			},
+		},
+		{
+			name:   "bridge mode egress rule",
+			args:   []string{"bridge:egress=192.168.1.10"},
+			nsmode: Namespace{NSMode: Bridge},
+			networks: map[string]types.PerNetworkOptions{
+				defaultNetName: {},
+			},
+			options: map[string][]string{
+				defaultNetName: {"egress=192.168.1.10"},
+			},
+		},
+		{
+			name: "invalid egress port",
+			args: []string{"net1:egress=10.0.0.1:0"},
+			err:  `invalid option for network net1: invalid egress rule "10.0.0.1:0": invalid port "0"`,
+		},
 		{
 			name:   "multiple networks on invalid mode should error",
 			args:   []string{"host", "net2"},
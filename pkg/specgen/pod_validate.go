@@ -61,6 +61,12 @@ func (p *PodSpecGenerator) Validate() error {
 		if len(p.HostAdd) > 0 {
 			return exclusivePodOptions("NoInfra", "HostAdd")
 		}
+		if len(p.NetworkRates) > 0 {
+			return exclusivePodOptions("NoInfra", "NetworkRates")
+		}
+		if p.HostsGroup != "" {
+			return exclusivePodOptions("NoInfra", "HostsGroup")
+		}
 		if p.NoManageResolvConf {
 			return exclusivePodOptions("NoInfra", "NoManageResolvConf")
 		}
@@ -85,6 +91,9 @@ func (p *PodSpecGenerator) Validate() error {
 	if p.NoManageHosts && len(p.HostAdd) > 0 {
 		return exclusivePodOptions("NoManageHosts", "HostAdd")
 	}
+	if p.NoManageHosts && p.HostsGroup != "" {
+		return exclusivePodOptions("NoManageHosts", "HostsGroup")
+	}
 
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"net"
 
 	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/libpod/define"
 	storageTypes "github.com/containers/storage/types"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -75,6 +76,15 @@ type PodBasicConfig struct {
 	// Only available when RestartPolicy is set to "on-failure".
 	// Optional.
 	RestartRetries *uint `json:"restart_tries,omitempty"`
+	// RestartPodPolicy is the policy to restart the whole pod, infra
+	// container included, when a critical container of the pod exits or
+	// turns unhealthy.
+	// Optional.
+	RestartPodPolicy string `json:"restart_pod_policy,omitempty"`
+	// RestartPodRetries is the maximum number of restarts of the pod.
+	// Only available when RestartPodPolicy is set to "on-failure".
+	// Optional.
+	RestartPodRetries *uint `json:"restart_pod_tries,omitempty"`
 	// PodCreateCommand is the command used to create this pod.
 	// This will be shown in the output of Inspect() on the pod, and may
 	// also be used by some tools that wish to recreate the pod
@@ -170,9 +180,19 @@ type PodNetworkConfig struct {
 	// Conflicts with NoInfra=true and NoManageHosts.
 	// Optional.
 	HostAdd []string `json:"hostadd,omitempty"`
+	// HostsGroup is the name of a group of containers and pods which keep
+	// each others names and addresses in their /etc/hosts files.
+	// Conflicts with NoInfra=true and NoManageHosts.
+	// Optional.
+	HostsGroup string `json:"hosts_group,omitempty"`
 	// NetworkOptions are additional options for each network
 	// Optional.
 	NetworkOptions map[string][]string `json:"network_options,omitempty"`
+	// NetworkRates are the bandwidth limits of the infra container, keyed
+	// by the network name. The empty key sets the limit of all networks.
+	// Conflicts with NoInfra=true.
+	// Optional.
+	NetworkRates map[string]define.NetworkRate `json:"network_rates,omitempty"`
 }
 
 // PodStorageConfig contains all of the storage related options for the pod and its infra container.
@@ -5,6 +5,7 @@ import (
 	"net"
 	"strings"
 	"syscall"
+	"time"
 
 	nettypes "github.com/containers/common/libnetwork/types"
 	"github.com/containers/image/v5/manifest"
@@ -40,6 +41,11 @@ type ContainerBasicConfig struct {
 	// Pod is the ID of the pod the container will join.
 	// Optional.
 	Pod string `json:"pod,omitempty"`
+	// PodCritical indicates that the whole pod is restarted according to
+	// its restart pod policy when the container exits or turns unhealthy.
+	// Requires Pod.
+	// Optional.
+	PodCritical *bool `json:"pod_critical,omitempty"`
 	// Entrypoint is the container's entrypoint.
 	// If not given and Image is specified, this will be populated by the
 	// image's configuration.
@@ -113,6 +119,10 @@ type ContainerBasicConfig struct {
 	// Only available when RestartPolicy is set to "on-failure".
 	// Optional.
 	RestartRetries *uint `json:"restart_tries,omitempty"`
+	// RestartBackoff delays the restarts by the restart policy
+	// exponentially.
+	// Optional.
+	RestartBackoff *define.RestartBackoff `json:"restart_backoff,omitempty"`
 	// OCIRuntime is the name of the OCI runtime that will be used to create
 	// the container.
 	// If not specified, the default will be used.
@@ -186,6 +196,16 @@ type ContainerBasicConfig struct {
 	// container. Dependencies can be specified by name or full/partial ID.
 	// Optional.
 	DependencyContainers []string `json:"dependencyContainers,omitempty"`
+	// DependencyConditions are the conditions, indexed by the dependency
+	// containers as given in DependencyContainers, which must be met
+	// before this container is started. Dependencies without a condition
+	// must be running.
+	// Optional.
+	DependencyConditions map[string]string `json:"dependencyConditions,omitempty"`
+	// DependencyTimeout is the time to wait for the dependency conditions
+	// to be met.
+	// Optional.
+	DependencyTimeout time.Duration `json:"dependencyTimeout,omitempty"`
 	// PidFile is the file that saves container's PID.
 	// Not supported for remote clients, so not serialized in specgen JSON.
 	// Optional.
@@ -299,6 +319,11 @@ type ContainerStorageConfig struct {
 	// Image volumes bind-mount a container-image mount into the container.
 	// Optional.
 	ImageVolumes []*ImageVolume `json:"image_volumes,omitempty"`
+	// Rootfs volumes bind-mount the root filesystem of another container
+	// into the container. The other container must be running when the
+	// container is started.
+	// Optional.
+	RootfsVolumes []*RootfsVolume `json:"rootfs_volumes,omitempty"`
 	// Devices are devices that will be added to the container.
 	// Optional.
 	Devices []spec.LinuxDevice `json:"devices,omitempty"`
@@ -545,9 +570,20 @@ type ContainerNetworkConfig struct {
 	// Conflicts with UseImageHosts.
 	// Optional.
 	HostAdd []string `json:"hostadd,omitempty"`
+	// HostsGroup is the name of a group of containers which keep each
+	// others names and addresses in their /etc/hosts files.
+	// Conflicts with UseImageHosts and joining the network namespace of
+	// another container.
+	// Optional.
+	HostsGroup string `json:"hosts_group,omitempty"`
 	// NetworkOptions are additional options for each network
 	// Optional.
 	NetworkOptions map[string][]string `json:"network_options,omitempty"`
+	// NetworkRates are the bandwidth limits of the container, keyed by the
+	// network name. The empty key sets the limit of all networks.
+	// Only available if NetNS is set to bridge.
+	// Optional.
+	NetworkRates map[string]define.NetworkRate `json:"network_rates,omitempty"`
 }
 
 // ContainerResourceConfig contains information on container resource limits.
@@ -589,6 +625,10 @@ type ContainerResourceConfig struct {
 type ContainerHealthCheckConfig struct {
 	HealthConfig               *manifest.Schema2HealthConfig     `json:"healthconfig,omitempty"`
 	HealthCheckOnFailureAction define.HealthCheckOnFailureAction `json:"health_check_on_failure_action,omitempty"`
+	// Number of checkpoint archives kept when HealthCheckOnFailureAction
+	// is checkpoint. If 0, Podman keeps the 3 newest archives.
+	// Optional.
+	HealthCheckCheckpointRetention uint `json:"health_check_checkpoint_retention,omitempty"`
 	// Startup healthcheck for a container.
 	// Requires that HealthConfig be set.
 	// Optional.
@@ -55,6 +55,16 @@ type ImageVolume struct {
 	ReadWrite bool
 }
 
+// RootfsVolume is a volume that bind-mounts the root filesystem of another
+// container into the container.
+type RootfsVolume struct {
+	// Source is the container whose root filesystem is mounted.  The
+	// container can be referred to by name and by ID.
+	Source string
+	// Destination is the absolute path of the mount in the container.
+	Destination string
+}
+
 // GenVolumeMounts parses user input into mounts, volumes and overlay volumes
 func GenVolumeMounts(volumeFlag []string) (map[string]spec.Mount, map[string]*NamedVolume, map[string]*OverlayVolume, error) {
 	mounts := make(map[string]spec.Mount)
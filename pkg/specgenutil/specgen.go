@@ -368,6 +368,10 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		return err
 	}
 	s.HealthCheckOnFailureAction = onFailureAction
+	if c.HealthCheckpoints > 0 && onFailureAction != define.HealthCheckOnFailureActionCheckpoint {
+		return errors.New("--health-checkpoint-retention requires --health-on-failure=checkpoint")
+	}
+	s.HealthCheckCheckpointRetention = c.HealthCheckpoints
 
 	if c.StartupHCCmd != "" {
 		if c.NoHealthCheck {
@@ -422,6 +426,9 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		}
 		s.Pod = podID
 	}
+	if s.PodCritical == nil || c.PodCritical {
+		s.PodCritical = &c.PodCritical
+	}
 
 	expose, err := CreateExpose(c.Expose)
 	if err != nil {
@@ -580,6 +587,8 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		s.DNSSearch = c.Net.DNSSearch
 		s.DNSOptions = c.Net.DNSOptions
 		s.NetworkOptions = c.Net.NetworkOptions
+		s.NetworkRates = c.Net.NetworkRates
+		s.HostsGroup = c.Net.HostsGroup
 		s.UseImageHosts = &c.Net.NoHosts
 	}
 	if len(s.HostUsers) == 0 || len(c.HostUsers) != 0 {
@@ -658,7 +667,20 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 	}
 
 	if len(s.DependencyContainers) == 0 || len(c.Requires) != 0 {
-		s.DependencyContainers = c.Requires
+		s.DependencyContainers, s.DependencyConditions, err = parseRequires(c.Requires)
+		if err != nil {
+			return err
+		}
+	}
+	if c.RequiresTimeout != "" {
+		timeout, err := time.ParseDuration(c.RequiresTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid --requires-timeout: %w", err)
+		}
+		if timeout <= 0 {
+			return errors.New("--requires-timeout must be positive")
+		}
+		s.DependencyTimeout = timeout
 	}
 
 	// Only add ReadWrite tmpfs mounts iff the container is
@@ -868,6 +890,15 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		s.RestartPolicy = policy
 		s.RestartRetries = &retries
 	}
+	if c.RestartDelay != "" {
+		backoff, err := parseRestartBackoff(c.RestartDelay, c.RestartMaxDelay, c.RestartDelayReset)
+		if err != nil {
+			return err
+		}
+		s.RestartBackoff = backoff
+	} else if c.RestartMaxDelay != "" || c.RestartDelayReset != "" {
+		return errors.New("--restart-max-delay and --restart-delay-reset require --restart-delay")
+	}
 
 	if len(s.Secrets) == 0 || len(c.Secrets) != 0 {
 		s.Secrets, s.EnvSecrets, err = parseSecrets(c.Secrets)
@@ -936,6 +967,61 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 	return nil
 }
 
+// parseRestartBackoff parses the durations of the restart backoff.  Empty
+// maximum and reset durations are left to the defaults.
+func parseRestartBackoff(initial, maxDelay, reset string) (*define.RestartBackoff, error) {
+	backoff := new(define.RestartBackoff)
+	var err error
+	if backoff.Initial, err = time.ParseDuration(initial); err != nil {
+		return nil, fmt.Errorf("invalid --restart-delay: %w", err)
+	}
+	if backoff.Initial <= 0 {
+		return nil, errors.New("--restart-delay must be positive")
+	}
+	if maxDelay != "" {
+		if backoff.Max, err = time.ParseDuration(maxDelay); err != nil {
+			return nil, fmt.Errorf("invalid --restart-max-delay: %w", err)
+		}
+		if backoff.Max < backoff.Initial {
+			return nil, errors.New("--restart-max-delay must not be less than --restart-delay")
+		}
+	}
+	if reset != "" {
+		if backoff.Reset, err = time.ParseDuration(reset); err != nil {
+			return nil, fmt.Errorf("invalid --restart-delay-reset: %w", err)
+		}
+		if backoff.Reset <= 0 {
+			return nil, errors.New("--restart-delay-reset must be positive")
+		}
+	}
+	return backoff, nil
+}
+
+// parseRequires parses the CONTAINER[:CONDITION] values of --requires into
+// the dependency containers and their conditions.
+func parseRequires(requires []string) ([]string, map[string]string, error) {
+	var conditions map[string]string
+	deps := make([]string, 0, len(requires))
+	for _, require := range requires {
+		ctr, condition, hasCondition := strings.Cut(require, ":")
+		if ctr == "" {
+			return nil, nil, fmt.Errorf("invalid --requires %q: container must not be empty", require)
+		}
+		deps = append(deps, ctr)
+		if !hasCondition {
+			continue
+		}
+		if err := define.ValidDependencyCondition(condition); err != nil {
+			return nil, nil, fmt.Errorf("invalid --requires %q: %w", require, err)
+		}
+		if conditions == nil {
+			conditions = make(map[string]string)
+		}
+		conditions[ctr] = condition
+	}
+	return deps, conditions, nil
+}
+
 func makeHealthCheckFromCli(inCmd, interval string, retries uint, timeout, startPeriod string, isStartup bool) (*manifest.Schema2HealthConfig, error) {
 	cmdArr := []string{}
 	isArr := true
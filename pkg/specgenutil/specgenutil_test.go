@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/containers/common/pkg/machine"
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/stretchr/testify/assert"
@@ -216,3 +218,41 @@ func TestGenRlimits(t *testing.T) {
 	_, err = GenRlimits([]string{"nofile=bar:buzz"})
 	assert.Error(t, err, "err is not nil")
 }
+
+func TestParseRestartBackoff(t *testing.T) {
+	backoff, err := parseRestartBackoff("2s", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, &define.RestartBackoff{Initial: 2 * time.Second}, backoff)
+
+	backoff, err = parseRestartBackoff("2s", "1m", "30m")
+	assert.NoError(t, err)
+	assert.Equal(t, &define.RestartBackoff{Initial: 2 * time.Second, Max: time.Minute, Reset: 30 * time.Minute}, backoff)
+
+	_, err = parseRestartBackoff("0s", "", "")
+	assert.Error(t, err)
+	_, err = parseRestartBackoff("10s", "5s", "")
+	assert.Error(t, err)
+	_, err = parseRestartBackoff("10s", "", "soon")
+	assert.Error(t, err)
+}
+
+func TestParseRequires(t *testing.T) {
+	deps, conditions, err := parseRequires([]string{"infra", "db:healthy", "migrate:completed-successfully", "cache:started"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"infra", "db", "migrate", "cache"}, deps)
+	assert.Equal(t, map[string]string{
+		"db":      define.DependencyConditionHealthy,
+		"migrate": define.DependencyConditionCompletedSuccessfully,
+		"cache":   define.DependencyConditionStarted,
+	}, conditions)
+
+	deps, conditions, err = parseRequires([]string{"db"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db"}, deps)
+	assert.Nil(t, conditions)
+
+	_, _, err = parseRequires([]string{"db:ready"})
+	assert.ErrorIs(t, err, define.ErrInvalidArg)
+	_, _, err = parseRequires([]string{":healthy"})
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package specgenutil
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"os"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v5/libpod/define"
 	storageTypes "github.com/containers/storage/types"
 	"github.com/sirupsen/logrus"
 )
@@ -261,6 +263,91 @@ func parseAndValidatePort(port string) (uint16, error) {
 	return uint16(num), nil
 }
 
+// bitRateUnits maps the tc style rate units to their value in bits per second.
+var bitRateUnits = map[string]uint64{
+	"bit":   1,
+	"kbit":  1000,
+	"mbit":  1000 * 1000,
+	"gbit":  1000 * 1000 * 1000,
+	"tbit":  1000 * 1000 * 1000 * 1000,
+	"kibit": 1024,
+	"mibit": 1024 * 1024,
+	"gibit": 1024 * 1024 * 1024,
+	"tibit": 1024 * 1024 * 1024 * 1024,
+	"bps":   8,
+	"kbps":  8 * 1000,
+	"mbps":  8 * 1000 * 1000,
+	"gbps":  8 * 1000 * 1000 * 1000,
+	"tbps":  8 * 1000 * 1000 * 1000 * 1000,
+}
+
+// ParseNetworkRates parses the values of the --network-rate option in the
+// [NETWORK:]ingress=RATE,egress=RATE format. The returned map is keyed by the
+// network name, the empty name is used for limits of all networks.
+func ParseNetworkRates(rates []string) (map[string]define.NetworkRate, error) {
+	if len(rates) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]define.NetworkRate, len(rates))
+	for _, value := range rates {
+		netName, limits, hasNet := strings.Cut(value, ":")
+		if !hasNet {
+			netName, limits = "", value
+		} else if netName == "" {
+			return nil, fmt.Errorf("invalid network rate %q: network name must not be empty", value)
+		}
+		if _, ok := result[netName]; ok {
+			return nil, fmt.Errorf("invalid network rate %q: rate is already set for this network", value)
+		}
+		var rate define.NetworkRate
+		for _, limit := range strings.Split(limits, ",") {
+			key, val, ok := strings.Cut(limit, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid network rate %q: expected ingress=RATE or egress=RATE", value)
+			}
+			bits, err := parseBitRate(val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid network rate %q: %w", value, err)
+			}
+			switch key {
+			case "ingress":
+				rate.Ingress = bits
+			case "egress":
+				rate.Egress = bits
+			default:
+				return nil, fmt.Errorf("invalid network rate %q: unknown direction %q", value, key)
+			}
+		}
+		result[netName] = rate
+	}
+	return result, nil
+}
+
+// parseBitRate parses a rate with a tc style unit, e.g. 10mbit or 1gbps, into
+// bits per second. A plain 0 is accepted to remove a limit.
+func parseBitRate(rate string) (uint64, error) {
+	if rate == "0" {
+		return 0, nil
+	}
+	rate = strings.ToLower(rate)
+	i := strings.IndexFunc(rate, func(r rune) bool { return r < '0' || r > '9' })
+	if i <= 0 {
+		return 0, fmt.Errorf("rate %q must be a number followed by a unit like kbit, mbit or gbit", rate)
+	}
+	unit, ok := bitRateUnits[rate[i:]]
+	if !ok {
+		return 0, fmt.Errorf("unknown rate unit %q", rate[i:])
+	}
+	num, err := strconv.ParseUint(rate[:i], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rate %q: %w", rate, err)
+	}
+	if num > math.MaxUint64/unit {
+		return 0, fmt.Errorf("rate %q is too large", rate)
+	}
+	return num * unit, nil
+}
+
 func CreateExitCommandArgs(storageConfig storageTypes.StoreOptions, config *config.Config, syslog, rm, exec bool) ([]string, error) {
 	// We need a cleanup process for containers in the current model.
 	// But we can't assume that the caller is Podman - it could be another
@@ -3,6 +3,9 @@ package specgenutil
 import (
 	"reflect"
 	"testing"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCreateExpose(t *testing.T) {
@@ -144,3 +147,72 @@ func Test_parseAndValidatePort(t *testing.T) {
 		})
 	}
 }
+
+func TestParseNetworkRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		rates   []string
+		want    map[string]define.NetworkRate
+		wantErr bool
+	}{
+		{
+			name:  "all networks",
+			rates: []string{"ingress=10mbit,egress=5mbit"},
+			want:  map[string]define.NetworkRate{"": {Ingress: 10_000_000, Egress: 5_000_000}},
+		},
+		{
+			name:  "per network",
+			rates: []string{"net1:egress=1gbit", "net2:ingress=2kbps"},
+			want: map[string]define.NetworkRate{
+				"net1": {Egress: 1_000_000_000},
+				"net2": {Ingress: 16_000},
+			},
+		},
+		{
+			name:  "iec unit and zero",
+			rates: []string{"ingress=1MiBit,egress=0"},
+			want:  map[string]define.NetworkRate{"": {Ingress: 1024 * 1024}},
+		},
+		{
+			name:    "missing unit",
+			rates:   []string{"ingress=100"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown unit",
+			rates:   []string{"ingress=100mb"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown direction",
+			rates:   []string{"both=1mbit"},
+			wantErr: true,
+		},
+		{
+			name:    "empty network name",
+			rates:   []string{":egress=1mbit"},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate network",
+			rates:   []string{"net1:egress=1mbit", "net1:ingress=1mbit"},
+			wantErr: true,
+		},
+		{
+			name:    "overflow",
+			rates:   []string{"egress=99999999999tbps"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNetworkRates(tt.rates)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
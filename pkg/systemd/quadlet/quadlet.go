@@ -524,6 +524,8 @@ func ConvertContainer(container *parser.UnitFile, names map[string]string, isUse
 		// but we also allow passing it to the container by setting Notify=yes
 		notify, ok := container.Lookup(ContainerGroup, KeyNotify)
 		switch {
+		case ok && strings.EqualFold(notify, "healthy-then-watchdog"):
+			podman.add("--sdnotify=healthy-then-watchdog")
 		case ok && strings.EqualFold(notify, "healthy"):
 			podman.add("--sdnotify=healthy")
 		case container.LookupBooleanWithDefault(ContainerGroup, KeyNotify, false):
@@ -1828,4 +1828,40 @@ var _ = Describe("Podman checkpoint", func() {
 		Expect(result).Should(ExitCleanly())
 		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
 	})
+
+	It("podman checkpoint container when its health check turns unhealthy", func() {
+		localRunString := getRunString([]string{"--name", "hc", "--health-cmd", "ls /foo || exit 1", "--health-retries", "1", "--health-on-failure", "checkpoint", "--health-checkpoint-retention", "1", ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(Exit(1))
+
+		info := podmanTest.Podman([]string{"info", "--format", "{{.Store.GraphRoot}}"})
+		info.WaitWithDefaultTimeout()
+		Expect(info).Should(ExitCleanly())
+		checkpointDir := filepath.Join(info.OutputToString(), "libpod", "healthcheck-checkpoints", "hc")
+		archives, err := filepath.Glob(filepath.Join(checkpointDir, "checkpoint-*.tar.zst"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(archives).To(HaveLen(1))
+
+		// The container is restarted after the checkpoint.
+		Eventually(func() string { return podmanTest.GetContainerStatus() }, "30s", "1s").Should(ContainSubstring("Up"))
+
+		// The archive can be restored for debugging next to the
+		// original container.
+		result := podmanTest.Podman([]string{"container", "restore", "--import", archives[0], "--name", "hc-debug"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(2))
+
+		// The archives outlive the container.
+		result = podmanTest.Podman([]string{"rm", "-t", "0", "-fa"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+		Expect(archives[0]).To(BeAnExistingFile())
+		Expect(os.RemoveAll(checkpointDir)).To(Succeed())
+	})
 })
@@ -259,4 +259,31 @@ var _ = Describe("Podman cp", func() {
 		Expect(lsOutput).To(ContainSubstring("bin"))
 		Expect(lsOutput).To(ContainSubstring("usr"))
 	})
+
+	It("podman cp --include --exclude from the ctr to the host", func() {
+		container := "copyfiltered"
+		session := podmanTest.RunTopContainer(container)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"exec", container, "sh", "-c", "mkdir -p /data/logs/old /data/cache && touch /data/logs/app.log /data/logs/old/app.log.gz /data/cache/blob /data/README"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		tmpDir := GinkgoT().TempDir()
+
+		session = podmanTest.Podman([]string{"cp", "--include", "*.log*", "--exclude", "*.gz", container + ":/data", tmpDir})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		Expect(filepath.Join(tmpDir, "data", "logs", "app.log")).To(BeARegularFile())
+		Expect(filepath.Join(tmpDir, "data", "logs", "old")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(tmpDir, "data", "cache")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(tmpDir, "data", "README")).ToNot(BeAnExistingFile())
+
+		session = podmanTest.Podman([]string{"cp", "--exclude", "[a-", container + ":/data", tmpDir})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring(`invalid pattern "[a-"`))
+	})
 })
@@ -0,0 +1,88 @@
+package integration
+
+import (
+	. "github.com/containers/podman/v5/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Podman debug", func() {
+
+	It("podman debug bogus container", func() {
+		session := podmanTest.Podman([]string{"debug", "--image", ALPINE, "foobar"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+	})
+
+	It("podman debug stopped container", func() {
+		session := podmanTest.Podman([]string{"create", "--name", "test1", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"debug", "--image", ALPINE, "test1"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("is not running"))
+	})
+
+	It("podman debug shares namespaces and root filesystem", func() {
+		setup := podmanTest.RunTopContainer("test1")
+		setup.WaitWithDefaultTimeout()
+		Expect(setup).Should(ExitCleanly())
+
+		session := podmanTest.Podman([]string{"exec", "test1", "touch", "/debug-marker"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"debug", "--image", ALPINE, "test1", "ls", "/target"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("debug-marker"))
+
+		session = podmanTest.Podman([]string{"container", "debug", "--image", ALPINE, "test1", "ps"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("top"))
+
+		session = podmanTest.Podman([]string{"debug", "--image", ALPINE, "test1", "hostname"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		hostname := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"exec", "test1", "hostname"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal(hostname))
+
+		session = podmanTest.Podman([]string{"debug", "--image", ALPINE, "test1", "sh", "-c", "exit 3"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(3))
+
+		// The debug containers are removed on exit.
+		Expect(podmanTest.NumberOfContainers()).To(Equal(1))
+	})
+
+	It("podman debug container in a pod", func() {
+		session := podmanTest.Podman([]string{"pod", "create", "--name", "pod1"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		setup := podmanTest.RunTopContainerInPod("test1", "pod1")
+		setup.WaitWithDefaultTimeout()
+		Expect(setup).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"debug", "--image", ALPINE, "test1", "ps"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("top"))
+
+		session = podmanTest.Podman([]string{"debug", "--image", ALPINE, "test1", "ls", "/target/etc"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("alpine-release"))
+
+		// Only the infra and the target container are left.
+		Expect(podmanTest.NumberOfContainers()).To(Equal(2))
+	})
+})
@@ -527,6 +527,47 @@ RUN useradd -u 1000 auser`, fedoraMinimal)
 		podmanTest.StopContainer(ctrName)
 	})
 
+	It("podman exec --name and exec-attach", func() {
+		ctrName := "testctr"
+		ctr := podmanTest.Podman([]string{"run", "-d", "--name", ctrName, ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).Should(ExitCleanly())
+
+		exec1 := podmanTest.Podman([]string{"exec", "--name", "debug", "-d", ctrName, "sh", "-c", "sleep 3; echo hello"})
+		exec1.WaitWithDefaultTimeout()
+		Expect(exec1).Should(ExitCleanly())
+
+		// names are unique among running containers
+		exec2 := podmanTest.Podman([]string{"exec", "--name", "debug", "-d", ctrName, "top"})
+		exec2.WaitWithDefaultTimeout()
+		Expect(exec2).Should(ExitWithError(125))
+		Expect(exec2.ErrorToString()).To(ContainSubstring(`the exec session name "debug" is already in use`))
+
+		exec3 := podmanTest.Podman([]string{"exec", "--name", "no/slash", ctrName, "true"})
+		exec3.WaitWithDefaultTimeout()
+		Expect(exec3).Should(ExitWithError(125))
+
+		attach := podmanTest.Podman([]string{"exec-attach", "--no-stdin", "debug"})
+		attach.WaitWithDefaultTimeout()
+		Expect(attach).Should(ExitCleanly())
+		Expect(attach.OutputToString()).To(Equal("hello"))
+
+		// the session is gone once its command exited
+		attach = podmanTest.Podman([]string{"container", "exec-attach", "--no-stdin", "debug"})
+		attach.WaitWithDefaultTimeout()
+		Expect(attach).Should(ExitWithError(125))
+
+		// exec keeps treating its first argument as the container
+		podmanTest.StopContainer(ctrName)
+		ctr = podmanTest.Podman([]string{"run", "-d", "--name", "attach", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).Should(ExitCleanly())
+		exec4 := podmanTest.Podman([]string{"exec", "attach", "echo", "hi"})
+		exec4.WaitWithDefaultTimeout()
+		Expect(exec4).Should(ExitCleanly())
+		Expect(exec4.OutputToString()).To(Equal("hi"))
+	})
+
 	It("podman exec with env var secret", func() {
 		secretsString := "somesecretdata"
 		secretFilePath := filepath.Join(podmanTest.TempDir, "secret")
@@ -277,6 +277,28 @@ var _ = Describe("Podman healthcheck run", func() {
 		Expect(hcLogsArray).To(BeEmpty())
 	})
 
+	It("podman healthcheck --health-on-failure=checkpoint", func() {
+		session := podmanTest.Podman([]string{"create", "--name", "hc", "--health-cmd", "ls /foo || exit 1", "--health-checkpoint-retention", "2", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("--health-checkpoint-retention requires --health-on-failure=checkpoint"))
+
+		session = podmanTest.Podman([]string{"create", "--name", "hc", "--health-cmd", "ls /foo || exit 1", "--health-on-failure", "checkpoint", "--health-checkpoint-retention", "2", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.InspectContainer("hc")
+		Expect(inspect[0].Config).To(HaveField("HealthcheckOnFailureAction", "checkpoint"))
+		Expect(inspect[0].Config).To(HaveField("HealthcheckCheckpointRetention", uint(2)))
+
+		session = podmanTest.Podman([]string{"create", "--name", "hc2", "--health-cmd", "ls /foo || exit 1", "--health-on-failure", "checkpoint", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect = podmanTest.InspectContainer("hc2")
+		Expect(inspect[0].Config).To(HaveField("HealthcheckCheckpointRetention", define.DefaultHealthCheckCheckpointRetention))
+	})
+
 	It("stopping and then starting a container with healthcheck cmd", func() {
 		session := podmanTest.Podman([]string{"run", "-dt", "--name", "hc", "--health-cmd", "[\"ls\", \"/foo\"]", ALPINE, "top"})
 		session.WaitWithDefaultTimeout()
@@ -0,0 +1,104 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/containers/podman/v5/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Podman hooks", func() {
+	var hooksDir string
+
+	BeforeEach(func() {
+		SkipIfRemote("--hooks-dir does not work with remote")
+		hooksDir = filepath.Join(podmanTest.TempDir, "hooks")
+		err := os.Mkdir(hooksDir, 0755)
+		Expect(err).ToNot(HaveOccurred())
+		hookJSON := `{"version": "1.0.0", "hook": {"path": "/bin/true"}, "when": {"commands": ["^/bin/sh$"]}, "stages": ["prestart", "poststop"]}`
+		err = os.WriteFile(filepath.Join(hooksDir, "shell.json"), []byte(hookJSON), 0644)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("podman hooks ls and inspect", func() {
+		session := podmanTest.Podman([]string{"--hooks-dir", hooksDir, "hooks", "ls", "--noheading"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(HaveLen(1))
+		Expect(session.OutputToString()).To(ContainSubstring("shell.json"))
+		Expect(session.OutputToString()).To(ContainSubstring("prestart,poststop"))
+
+		session = podmanTest.Podman([]string{"--hooks-dir", hooksDir, "hooks", "inspect", "--format", "{{.Hook.Path}} {{.Stages}}", "shell"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("/bin/true [prestart poststop]"))
+
+		session = podmanTest.Podman([]string{"--hooks-dir", hooksDir, "hooks", "inspect", "shell.json"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(BeValidJSON())
+
+		session = podmanTest.Podman([]string{"--hooks-dir", hooksDir, "hooks", "inspect", "bogus"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("bogus: no such hook"))
+
+		// invalid hooks are listed with a warning
+		err := os.WriteFile(filepath.Join(hooksDir, "invalid.json"), []byte(`{"version": "1.0.0", "hook": {"path": "/bin/true"}, "when": {"always": true}, "stages": ["prestop"]}`), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		session = podmanTest.Podman([]string{"--hooks-dir", hooksDir, "hooks", "ls", "--quiet"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToStringArray()).To(Equal([]string{"invalid.json", "shell.json"}))
+		Expect(session.ErrorToString()).To(ContainSubstring(`unknown stage "prestop"`))
+	})
+
+	It("podman hooks validate", func() {
+		hookPath := filepath.Join(hooksDir, "shell.json")
+		session := podmanTest.Podman([]string{"hooks", "validate", "--command", "/bin/sh", "--format", "{{.Match}}", hookPath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("true"))
+
+		session = podmanTest.Podman([]string{"hooks", "validate", "--command", "/bin/bash", "--format", "{{.Match}}", hookPath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("false"))
+
+		invalidPath := filepath.Join(podmanTest.TempDir, "invalid.json")
+		err := os.WriteFile(invalidPath, []byte(`{"version": "1.0.0", "hook": {"path": "/bin/true"}}`), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		session = podmanTest.Podman([]string{"hooks", "validate", invalidPath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("invalid hook " + invalidPath))
+	})
+
+	It("podman container inspect --show-hooks", func() {
+		session := podmanTest.Podman([]string{"--hooks-dir", hooksDir, "create", "--name", "withhook", ALPINE, "/bin/sh"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		session = podmanTest.Podman([]string{"--hooks-dir", hooksDir, "create", "--name", "withouthook", ALPINE, "ls"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"--hooks-dir", hooksDir, "container", "inspect", "--show-hooks", "--format", "{{range .OCIHooks}}{{.Name}} {{.Stages}}{{end}}", "withhook"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("shell.json [prestart poststop]"))
+
+		inspect = podmanTest.Podman([]string{"--hooks-dir", hooksDir, "container", "inspect", "--show-hooks", "--format", "{{len .OCIHooks}}", "withouthook"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("0"))
+
+		// hooks are only shown on request
+		inspect = podmanTest.Podman([]string{"--hooks-dir", hooksDir, "inspect", "--format", "{{len .OCIHooks}}", "withhook"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("0"))
+	})
+})
@@ -1,6 +1,8 @@
 package integration
 
 import (
+	"encoding/json"
+
 	. "github.com/containers/podman/v5/test/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -188,4 +190,41 @@ var _ = Describe("Podman kill", func() {
 		Expect(session).Should(ExitCleanly())
 		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
 	})
+
+	It("podman kill --filter --format json", func() {
+		session := podmanTest.RunTopContainerWithArgs("", []string{"--label", "tier=web"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		session = podmanTest.RunTopContainer("")
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"kill", "foobar", "--filter", "label=tier=web"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+
+		session = podmanTest.Podman([]string{"kill", "--format", "yaml", "--filter", "label=tier=web"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("only json is supported"))
+
+		session = podmanTest.Podman([]string{"kill", "--filter", "label=tier=web", "--format", "json"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(BeValidJSON())
+		var reports []map[string]any
+		err := json.Unmarshal(session.Out.Contents(), &reports)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reports).To(HaveLen(1))
+		Expect(reports[0]).To(HaveKeyWithValue("Id", cid))
+		Expect(reports[0]).ToNot(HaveKey("Error"))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+
+		session = podmanTest.Podman([]string{"kill", "--filter", "label=tier=web", "--format", "json"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("[]"))
+	})
 })
@@ -0,0 +1,82 @@
+package integration
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	. "github.com/containers/podman/v5/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Podman container netcap", func() {
+
+	It("podman container netcap writes pcapng", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "netcap", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		outfile := filepath.Join(podmanTest.TempDir, "capture.pcapng")
+		capture := podmanTest.Podman([]string{"container", "netcap", "-i", "lo", "--filter", "icmp and host 127.0.0.1", "-c", "2", "-w", outfile, "netcap"})
+
+		// keep pinging until the capture is set up and has enough packets
+		ping := podmanTest.Podman([]string{"exec", "netcap", "sh", "-c", "for i in $(seq 20); do ping -c 1 -W 1 127.0.0.1; sleep 0.5; done"})
+		capture.WaitWithDefaultTimeout()
+		Expect(capture).Should(ExitCleanly())
+		ping.WaitWithDefaultTimeout()
+
+		data, err := os.ReadFile(outfile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(len(data)).To(BeNumerically(">", 12))
+		// section header block
+		Expect(binary.LittleEndian.Uint32(data[0:])).To(Equal(uint32(0x0A0D0D0A)))
+		Expect(binary.LittleEndian.Uint32(data[8:])).To(Equal(uint32(0x1A2B3C4D)))
+		Expect(string(data)).To(ContainSubstring("lo"))
+
+		// count the enhanced packet blocks
+		packets := 0
+		for rest := data; len(rest) >= 12; {
+			if binary.LittleEndian.Uint32(rest[0:]) == 6 {
+				packets++
+			}
+			rest = rest[binary.LittleEndian.Uint32(rest[4:]):]
+		}
+		Expect(packets).To(Equal(2))
+	})
+
+	It("podman container netcap errors", func() {
+		session := podmanTest.Podman([]string{"create", "--name", "netcap", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		outfile := filepath.Join(podmanTest.TempDir, "capture.pcapng")
+		capture := podmanTest.Podman([]string{"container", "netcap", "-w", outfile, "netcap"})
+		capture.WaitWithDefaultTimeout()
+		Expect(capture).Should(ExitWithError())
+		Expect(capture.ErrorToString()).To(ContainSubstring("must be running to capture packets"))
+
+		capture = podmanTest.Podman([]string{"container", "netcap", "--filter", "tcp port http", "-w", outfile, "netcap"})
+		capture.WaitWithDefaultTimeout()
+		Expect(capture).Should(ExitWithError())
+		Expect(capture.ErrorToString()).To(ContainSubstring(`invalid port "http"`))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--name", "netcaphost", "--network", "host", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		capture = podmanTest.Podman([]string{"container", "netcap", "-w", outfile, "netcaphost"})
+		capture.WaitWithDefaultTimeout()
+		Expect(capture).Should(ExitWithError())
+		Expect(capture.ErrorToString()).To(ContainSubstring("uses the host network"))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--name", "netcapiface", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		capture = podmanTest.Podman([]string{"container", "netcap", "-i", "eth99", "-w", outfile, "netcapiface"})
+		capture.WaitWithDefaultTimeout()
+		Expect(capture).Should(ExitWithError())
+		Expect(capture.ErrorToString()).To(ContainSubstring("interface eth99"))
+	})
+})
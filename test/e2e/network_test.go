@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v5/pkg/domain/entities"
 	. "github.com/containers/podman/v5/test/utils"
 	"github.com/containers/storage/pkg/stringid"
 	. "github.com/onsi/ginkgo/v2"
@@ -262,6 +263,70 @@ var _ = Describe("Podman network", func() {
 		Expect(session.OutputToString()).To(ContainSubstring("bridge"))
 	})
 
+	It("podman network inspect --live", func() {
+		netName := createNetworkName("live")
+		network := podmanTest.Podman([]string{"network", "create", "--subnet", "10.51.51.0/24", netName})
+		network.WaitWithDefaultTimeout()
+		defer podmanTest.removeNetwork(netName)
+		Expect(network).Should(ExitCleanly())
+
+		empty := podmanTest.Podman([]string{"network", "inspect", "--live", "--format", "{{len .Endpoints}}", netName})
+		empty.WaitWithDefaultTimeout()
+		Expect(empty).Should(ExitCleanly())
+		Expect(empty.OutputToString()).To(Equal("0"))
+
+		podName := "livepod"
+		pod := podmanTest.Podman([]string{"pod", "create", "--network", netName + ":alias=livealias", "--name", podName})
+		pod.WaitWithDefaultTimeout()
+		Expect(pod).Should(ExitCleanly())
+
+		ctr := podmanTest.Podman([]string{"run", "-d", "--pod", podName, "--name", "livectr", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).Should(ExitCleanly())
+
+		stopped := podmanTest.Podman([]string{"create", "--network", netName, "--name", "livestopped", ALPINE, "true"})
+		stopped.WaitWithDefaultTimeout()
+		Expect(stopped).Should(ExitCleanly())
+
+		ping := podmanTest.Podman([]string{"exec", "livectr", "ping", "-c", "1", "10.51.51.1"})
+		ping.WaitWithDefaultTimeout()
+		Expect(ping).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"network", "inspect", "--live", netName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		var reports []entities.NetworkInspectLiveReport
+		err := json.Unmarshal(inspect.Out.Contents(), &reports)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reports).To(HaveLen(1))
+		Expect(reports[0].Name).To(Equal(netName))
+		Expect(reports[0].Endpoints).To(HaveLen(2))
+
+		for _, endpoint := range reports[0].Endpoints {
+			if endpoint.ContainerName == "livestopped" {
+				Expect(endpoint.State).To(Equal("created"))
+				Expect(endpoint.Statistics).To(BeNil())
+				continue
+			}
+			Expect(endpoint.PodName).To(Equal(podName))
+			Expect(endpoint.State).To(Equal("running"))
+			Expect(endpoint.InterfaceName).To(Equal("eth0"))
+			Expect(endpoint.MacAddress).ToNot(BeEmpty())
+			Expect(endpoint.IPAddresses).To(HaveLen(1))
+			Expect(endpoint.IPAddresses[0]).To(HavePrefix("10.51.51."))
+			Expect(endpoint.IPAddresses[0]).To(HaveSuffix("/24"))
+			Expect(endpoint.Aliases).To(ContainElement("livealias"))
+			Expect(endpoint.SharedWith).To(ConsistOf(ctr.OutputToString()))
+			Expect(endpoint.Statistics).ToNot(BeNil())
+			Expect(endpoint.Statistics.TxPackets).To(BeNumerically(">", 0))
+			Expect(endpoint.Statistics.RxBytes).To(BeNumerically(">", 0))
+		}
+
+		rm := podmanTest.Podman([]string{"pod", "rm", "-t", "0", "-f", podName})
+		rm.WaitWithDefaultTimeout()
+		Expect(rm).Should(ExitCleanly())
+	})
+
 	It("podman inspect container single CNI network", func() {
 		netName := "net-" + stringid.GenerateRandomID()
 		network := podmanTest.Podman([]string{"network", "create", "--subnet", "10.50.50.0/24", netName})
@@ -736,4 +801,88 @@ var _ = Describe("Podman network", func() {
 		Expect(listAgain.OutputToStringArray()).Should(ContainElement(net2))
 		Expect(listAgain.OutputToStringArray()).Should(ContainElement("podman"))
 	})
+
+	It("podman network update subnets, labels and options", func() {
+		net := createNetworkName("update")
+		session := podmanTest.Podman([]string{"network", "create", "--subnet", "10.11.12.0/24", "--label", "a=1", net})
+		session.WaitWithDefaultTimeout()
+		defer podmanTest.removeNetwork(net)
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"network", "inspect", "--format", "{{.ID}}", net})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		netID := session.OutputToString()
+
+		ctr := podmanTest.Podman([]string{"run", "-d", "--name", "updatectr", "--network", net, ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).Should(ExitCleanly())
+
+		ipFormat := fmt.Sprintf("{{(index .NetworkSettings.Networks %q).IPAddress}}", net)
+		inspect := podmanTest.Podman([]string{"inspect", "--format", ipFormat, "updatectr"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		ip := inspect.OutputToString()
+		Expect(ip).To(HavePrefix("10.11.12."))
+
+		update := podmanTest.Podman([]string{"network", "update", "--subnet-add", "10.11.13.0/24", "--gateway", "10.11.13.254",
+			"--ip-range", "10.11.13.128/25", "--internal", "--isolate", "strict", "--label-add", "b=2", "--label-drop", "a", net})
+		update.WaitWithDefaultTimeout()
+		Expect(update).Should(ExitCleanly())
+		Expect(update.OutputToString()).To(Equal(net))
+
+		session = podmanTest.Podman([]string{"network", "inspect", net})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		var results []types.Network
+		err := json.Unmarshal([]byte(session.OutputToString()), &results)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		result := results[0]
+		Expect(result.ID).To(Equal(netID))
+		Expect(result.Subnets).To(HaveLen(2))
+		Expect(result.Subnets[1].Subnet.String()).To(Equal("10.11.13.0/24"))
+		Expect(result.Subnets[1].Gateway.String()).To(Equal("10.11.13.254"))
+		Expect(result.Subnets[1].LeaseRange.StartIP.String()).To(Equal("10.11.13.129"))
+		Expect(result.Internal).To(BeTrue())
+		Expect(result.Options).To(HaveKeyWithValue("isolate", "strict"))
+		Expect(result.Labels).To(Equal(map[string]string{"b": "2"}))
+
+		// The container keeps running with the same IP address.
+		inspect = podmanTest.Podman([]string{"inspect", "--format", "{{.State.Running}} " + ipFormat, "updatectr"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("true " + ip))
+
+		// The subnet of the container can not be removed.
+		update = podmanTest.Podman([]string{"network", "update", "--subnet-drop", "10.11.12.0/24", net})
+		update.WaitWithDefaultTimeout()
+		Expect(update).Should(ExitWithError())
+		Expect(update.ErrorToString()).To(ContainSubstring("subnet 10.11.12.0/24 is in use by container"))
+
+		update = podmanTest.Podman([]string{"network", "update", "--subnet-drop", "10.11.13.0/24", "--internal=false", net})
+		update.WaitWithDefaultTimeout()
+		Expect(update).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"network", "inspect", "--format", "{{len .Subnets}} {{.Internal}}", net})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("1 false"))
+
+		update = podmanTest.Podman([]string{"network", "update", "--gateway", "10.11.14.1", net})
+		update.WaitWithDefaultTimeout()
+		Expect(update).Should(ExitWithError())
+		Expect(update.ErrorToString()).To(ContainSubstring("gateway 10.11.14.1 is not part of any subnet of network " + net))
+
+		update = podmanTest.Podman([]string{"network", "update", "--opt-add", "mtu=abc", net})
+		update.WaitWithDefaultTimeout()
+		Expect(update).Should(ExitWithError())
+		Expect(update.ErrorToString()).To(ContainSubstring("invalid options for network " + net))
+
+		session = podmanTest.Podman([]string{"network", "inspect", "--format", "{{.ID}} {{.Options}}", net})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(HavePrefix(netID))
+		Expect(session.OutputToString()).ToNot(ContainSubstring("mtu"))
+	})
 })
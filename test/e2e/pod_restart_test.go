@@ -154,4 +154,63 @@ var _ = Describe("Podman pod restart", func() {
 		session.WaitWithDefaultTimeout()
 		Expect(session).Should(Exit(125))
 	})
+
+	It("podman pod create --restart-pod restarts the pod when a critical container exits", func() {
+		session := podmanTest.Podman([]string{"run", "--pod-critical", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("pod critical containers must be part of a pod"))
+
+		session = podmanTest.Podman([]string{"pod", "create", "--name", "critpod", "--restart-pod", "on-failure:2"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", "critpod", "--name", "sidecar", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.StartedAt}}", "sidecar"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		startedAt := inspect.OutputToString()
+
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", "critpod", "--name", "critical", "--pod-critical", ALPINE, "sh", "-c", "sleep 2; exit 1"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		Eventually(func() string {
+			inspect := podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.RestartPodPolicy}} {{.RestartCount}}", "critpod"})
+			inspect.WaitWithDefaultTimeout()
+			return inspect.OutputToString()
+		}, "60s", "1s").Should(Equal("on-failure 2"))
+
+		// The pod is not restarted anymore after the maximum of
+		// restarts, but the other containers keep running.
+		Eventually(func() string {
+			inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.Status}}", "critical"})
+			inspect.WaitWithDefaultTimeout()
+			return inspect.OutputToString()
+		}, "30s", "1s").Should(Equal("exited"))
+
+		inspect = podmanTest.Podman([]string{"inspect", "--format", "{{.State.Status}} {{.State.StartedAt}}", "sidecar"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(HavePrefix("running "))
+		Expect(inspect.OutputToString()).ToNot(HaveSuffix(startedAt))
+
+		events := podmanTest.Podman([]string{"events", "--stream=false", "--filter", "pod=critpod", "--filter", "event=restart"})
+		events.WaitWithDefaultTimeout()
+		Expect(events).Should(ExitCleanly())
+		Expect(events.OutputToStringArray()).To(HaveLen(2))
+
+		// Starting the pod resets the restart count.
+		session = podmanTest.Podman([]string{"pod", "start", "critpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect = podmanTest.Podman([]string{"pod", "inspect", "--format", "{{.RestartCount}}", "critpod"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("0"))
+	})
 })
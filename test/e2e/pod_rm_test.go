@@ -321,4 +321,36 @@ var _ = Describe("Podman pod rm", func() {
 		Expect(ps2).Should(ExitCleanly())
 		Expect(ps2.OutputToString()).To(Not(ContainSubstring(podName)))
 	})
+
+	It("podman pod stop and rm --filter", func() {
+		_, ec, podid := podmanTest.CreatePod(map[string][]string{"--label": {"env=test"}})
+		Expect(ec).To(Equal(0))
+		_, ec, podid2 := podmanTest.CreatePod(nil)
+		Expect(ec).To(Equal(0))
+
+		session := podmanTest.RunTopContainerInPod("", podid)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "stop", "--filter", "label=env=test", "--format", "json"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(BeValidJSON())
+		Expect(session.OutputToString()).To(ContainSubstring(podid))
+		Expect(session.OutputToString()).ToNot(ContainSubstring(podid2))
+
+		session = podmanTest.Podman([]string{"pod", "rm", "--filter", "label=env=test", podid2})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+
+		session = podmanTest.Podman([]string{"pod", "rm", "--filter", "label=env=test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal(podid))
+
+		session = podmanTest.Podman([]string{"pod", "ps", "-q", "--no-trunc"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{podid2}))
+	})
 })
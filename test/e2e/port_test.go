@@ -127,4 +127,83 @@ var _ = Describe("Podman port", func() {
 		Expect(result2).Should(ExitCleanly())
 		Expect(result2.OutputToStringArray()).To(ContainElement(HavePrefix("0.0.0.0:5001")))
 	})
+
+	It("podman port add and rm on a running container", func() {
+		port1 := GetPort()
+		port2 := GetPort()
+
+		setup := podmanTest.Podman([]string{"run", "--name", "test", "-d", "-p", fmt.Sprintf("%d:80", port1), ALPINE, "top"})
+		setup.WaitWithDefaultTimeout()
+		Expect(setup).Should(ExitCleanly())
+
+		add := podmanTest.Podman([]string{"port", "add", "test", fmt.Sprintf("%d:90/udp", port2)})
+		add.WaitWithDefaultTimeout()
+		Expect(add).Should(ExitCleanly())
+		Expect(add.OutputToStringArray()).To(ConsistOf(
+			fmt.Sprintf("80/tcp -> 0.0.0.0:%d", port1),
+			fmt.Sprintf("90/udp -> 0.0.0.0:%d", port2)))
+
+		result := podmanTest.Podman([]string{"port", "test", "90/udp"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+		Expect(result.OutputToString()).To(Equal(fmt.Sprintf("0.0.0.0:%d", port2)))
+
+		// The host port is already used by the container.
+		conflict := podmanTest.Podman([]string{"port", "add", "test", fmt.Sprintf("%d:91", port1)})
+		conflict.WaitWithDefaultTimeout()
+		Expect(conflict).Should(ExitWithError())
+		Expect(conflict.ErrorToString()).To(ContainSubstring(fmt.Sprintf("conflicting port mappings for host port %d", port1)))
+
+		rm := podmanTest.Podman([]string{"container", "port", "rm", "test", "80"})
+		rm.WaitWithDefaultTimeout()
+		Expect(rm).Should(ExitCleanly())
+		Expect(rm.OutputToStringArray()).To(ConsistOf(fmt.Sprintf("90/udp -> 0.0.0.0:%d", port2)))
+
+		result = podmanTest.Podman([]string{"port", "test", "80"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitWithError())
+
+		rm = podmanTest.Podman([]string{"port", "rm", "test", "80"})
+		rm.WaitWithDefaultTimeout()
+		Expect(rm).Should(ExitWithError())
+		Expect(rm.ErrorToString()).To(ContainSubstring("container port 80/tcp is not published"))
+
+		// The container keeps running with the same IP address.
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.Running}} {{.State.Pid}}", "test"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(HavePrefix("true"))
+	})
+
+	It("podman port add on a stopped container", func() {
+		port1 := GetPort()
+
+		setup := podmanTest.Podman([]string{"create", "--name", "test", ALPINE, "top"})
+		setup.WaitWithDefaultTimeout()
+		Expect(setup).Should(ExitCleanly())
+
+		add := podmanTest.Podman([]string{"port", "add", "test", fmt.Sprintf("%d:80", port1)})
+		add.WaitWithDefaultTimeout()
+		Expect(add).Should(ExitCleanly())
+
+		start := podmanTest.Podman([]string{"start", "test"})
+		start.WaitWithDefaultTimeout()
+		Expect(start).Should(ExitCleanly())
+
+		result := podmanTest.Podman([]string{"port", "test"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+		Expect(result.OutputToStringArray()).To(ConsistOf(fmt.Sprintf("80/tcp -> 0.0.0.0:%d", port1)))
+	})
+
+	It("podman port add with container in a pod", func() {
+		setup := podmanTest.Podman([]string{"create", "--pod", "new:portpod", "--name", "test", ALPINE, "top"})
+		setup.WaitWithDefaultTimeout()
+		Expect(setup).Should(ExitCleanly())
+
+		add := podmanTest.Podman([]string{"port", "add", "test", "8080:80"})
+		add.WaitWithDefaultTimeout()
+		Expect(add).Should(ExitWithError())
+		Expect(add.ErrorToString()).To(ContainSubstring("shares the network namespace of container"))
+	})
 })
@@ -190,6 +190,41 @@ var _ = Describe("Podman prune", func() {
 		Expect(images.OutputToStringArray()).To(HaveLen(len(CACHE_IMAGES)))
 	})
 
+	It("podman image prune --policy", func() {
+		podmanTest.AddImageToRWStore(ALPINE)
+		podmanTest.AddImageToRWStore(BB)
+
+		prune := podmanTest.Podman([]string{"image", "prune", "--policy", "-f"})
+		prune.WaitWithDefaultTimeout()
+		Expect(prune).Should(ExitWithError())
+		Expect(prune.ErrorToString()).To(ContainSubstring("no image retention policy configured"))
+
+		conffile := filepath.Join(podmanTest.TempDir, "containers.conf")
+		err := os.WriteFile(conffile, []byte("[image_retention]\nunused_days = 1\n"), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("CONTAINERS_CONF_OVERRIDE", conffile)
+		if IsRemote() {
+			podmanTest.RestartRemoteService()
+		}
+
+		session := podmanTest.Podman([]string{"create", BB})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		prune = podmanTest.Podman([]string{"image", "prune", "--policy", "-f"})
+		prune.WaitWithDefaultTimeout()
+		Expect(prune).Should(ExitCleanly())
+
+		// ALPINE is older than a day and unused, BB is used by a container
+		session = podmanTest.Podman([]string{"image", "exists", ALPINE})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+
+		session = podmanTest.Podman([]string{"image", "exists", BB})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+	})
+
 	It("podman system image prune unused images", func() {
 		useCustomNetworkDir(podmanTest, tempdir)
 		podmanTest.AddImageToRWStore(ALPINE)
@@ -388,6 +388,83 @@ var _ = Describe("Podman ps", func() {
 		Expect(psAll.OutputToString()).To(Equal(psFilter.OutputToString()))
 	})
 
+	It("podman ps filter by exit code comparison and restart count", func() {
+		session := podmanTest.Podman([]string{"run", "--name", "exit0", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		session = podmanTest.Podman([]string{"run", "--name", "exit3", ALPINE, "sh", "-c", "exit 3"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(3))
+
+		session = podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", "exited>0"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{"exit3"}))
+
+		session = podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", "exited<=0"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{"exit0"}))
+
+		session = podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", "restart-count>0"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(BeEmpty())
+
+		session = podmanTest.Podman([]string{"ps", "-a", "--filter", "exited>abc"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring(`invalid exited filter value "abc"`))
+	})
+
+	It("podman ps filter by command, oom-killed, started-since and ports", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "web", "-p", "8080:80", "--expose", "9000-9001/udp", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		session = podmanTest.Podman([]string{"create", "--name", "idle", ALPINE, "sleep", "100"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		for _, filter := range []string{"command=to*", "command=t?p", "oom-killed=false", "started-since=10m", "publish=80", "publish=70-90/tcp", "expose=9001/udp"} {
+			session = podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", "status=running", "--filter", filter})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToStringArray()).To(Equal([]string{"web"}), filter)
+		}
+
+		for _, filter := range []string{"command=sleep", "oom-killed=true", "publish=80/udp", "publish=8080", "expose=9000"} {
+			session = podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", filter})
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToStringArray()).ToNot(ContainElement("web"), filter)
+		}
+
+		session = podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", "command=sleep*"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{"idle"}))
+	})
+
+	It("podman ps filter by image digest", func() {
+		session := podmanTest.Podman([]string{"run", "--name", "digest", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"image", "inspect", "--format", "{{.Digest}}", ALPINE})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"ps", "-a", "--format", "{{.Names}}", "--filter", "image-digest=" + inspect.OutputToString()})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{"digest"}))
+
+		session = podmanTest.Podman([]string{"ps", "-a", "--filter", "image-digest=foo"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring(`invalid image digest "foo"`))
+	})
+
 	It("podman filter without status does not find non-running", func() {
 		ctrName := "aContainerName"
 		ctr := podmanTest.Podman([]string{"create", "--name", ctrName, ALPINE, "ls", "/"})
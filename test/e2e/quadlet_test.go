@@ -802,6 +802,7 @@ BOGUS=foo
 		Entry("noimage.container", "noimage.container", 1, "converting \"noimage.container\": no Image or Rootfs key specified"),
 		Entry("notify.container", "notify.container", 0, ""),
 		Entry("notify-healthy.container", "notify-healthy.container", 0, ""),
+		Entry("notify-healthy-then-watchdog.container", "notify-healthy-then-watchdog.container", 0, ""),
 		Entry("oneshot.container", "oneshot.container", 0, ""),
 		Entry("other-sections.container", "other-sections.container", 0, ""),
 		Entry("pod.non-quadlet.container", "pod.non-quadlet.container", 1, "converting \"pod.non-quadlet.container\": pod test-pod is not Quadlet based"),
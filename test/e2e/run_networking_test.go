@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"os/exec"
 	"strconv"
 	"strings"
 	"syscall"
@@ -1093,6 +1094,67 @@ EXPOSE 2004-2005/tcp`, ALPINE)
 		pingTest("--net=none")
 	})
 
+	It("podman run --hosts-group", func() {
+		// use a network without dns so that only /etc/hosts can resolve the names
+		net := createNetworkName("hostsgroup")
+		session := podmanTest.Podman([]string{"network", "create", "--disable-dns", net})
+		session.WaitWithDefaultTimeout()
+		defer podmanTest.removeNetwork(net)
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"pod", "create", "--name", "hgpod", "--network", net, "--hosts-group", "hg"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", "hgpod", "--name", "inpod", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		ctr := podmanTest.RunTopContainerWithArgs("hgctr", []string{"--network", net, "--hosts-group", "hg", "--cap-add", "net_raw"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).Should(ExitCleanly())
+		ip := podmanTest.Podman([]string{"inspect", "--format", "{{(index .NetworkSettings.Networks \"" + net + "\").IPAddress}}", "hgctr"})
+		ip.WaitWithDefaultTimeout()
+		Expect(ip).Should(ExitCleanly())
+
+		// the containers of the pod share the hosts file of the infra container
+		session = podmanTest.Podman([]string{"exec", "inpod", "grep", "hgctr", "/etc/hosts"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(HavePrefix(ip.OutputToString()))
+
+		session = podmanTest.Podman([]string{"exec", "hgctr", "ping", "-c", "1", "hgpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		// a container outside of the group is not added
+		session = podmanTest.Podman([]string{"run", "--rm", "--name", "other", "--network", net, ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		session = podmanTest.Podman([]string{"exec", "hgctr", "cat", "/etc/hosts"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).ToNot(ContainSubstring("other"))
+
+		// stopping a member removes its entries
+		session = podmanTest.Podman([]string{"stop", "-t0", "hgctr"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		session = podmanTest.Podman([]string{"exec", "inpod", "cat", "/etc/hosts"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).ToNot(ContainSubstring("hgctr"))
+
+		session = podmanTest.Podman([]string{"run", "--pod", "hgpod", "--hosts-group", "hg", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("cannot join a hosts group when joining the network namespace of another container"))
+
+		session = podmanTest.Podman([]string{"create", "--hosts-group", "../hg", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("names must match"))
+	})
+
 	It("podman attempt to ping container name and hostname --net=private", func() {
 		pingTest("--net=private")
 	})
@@ -1206,4 +1268,107 @@ EXPOSE 2004-2005/tcp`, ALPINE)
 options ndots:1
 `))
 	})
+
+	It("podman run --network with egress rules", func() {
+		SkipIfRootless("egress rules are tested as root only")
+		if _, err := exec.LookPath("nft"); err != nil {
+			Skip("nft is not installed")
+		}
+		net := createNetworkName("egress")
+		session := podmanTest.Podman([]string{"network", "create", net})
+		session.WaitWithDefaultTimeout()
+		defer podmanTest.removeNetwork(net)
+		Expect(session).Should(ExitCleanly())
+
+		listener := []string{"sh", "-c", "while true; do echo reached | nc -l -p 8080; done"}
+		ips := make([]string, 0, 2)
+		for i := 0; i < 2; i++ {
+			session = podmanTest.Podman(append([]string{"run", "-d", "--network", net, ALPINE}, listener...))
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			inspect := podmanTest.Podman([]string{"inspect", "--format", "{{(index .NetworkSettings.Networks \"" + net + "\").IPAddress}}", session.OutputToString()})
+			inspect.WaitWithDefaultTimeout()
+			Expect(inspect).Should(ExitCleanly())
+			ips = append(ips, inspect.OutputToString())
+		}
+
+		session = podmanTest.Podman([]string{"run", "-d", "--name", "client", "--network", net + ":egress=" + ips[0] + ":8080", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{(index .NetworkSettings.Networks \"" + net + "\").EgressRules}}", "client"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("[" + ips[0] + ":8080]"))
+
+		session = podmanTest.Podman([]string{"exec", "client", "nc", "-w", "2", ips[0], "8080"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("reached"))
+
+		session = podmanTest.Podman([]string{"exec", "client", "nc", "-w", "2", ips[1], "8080"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+
+		// the rules are installed again when the network is set up again
+		session = podmanTest.Podman([]string{"network", "reload", "client"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"exec", "client", "nc", "-w", "2", ips[1], "8080"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+
+		session = podmanTest.Podman([]string{"run", "--rm", "--cap-add", "net_admin", "--network", net + ":egress=" + ips[0], ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("egress rules cannot be enforced for containers with the CAP_NET_ADMIN capability"))
+	})
+
+	It("podman network create -o egress", func() {
+		SkipIfRootless("egress rules are tested as root only")
+		net := createNetworkName("egress")
+		session := podmanTest.Podman([]string{"network", "create", "-o", "egress=10.10.0.0/16,[fd00::1]:443", net})
+		session.WaitWithDefaultTimeout()
+		defer podmanTest.removeNetwork(net)
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"network", "inspect", "--format", "{{index .Labels \"io.podman.network.egress\"}} {{.Options}}", net})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("10.10.0.0/16,[fd00::1]:443 map[]"))
+
+		// containers with CAP_NET_ADMIN could remove the rules of the network
+		session = podmanTest.Podman([]string{"create", "--cap-add", "net_admin", "--network", net, ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("egress rules cannot be enforced for containers with the CAP_NET_ADMIN capability"))
+
+		session = podmanTest.Podman([]string{"create", "--name", "netadmin", "--cap-add", "net_admin", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"network", "connect", net, "netadmin"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("with the CAP_NET_ADMIN capability"))
+
+		session = podmanTest.Podman([]string{"network", "update", "--opt-drop", "egress", net})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"network", "connect", net, "netadmin"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"network", "update", "--opt-add", "egress=10.10.0.0/16", net})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("with the CAP_NET_ADMIN capability"))
+
+		session = podmanTest.Podman([]string{"network", "create", "-o", "egress=10.10.0.1:99999", createNetworkName("egress")})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring(`invalid port "99999"`))
+	})
 })
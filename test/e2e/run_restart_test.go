@@ -4,6 +4,7 @@ import (
 	. "github.com/containers/podman/v5/test/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
 )
 
 var _ = Describe("Podman run restart containers", func() {
@@ -35,4 +36,31 @@ var _ = Describe("Podman run restart containers", func() {
 		session2.WaitWithDefaultTimeout()
 		Expect(session2).Should(ExitCleanly())
 	})
+
+	It("Podman restart policy with --restart-delay", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "crashloop", "--restart", "always", "--restart-delay", "1m", ALPINE, "false"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		Eventually(func() string {
+			inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.Restarting}} {{.State.RestartBackoff}}", "crashloop"})
+			inspect.WaitWithDefaultTimeout()
+			return inspect.OutputToString()
+		}, "30s", "1s").Should(Equal("true 1m0s"))
+
+		ps := podmanTest.Podman([]string{"ps", "-a", "--filter", "name=crashloop", "--format", "{{.Status}}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).Should(ExitCleanly())
+		Expect(ps.OutputToString()).To(HavePrefix("Restarting (backoff "))
+
+		// Stopping the container cancels the pending restart.
+		stop := podmanTest.Podman([]string{"stop", "crashloop"})
+		stop.WaitWithDefaultTimeout()
+		Expect(stop).Should(Exit(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.Restarting}} {{.RestartCount}}", "crashloop"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("false 0"))
+	})
 })
@@ -2101,6 +2101,56 @@ WORKDIR /madethis`, BB)
 		Expect(running.OutputToStringArray()).To(HaveLen(2))
 	})
 
+	It("podman run --requires with conditions", func() {
+		migrate := podmanTest.Podman([]string{"create", "--name", "migrate", ALPINE, "sh", "-c", "sleep 2; touch /tmp/done"})
+		migrate.WaitWithDefaultTimeout()
+		Expect(migrate).Should(ExitCleanly())
+
+		db := podmanTest.Podman([]string{"create", "--name", "db", "--health-cmd", "test -e /tmp/ready", "--health-interval", "1s", ALPINE, "sh", "-c", "sleep 2; touch /tmp/ready; exec top"})
+		db.WaitWithDefaultTimeout()
+		Expect(db).Should(ExitCleanly())
+
+		session := podmanTest.Podman([]string{"create", "--name", "nohc", "--requires", "migrate:healthy", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("has no healthcheck"))
+
+		session = podmanTest.Podman([]string{"create", "--name", "bad", "--requires", "db:ready", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring(`invalid dependency condition "ready"`))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--name", "app", "--requires", "migrate:completed-successfully,db:healthy", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"inspect", "--format", "{{.State.ExitCode}} {{.State.Status}}", "migrate"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("0 exited"))
+
+		session = podmanTest.Podman([]string{"inspect", "--format", "{{.State.Health.Status}}", "db"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal(define.HealthCheckHealthy))
+
+		session = podmanTest.Podman([]string{"inspect", "--format", "{{len .DependencyConditions}}", "app"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("2"))
+
+		// A dependency which must complete successfully and failed
+		// stops the start.
+		session = podmanTest.Podman([]string{"run", "--name", "failing", ALPINE, "false"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(1))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--name", "app2", "--requires", "failing:completed-successfully", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("exited with code 1"))
+	})
+
 	It("podman run with pidfile", func() {
 		SkipIfRemote("pidfile not handled by remote")
 		pidfile := tempdir + "pidfile"
@@ -0,0 +1,117 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/containers/podman/v5/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Podman sync", func() {
+
+	It("podman sync a directory to a container", func() {
+		srcDir := GinkgoT().TempDir()
+		err := os.MkdirAll(filepath.Join(srcDir, "src"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.WriteFile(filepath.Join(srcDir, "src", "main.c"), []byte("int main;"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.WriteFile(filepath.Join(srcDir, "build.log"), []byte("log"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.WriteFile(filepath.Join(srcDir, ".containerignore"), []byte("*.log\n"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+
+		session := podmanTest.RunTopContainer("syncctr")
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"exec", "syncctr", "sh", "-c", "mkdir /app && touch /app/stale"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"sync", "--delete", srcDir, "syncctr:/app"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"exec", "syncctr", "find", "/app"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(ConsistOf("/app", "/app/.containerignore", "/app/src", "/app/src/main.c"))
+
+		session = podmanTest.Podman([]string{"sync", srcDir, "syncctr:/missing"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125))
+		Expect(session.ErrorToString()).To(ContainSubstring(`"/missing" could not be found on container syncctr`))
+	})
+
+	It("podman sync --delete to a stopped container", func() {
+		srcDir := GinkgoT().TempDir()
+		err := os.WriteFile(filepath.Join(srcDir, "main.c"), []byte("int main;"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+
+		session := podmanTest.Podman([]string{"create", "--name", "syncctr", ALPINE, "ls", "-A", "/etc/ssl"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		// The files are removed through the copy API, not by running rm.
+		session = podmanTest.Podman([]string{"sync", "--delete", srcDir, "syncctr:/etc/ssl"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"start", "--attach", "syncctr"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{"main.c"}))
+	})
+
+	It("podman sync a directory from a container", func() {
+		dstDir := GinkgoT().TempDir()
+		err := os.WriteFile(filepath.Join(dstDir, "stale"), []byte("stale"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.WriteFile(filepath.Join(dstDir, "keep.log"), []byte("log"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.WriteFile(filepath.Join(dstDir, ".containerignore"), []byte("*.log\n"), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+
+		session := podmanTest.RunTopContainer("syncctr")
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"exec", "syncctr", "sh", "-c", "mkdir -p /app/dist/js && echo app > /app/dist/js/app.js && echo log > /app/build.log && cp /app/dist/js/app.js /app/index.js && ln -s dist/js/app.js /app/link && echo '*.log' > /app/.containerignore"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"sync", "--delete", "syncctr:/app", dstDir})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		var files []string
+		err = filepath.WalkDir(dstDir, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(dstDir, p)
+			files = append(files, rel)
+			return err
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(ConsistOf(".", ".containerignore", "dist", "dist/js", "dist/js/app.js", "index.js", "keep.log", "link"))
+		content, err := os.ReadFile(filepath.Join(dstDir, "link"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("app\n"))
+
+		// Only the changed file is copied again.
+		session = podmanTest.Podman([]string{"exec", "syncctr", "sh", "-c", "echo changed > /app/index.js"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		session = podmanTest.Podman([]string{"--log-level", "debug", "sync", "syncctr:/app", dstDir})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.ErrorToString()).To(ContainSubstring("Syncing 1 files from syncctr:/app"))
+		content, err = os.ReadFile(filepath.Join(dstDir, "index.js"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("changed\n"))
+	})
+})
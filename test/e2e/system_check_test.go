@@ -0,0 +1,90 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/containers/podman/v5/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("podman system check", func() {
+
+	It("podman system check on a consistent state", func() {
+		SkipIfRemote("system check not supported on podman --remote")
+		session := podmanTest.Podman([]string{"system", "check", "--quick"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("No inconsistencies found"))
+
+		session = podmanTest.Podman([]string{"system", "check", "--quick", "--format", "json"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(BeValidJSON())
+	})
+
+	It("podman system check --repair missing volume mount point", func() {
+		SkipIfRemote("system check not supported on podman --remote")
+		session := podmanTest.Podman([]string{"volume", "create", "checkvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"volume", "inspect", "--format", "{{.Mountpoint}}", "checkvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		mountPoint := session.OutputToString()
+		Expect(os.RemoveAll(mountPoint)).To(Succeed())
+
+		session = podmanTest.Podman([]string{"system", "check", "--quick", "--format", "{{.Type}} {{.ID}} {{.Repaired}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.OutputToString()).To(ContainSubstring("volume checkvol false"))
+		Expect(session.ErrorToString()).To(ContainSubstring("inconsistencies found"))
+
+		session = podmanTest.Podman([]string{"system", "check", "--quick", "--repair", "--format", "{{.Type}} {{.ID}} {{.Repaired}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("volume checkvol true"))
+		Expect(mountPoint).To(BeADirectory())
+
+		session = podmanTest.Podman([]string{"system", "check", "--quick"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+	})
+	It("podman system check --repair leaves unknown storage containers", func() {
+		SkipIfRemote("system check not supported on podman --remote")
+		session := podmanTest.Podman([]string{"create", "--name", "foreign", ALPINE})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		// Drop the podman database but keep the storage container, like
+		// a container created by another tool using the same storage.
+		if podmanTest.DatabaseBackend == "sqlite" {
+			Expect(os.Remove(filepath.Join(podmanTest.Root, "db.sql"))).To(Succeed())
+		} else {
+			Expect(os.RemoveAll(filepath.Join(podmanTest.Root, "libpod"))).To(Succeed())
+		}
+
+		session = podmanTest.Podman([]string{"system", "check", "--quick", "--repair", "--format", "{{.Type}} {{.ID}} {{.Repaired}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.OutputToString()).To(ContainSubstring("storage-container " + cid + " false"))
+
+		session = podmanTest.Podman([]string{"ps", "--all", "--external", "--noheading", "--no-trunc", "--format", "{{.ID}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring(cid))
+
+		session = podmanTest.Podman([]string{"system", "check", "--quick", "--force", "--format", "{{.Type}} {{.ID}} {{.Repaired}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("storage-container " + cid + " true"))
+
+		session = podmanTest.Podman([]string{"ps", "--all", "--external", "--noheading", "--no-trunc", "--format", "{{.ID}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).ToNot(ContainSubstring(cid))
+	})
+})
@@ -112,4 +112,23 @@ var _ = Describe("podman system df", func() {
 		Expect(session.OutputToString()).To(BeValidJSON())
 	})
 
+	It("podman system df --analyze", func() {
+		session := podmanTest.Podman([]string{"create", ALPINE})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"system", "df", "--analyze"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+		Expect(session.ErrorToString()).To(Equal("Error: --analyze can only be used with --verbose"))
+
+		session = podmanTest.Podman([]string{"system", "df", "--verbose", "--analyze"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("EXCLUSIVE SIZE"))
+		Expect(session.OutputToString()).To(ContainSubstring("Layers space usage:"))
+		Expect(session.OutputToString()).To(ContainSubstring("Build cache space usage:"))
+		Expect(session.OutputToString()).To(ContainSubstring("Estimated space reclaimed by prune:"))
+	})
+
 })
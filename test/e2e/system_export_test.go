@@ -0,0 +1,95 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/containers/podman/v5/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// system export/import uses system reset and must run serial
+var _ = Describe("podman system export", Serial, func() {
+
+	It("podman system export and import", func() {
+		SkipIfRemote("system export not supported on podman --remote")
+		useCustomNetworkDir(podmanTest, tempdir)
+		bundle := filepath.Join(tempdir, "bundle.tar")
+
+		podmanTest.AddImageToRWStore(ALPINE)
+		session := podmanTest.Podman([]string{"volume", "create", "data"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "-v", "data:/data", ALPINE, "sh", "-c", "echo hello > /data/file"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"network", "create", "exportnet"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"create", "--name", "exported", "--network", "exportnet", "-v", "data:/data", ALPINE, "cat", "/data/file"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"system", "export", bundle})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"system", "reset", "-f"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"system", "import", bundle})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(ContainSubstring("Container: exported"))
+		Expect(session.OutputToString()).To(ContainSubstring("Network: exportnet"))
+
+		session = podmanTest.Podman([]string{"container", "inspect", "--format", "{{.ID}}", "exported"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal(cid))
+
+		session = podmanTest.Podman([]string{"start", "--attach", "exported"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("hello"))
+	})
+
+	It("podman system import with missing secret", func() {
+		SkipIfRemote("system import not supported on podman --remote")
+		bundle := filepath.Join(tempdir, "bundle.tar")
+
+		secretFile := filepath.Join(tempdir, "secret")
+		err := os.WriteFile(secretFile, []byte("mysecret"), 0755)
+		Expect(err).ToNot(HaveOccurred())
+		session := podmanTest.Podman([]string{"secret", "create", "exportsecret", secretFile})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"create", "--secret", "exportsecret", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"system", "export", bundle})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"rm", "-a", "-f"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"secret", "rm", "exportsecret"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"system", "import", bundle})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("secrets [exportsecret] used by the exported containers must be created before importing"))
+	})
+})
@@ -218,4 +218,97 @@ var _ = Describe("Podman update", func() {
 		Expect(session).Should(ExitCleanly())
 		Expect(session.OutputToString()).Should(ContainSubstring("500000"))
 	})
+
+	It("podman update --network-rate", func() {
+		net := createNetworkName("rate")
+		session := podmanTest.Podman([]string{"network", "create", net})
+		session.WaitWithDefaultTimeout()
+		defer podmanTest.removeNetwork(net)
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "-d", "--network", net, "--network-rate", net + ":egress=5mbit", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		ctrID := session.OutputToString()
+
+		rateFormat := "{{json (index .NetworkSettings.Networks \"" + net + "\").NetworkRate}}"
+		inspect := podmanTest.Podman([]string{"inspect", "--format", rateFormat, ctrID})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal(`{"egress":5000000}`))
+
+		session = podmanTest.Podman([]string{"update", "--network-rate", "ingress=10mbit,egress=1kbps", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		// the limit of the network takes precedence over the limit of all networks
+		inspect = podmanTest.Podman([]string{"inspect", "--format", rateFormat, ctrID})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal(`{"egress":5000000}`))
+
+		session = podmanTest.Podman([]string{"update", "--network-rate", net + ":ingress=0,egress=0", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect = podmanTest.Podman([]string{"inspect", "--format", rateFormat, ctrID})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal(`{"ingress":10000000,"egress":8000}`))
+
+		session = podmanTest.Podman([]string{"update", "--network-rate", "ingress=1mibit", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		// the limits are kept when the network is set up again
+		session = podmanTest.Podman([]string{"restart", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect = podmanTest.Podman([]string{"inspect", "--format", rateFormat, ctrID})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal(`{"ingress":1048576}`))
+
+		session = podmanTest.Podman([]string{"update", "--network-rate", "othernet:egress=1mbit", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+
+		session = podmanTest.Podman([]string{"update", "--network-rate", "egress=1mb", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring(`unknown rate unit "mb"`))
+	})
+
+	It("podman run --network-rate requires a bridge network", func() {
+		session := podmanTest.Podman([]string{"run", "--rm", "--network", "none", "--network-rate", "egress=1mbit", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("network rates can only be set for containers using bridge networks"))
+	})
+
+	It("podman run --network-rate refuses CAP_NET_ADMIN", func() {
+		session := podmanTest.Podman([]string{"run", "--rm", "--cap-add", "NET_ADMIN", "--network-rate", "egress=1mbit", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("network rates cannot be enforced for containers with the CAP_NET_ADMIN capability"))
+
+		session = podmanTest.Podman([]string{"create", "--name", "ratectr", "--network-rate", "egress=1mbit", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"create", "--cap-add", "NET_ADMIN", "--network", "container:ratectr", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("cannot join the network namespace of container"))
+
+		session = podmanTest.Podman([]string{"create", "--name", "adminctr", "--cap-add", "NET_ADMIN", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"update", "--network-rate", "egress=1mbit", "adminctr"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("network rates cannot be enforced for containers with the CAP_NET_ADMIN capability"))
+	})
 })
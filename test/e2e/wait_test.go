@@ -1,6 +1,8 @@
 package integration
 
 import (
+	"encoding/json"
+
 	. "github.com/containers/podman/v5/test/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -107,4 +109,112 @@ var _ = Describe("Podman wait", func() {
 		Expect(session).Should(ExitCleanly())
 		Expect(session.OutputToStringArray()).To(Equal([]string{"0", "0", "0"}))
 	})
+
+	It("podman wait --filter --format json", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--label", "job=batch", ALPINE, "sh", "-c", "exit 3"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"run", "-d", ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"wait", "--filter", "label=job=batch", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("--filter takes no arguments"))
+
+		session = podmanTest.Podman([]string{"wait", "--filter", "label=job=batch"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("3"))
+
+		session = podmanTest.Podman([]string{"wait", "--filter", "label=job=batch", "--format", "json"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		var reports []map[string]any
+		err := json.Unmarshal(session.Out.Contents(), &reports)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reports).To(HaveLen(1))
+		Expect(reports[0]).To(HaveKeyWithValue("Id", cid))
+		Expect(reports[0]).To(HaveKeyWithValue("Result", BeEquivalentTo(3)))
+	})
+
+	It("podman wait --timeout", func() {
+		session := podmanTest.Podman([]string{"run", "-d", ALPINE, "sleep", "100"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"wait", "--timeout", "1", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("timed out waiting for container " + cid))
+
+		session = podmanTest.Podman([]string{"wait", "--timeout", "-1s", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("--timeout must not be negative"))
+	})
+
+	It("podman wait --any", func() {
+		session := podmanTest.Podman([]string{"run", "-d", ALPINE, "sleep", "100"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid1 := session.OutputToString()
+		session = podmanTest.Podman([]string{"run", "-d", ALPINE, "sh", "-c", "sleep 1; exit 3"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid2 := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"wait", "--any", "--timeout", "30s", cid1, cid2})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("3"))
+	})
+
+	It("podman wait --until-log", func() {
+		session := podmanTest.Podman([]string{"run", "-d", ALPINE, "sh", "-c", "sleep 1; echo server is ready; sleep 100"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"wait", "--until-log", "is (", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("invalid log pattern"))
+
+		session = podmanTest.Podman([]string{"wait", "--timeout", "30s", "--until-log", "^server is re.dy$", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal(cid))
+
+		session = podmanTest.Podman([]string{"run", "-d", ALPINE, "echo", "done"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid = session.OutputToString()
+
+		session = podmanTest.Podman([]string{"wait", "--timeout", "30s", "--until-log", "ready", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("exited without logging a line matching"))
+	})
+
+	It("podman wait --until-port", func() {
+		session := podmanTest.Podman([]string{"run", "-d", ALPINE, "sh", "-c", "sleep 1; nc -l -p 8080"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"wait", "--timeout", "30s", "--until-port", "8080", "--format", "json", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		var reports []map[string]any
+		err := json.Unmarshal(session.Out.Contents(), &reports)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reports).To(HaveLen(1))
+		Expect(reports[0]).To(HaveKeyWithValue("RawInput", cid))
+		Expect(reports[0]).ToNot(HaveKey("Result"))
+	})
 })
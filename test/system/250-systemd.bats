@@ -375,6 +375,45 @@ LISTEN_FDNAMES=listen_fdnames" | sort)
     service_cleanup
 }
 
+@test "podman run --sdnotify=healthy-then-watchdog" {
+    cname=c_$(random_string)
+    cat >$UNIT_FILE <<EOF
+[Service]
+Type=notify
+NotifyAccess=all
+WatchdogSec=5
+KillMode=mixed
+ExecStart=$PODMAN run --name $cname --replace --rm -d --sdnotify=healthy-then-watchdog --health-cmd /home/podman/healthcheck --health-interval 1s $IMAGE /home/podman/pause
+ExecStop=$PODMAN stop -t0 $cname
+EOF
+    systemctl daemon-reload
+    systemctl_start "$SERVICE_NAME"
+
+    run_podman container inspect $cname --format "{{.ID}}"
+    cid="$output"
+
+    # The healthchecks keep the service alive for several watchdog timeouts
+    sleep 12
+    run systemctl show --property=ActiveState --property=NRestarts "$SERVICE_NAME"
+    assert "$output" =~ "ActiveState=active" "service is kept alive by the watchdog pings"
+    assert "$output" =~ "NRestarts=0" "service was not restarted"
+    run_podman container inspect $cname --format "{{.ID}}"
+    assert "$output" = "$cid" "the container was not replaced"
+
+    # Once the healthcheck fails, systemd stops the service
+    run_podman exec $cname touch /uh-oh
+    local timeout=15
+    while [[ $timeout -gt 1 ]]; do
+        run systemctl show --property=Result "$SERVICE_NAME"
+        if [[ "$output" = "Result=watchdog" ]]; then
+            break
+        fi
+        sleep 1
+        let timeout=$timeout-1
+    done
+    assert "$output" = "Result=watchdog" "service is stopped by the watchdog"
+}
+
 @test "podman-kube@.service template" {
     install_kube_template
     # Create the YAMl file